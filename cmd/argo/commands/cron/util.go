@@ -8,7 +8,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -24,7 +23,7 @@ func GetNextRuntime(ctx context.Context, cwf *v1alpha1.CronWorkflow) (time.Time,
 	var nextRunTime time.Time
 	now := time.Now().UTC()
 	for _, schedule := range cwf.Spec.GetSchedulesWithTimezone(ctx) {
-		cronSchedule, err := cron.ParseStandard(schedule)
+		cronSchedule, err := v1alpha1.ParseCronSchedule(schedule)
 		if err != nil {
 			return time.Time{}, err
 		}
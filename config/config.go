@@ -105,6 +105,19 @@ type Config struct {
 	// https://argo-workflows.readthedocs.io/en/latest/workflow-executors/#emissary-emissary
 	Images map[string]Image `json:"images,omitempty"`
 
+	// EntrypointCache persists the command/args of images looked up from their registry in a ConfigMap, so that they
+	// survive a restart of the controller. Disabled by default.
+	EntrypointCache *EntrypointCache `json:"entrypointCache,omitempty"`
+
+	// CronWorkflowTimezones are the default timezones of CronWorkflows, keyed by namespace, e.g. "Asia/Tokyo", used for
+	// CronWorkflows that do not set a timezone. CronWorkflows in other namespaces default to the controller's local time.
+	CronWorkflowTimezones map[string]string `json:"cronWorkflowTimezones,omitempty"`
+
+	// CronWorkflowDefaultTimezoneUTC makes CronWorkflows that set no timezone, and whose namespace has no default in
+	// CronWorkflowTimezones, run in UTC rather than the controller's local time, so that they fire at the same times
+	// whichever host the controller runs on. Defaults to false, the controller's local time.
+	CronWorkflowDefaultTimezoneUTC bool `json:"cronWorkflowDefaultTimezoneUTC,omitempty"`
+
 	// Workflow retention by number of workflows
 	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
 
@@ -1,6 +1,40 @@
 package config
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type Image struct {
 	Entrypoint []string `json:"entrypoint,omitempty"`
 	Cmd        []string `json:"cmd,omitempty"`
 }
+
+// EntrypointCache persists the entrypoint/cmd of images looked up from their registry in a ConfigMap in the
+// controller's namespace, so that they are not looked up again after the controller restarts
+type EntrypointCache struct {
+	// ConfigMapName is the name of the ConfigMap. It is created if it does not exist; an existing ConfigMap must have
+	// the workflows.argoproj.io/configmap-type: EntrypointCache label.
+	ConfigMapName string `json:"configMapName"`
+	// MaxEntries is the maximum number of images in the ConfigMap, the least recently looked up are evicted first.
+	// Defaults to 500.
+	MaxEntries int `json:"maxEntries,omitempty"`
+	// TagTTL is how long an image referenced by tag is cached, as the tag may be moved to another image. Images
+	// referenced by digest are cached until evicted. Defaults to 24h.
+	TagTTL *metav1.Duration `json:"tagTTL,omitempty"`
+}
+
+func (c EntrypointCache) GetMaxEntries() int {
+	if c.MaxEntries > 0 {
+		return c.MaxEntries
+	}
+	return 500
+}
+
+func (c EntrypointCache) GetTagTTL() time.Duration {
+	if c.TagTTL != nil {
+		return c.TagTTL.Duration
+	}
+	return 24 * time.Hour
+}
@@ -0,0 +1,105 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// previewRunCount is the number of upcoming runs returned by PreviewCronWorkflow
+const previewRunCount = 10
+
+// PreviewResult describes when a CronWorkflow will run, for clients such as the CLI or UI to render before it is
+// submitted. It is not an API type, so neither protobuf nor OpenAPI is generated for it.
+// +k8s:deepcopy-gen=false
+// +k8s:openapi-gen=false
+// +protobuf=false
+type PreviewResult struct {
+	// Timezone is the timezone the schedules are evaluated in, empty for the controller's local time
+	Timezone string `json:"timezone,omitempty"`
+	// Schedules are the schedules the controller will use, with any timezone prefix
+	Schedules []string `json:"schedules"`
+	// Schedulable is false if the CronWorkflow is suspended or stopped, in which case none of NextRuns will happen
+	// until it is resumed
+	Schedulable bool `json:"schedulable"`
+	// NextRuns are the next times at which any of the schedules fires, earliest first
+	NextRuns []PreviewRun `json:"nextRuns"`
+	// Deprecations are human-readable warnings about deprecated fields in use
+	Deprecations []string `json:"deprecations,omitempty"`
+}
+
+// PreviewRun is a single upcoming run of a CronWorkflow
+// +k8s:deepcopy-gen=false
+// +k8s:openapi-gen=false
+// +protobuf=false
+type PreviewRun struct {
+	Time metav1.Time `json:"time"`
+	// Schedules are the schedules that fire at Time
+	Schedules []string `json:"schedules"`
+}
+
+// PreviewCronWorkflow validates the schedules and timezone of cwf and returns its next runs and any deprecated fields
+// it uses. It makes no API calls, so may be used client-side.
+func PreviewCronWorkflow(ctx context.Context, cwf *CronWorkflow) (PreviewResult, error) {
+	return previewCronWorkflow(ctx, cwf, time.Now())
+}
+
+func previewCronWorkflow(ctx context.Context, cwf *CronWorkflow, now time.Time) (PreviewResult, error) {
+	spec := &cwf.Spec
+	if spec.Timezone != "" {
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return PreviewResult{}, fmt.Errorf("invalid timezone %q: %w", spec.Timezone, err)
+		}
+	}
+	schedules := spec.GetSchedulesWithTimezone(ctx)
+	if len(schedules) == 0 {
+		return PreviewResult{}, fmt.Errorf("at least one schedule must be specified")
+	}
+	cronSchedules := make([]cron.Schedule, len(schedules))
+	for i, schedule := range schedules {
+		cronSchedule, err := ParseCronSchedule(schedule)
+		if err != nil {
+			return PreviewResult{}, fmt.Errorf("failed to parse schedule %q: %w", schedule, err)
+		}
+		cronSchedules[i] = cronSchedule
+	}
+	result := PreviewResult{
+		Timezone:    spec.Timezone,
+		Schedules:   schedules,
+		Schedulable: cwf.IsSchedulable(),
+		NextRuns:    []PreviewRun{},
+	}
+	if spec.Schedule != "" {
+		result.Deprecations = append(result.Deprecations, "'schedule' is deprecated, use 'schedules' instead")
+	}
+	if spec.HasBothSchedules() {
+		result.Deprecations = append(result.Deprecations, "both 'schedule' and 'schedules' are set, 'schedules' is ignored")
+	}
+	next := make([]time.Time, len(cronSchedules))
+	for i, cronSchedule := range cronSchedules {
+		next[i] = cronSchedule.Next(now)
+	}
+	for len(result.NextRuns) < previewRunCount {
+		var earliest time.Time
+		for _, t := range next {
+			if !t.IsZero() && (earliest.IsZero() || t.Before(earliest)) {
+				earliest = t
+			}
+		}
+		if earliest.IsZero() {
+			break
+		}
+		run := PreviewRun{Time: metav1.Time{Time: earliest}}
+		for i, t := range next {
+			if t.Equal(earliest) {
+				run.Schedules = append(run.Schedules, schedules[i])
+				next[i] = cronSchedules[i].Next(t)
+			}
+		}
+		result.NextRuns = append(result.NextRuns, run)
+	}
+	return result, nil
+}
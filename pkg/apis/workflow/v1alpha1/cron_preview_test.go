@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewCronWorkflow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+
+	t.Run("NextRuns", func(t *testing.T) {
+		cwf := &CronWorkflow{Spec: CronWorkflowSpec{Schedules: []string{"0 * * * *", "0 14 * * *"}, Timezone: "UTC"}}
+		result, err := previewCronWorkflow(ctx, cwf, now)
+		require.NoError(t, err)
+		assert.True(t, result.Schedulable)
+		assert.Empty(t, result.Deprecations)
+		assert.Equal(t, []string{"CRON_TZ=UTC 0 * * * *", "CRON_TZ=UTC 0 14 * * *"}, result.Schedules)
+		require.Len(t, result.NextRuns, previewRunCount)
+		assert.Equal(t, time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC), result.NextRuns[0].Time.UTC())
+		assert.Equal(t, []string{"CRON_TZ=UTC 0 * * * *"}, result.NextRuns[0].Schedules)
+		assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), result.NextRuns[1].Time.UTC())
+		assert.Equal(t, result.Schedules, result.NextRuns[1].Schedules)
+		assert.Equal(t, time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC), result.NextRuns[9].Time.UTC())
+		_, err = json.Marshal(result)
+		require.NoError(t, err)
+	})
+
+	t.Run("Deprecations", func(t *testing.T) {
+		cwf := &CronWorkflow{Spec: CronWorkflowSpec{Schedule: "@daily", Schedules: []string{"@hourly"}, Suspend: true}}
+		result, err := previewCronWorkflow(ctx, cwf, now)
+		require.NoError(t, err)
+		assert.False(t, result.Schedulable)
+		assert.Equal(t, []string{"@daily"}, result.Schedules)
+		assert.Len(t, result.Deprecations, 2)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := previewCronWorkflow(ctx, &CronWorkflow{Spec: CronWorkflowSpec{Schedules: []string{"* * * *"}}}, now)
+		require.ErrorContains(t, err, `failed to parse schedule "* * * *"`)
+		_, err = previewCronWorkflow(ctx, &CronWorkflow{Spec: CronWorkflowSpec{Schedules: []string{"* * * * *"}, Timezone: "Nowhere/Special"}}, now)
+		require.ErrorContains(t, err, `invalid timezone "Nowhere/Special"`)
+		_, err = previewCronWorkflow(ctx, &CronWorkflow{}, now)
+		require.EqualError(t, err, "at least one schedule must be specified")
+	})
+}
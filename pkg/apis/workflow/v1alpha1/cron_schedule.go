@@ -0,0 +1,579 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ParseCronSchedule parses a schedule in standard cron format, optionally prefixed with `CRON_TZ=` or `TZ=`, exactly
+// as the controller does. The `L`, `W` and `#` operators supported by some other cron implementations are not
+// supported, and are rejected with a clear error because the underlying parser's error is obscure.
+func ParseCronSchedule(schedule string) (s cron.Schedule, err error) {
+	if op := unsupportedCronOperator(schedule); op != "" {
+		return nil, fmt.Errorf("the '%s' operator is not supported", op)
+	}
+	// the parser panics on some malformed timezone prefixes, e.g. `TZ=`
+	defer func() {
+		if r := recover(); r != nil {
+			s, err = nil, fmt.Errorf("failed to parse %q: %v", schedule, r)
+		}
+	}()
+	return cron.ParseStandard(schedule)
+}
+
+// FireTimesForDay returns the times, sorted and without duplicates, at which any of the schedules fire on the calendar
+// day of day in the CronWorkflow's timezone. The day starts and ends at local midnight, so it may be 23 or 25 hours
+// long across a DST transition, and local times that are skipped or repeated by the transition fire as the
+// controller would fire them.
+func (c *CronWorkflowSpec) FireTimesForDay(ctx context.Context, day time.Time) ([]time.Time, error) {
+	loc := time.Local
+	if c.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(c.Timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	y, m, d := day.In(loc).Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	end := time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	var times []time.Time
+	for _, schedule := range c.GetSchedulesWithTimezone(ctx) {
+		cronSchedule, err := ParseCronSchedule(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schedule %q: %w", schedule, err)
+		}
+		// Next returns times strictly after its argument, so start just before midnight to include it
+		for t := cronSchedule.Next(start.Add(-time.Nanosecond)); !t.IsZero() && t.Before(end); t = cronSchedule.Next(t) {
+			times = append(times, t)
+		}
+	}
+	slices.SortFunc(times, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(times, time.Time.Equal), nil
+}
+
+// ParsedSchedules parses the schedules, with their timezone, in the order GetSchedulesWithTimezone returns them, so that
+// callers that evaluate them repeatedly can parse them once. Only the standard five field format is supported.
+func (c *CronWorkflowSpec) ParsedSchedules(ctx context.Context) ([]cron.Schedule, error) {
+	schedules := c.GetSchedulesWithTimezone(ctx)
+	parsed := make([]cron.Schedule, len(schedules))
+	for i, schedule := range schedules {
+		cronSchedule, err := ParseCronSchedule(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schedule %q: %w", schedule, err)
+		}
+		parsed[i] = cronSchedule
+	}
+	return parsed, nil
+}
+
+// NextRunTime returns the earliest time after after at which any of the schedules fires, regardless of whether the
+// CronWorkflow is suspended or stopped. It returns the zero time if none of the schedules fires again.
+func (c *CronWorkflowSpec) NextRunTime(ctx context.Context, after time.Time) (time.Time, error) {
+	cronSchedules, err := c.ParsedSchedules(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var next time.Time
+	for _, cronSchedule := range cronSchedules {
+		if t := cronSchedule.Next(after); !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	return next, nil
+}
+
+// UnschedulableSchedules returns the schedules, as written, that do not fire within lookahead from now, such as
+// `0 0 30 2 *` since February never has 30 days. Schedules are only searched up to five years ahead, so a longer
+// lookahead has the same result.
+func (c *CronWorkflowSpec) UnschedulableSchedules(ctx context.Context, now time.Time, lookahead time.Duration) ([]string, error) {
+	cronSchedules, err := c.ParsedSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedules := c.GetSchedules(ctx)
+	var unschedulable []string
+	for i, cronSchedule := range cronSchedules {
+		if next := cronSchedule.Next(now); next.IsZero() || next.After(now.Add(lookahead)) {
+			unschedulable = append(unschedulable, schedules[i])
+		}
+	}
+	return unschedulable, nil
+}
+
+// Lateness returns how late a run scheduled at scheduledTime is at now, to the second. It is zero if now is not after
+// scheduledTime.
+func Lateness(scheduledTime, now time.Time) time.Duration {
+	if !now.After(scheduledTime) {
+		return 0
+	}
+	return now.Sub(scheduledTime).Truncate(time.Second)
+}
+
+// TruncateToScheduleResolution truncates t to the resolution the schedules fire at, so that a time recorded for a run
+// lines up with the schedules' fire times. Cron expressions and schedule windows fire on whole minutes, but `@every`
+// schedules fire a whole number of seconds after they were added, so if any schedule is an `@every` the resolution is
+// a second.
+func (c *CronWorkflowSpec) TruncateToScheduleResolution(t time.Time) time.Time {
+	return t.Truncate(c.scheduleResolution())
+}
+
+func (c *CronWorkflowSpec) scheduleResolution() time.Duration {
+	schedules := c.Schedules
+	if c.Schedule != "" {
+		schedules = []string{c.Schedule}
+	}
+	for _, schedule := range schedules {
+		if strings.HasPrefix(withoutTimezone(schedule), "@every ") {
+			return time.Second
+		}
+	}
+	return time.Minute
+}
+
+// FiresAt returns whether any schedule fires at exactly t and, if so, the schedule that owns the run as written, see
+// MatchScheduleAt.
+func (c *CronWorkflowSpec) FiresAt(ctx context.Context, t time.Time) (bool, string, error) {
+	schedule, _, ok, err := c.matchScheduleAt(ctx, t)
+	return ok, schedule, err
+}
+
+// MatchScheduleAt returns the schedule, as written, and its index in GetSchedules that owns a run at t, for per
+// schedule attribution such as concurrency and history. If several schedules fire at t, the one with the lowest index
+// owns the run, so that it is always attributed to the same schedule. Like the controller, schedules fire on whole
+// seconds, so a t with a fractional second never matches. It returns false if no schedule fires at t, or if the
+// schedules cannot be parsed.
+func (c *CronWorkflowSpec) MatchScheduleAt(ctx context.Context, t time.Time) (string, int, bool) {
+	schedule, i, ok, _ := c.matchScheduleAt(ctx, t)
+	return schedule, i, ok
+}
+
+func (c *CronWorkflowSpec) matchScheduleAt(ctx context.Context, t time.Time) (string, int, bool, error) {
+	cronSchedules, err := c.ParsedSchedules(ctx)
+	if err != nil {
+		return "", -1, false, err
+	}
+	schedules := c.GetSchedules(ctx)
+	for i, cronSchedule := range cronSchedules {
+		if firesAt(cronSchedule, t) {
+			return schedules[i], i, true, nil
+		}
+	}
+	return "", -1, false, nil
+}
+
+// firesAt returns true if cronSchedule fires at exactly t
+func firesAt(cronSchedule cron.Schedule, t time.Time) bool {
+	// Next returns times strictly after its argument
+	return cronSchedule.Next(t.Add(-time.Second)).Equal(t)
+}
+
+// NextRunTimesBySchedule returns the next n times after from at which each schedule fires, keyed by the schedule as
+// written, e.g. to show which schedule contributes which runs. A time at which several schedules fire is listed for
+// each of them. Schedules with their own CRON_TZ= or TZ= prefix are evaluated in that timezone.
+func (c *CronWorkflowSpec) NextRunTimesBySchedule(ctx context.Context, from time.Time, n int) (map[string][]time.Time, error) {
+	cronSchedules, err := c.ParsedSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedules := c.GetSchedules(ctx)
+	times := make(map[string][]time.Time, len(schedules))
+	for i, cronSchedule := range cronSchedules {
+		if _, ok := times[schedules[i]]; ok {
+			continue
+		}
+		next := []time.Time{}
+		for t := cronSchedule.Next(from); !t.IsZero() && len(next) < n; t = cronSchedule.Next(t) {
+			next = append(next, t)
+		}
+		times[schedules[i]] = next
+	}
+	return times, nil
+}
+
+// RunTimeFilter returns whether the CronWorkflow would run at a time one of its schedules fires, e.g. by evaluating its
+// when expression
+type RunTimeFilter func(t time.Time) (bool, error)
+
+// maxRunTimeCandidates limits how many fire times NextRunTimes considers, so that a filter that is never true does not
+// make it search forever
+const maxRunTimeCandidates = 10000
+
+// NextRunTimes returns up to n of the next times after from at which any of the schedules fires. If filter is set,
+// only the times it returns true for are returned, e.g. to preview a CronWorkflow whose when expression gates some
+// runs. At most 10000 fire times are considered, so fewer than n times may be returned.
+func (c *CronWorkflowSpec) NextRunTimes(ctx context.Context, from time.Time, n int, filter RunTimeFilter) ([]time.Time, error) {
+	cronSchedules, err := c.ParsedSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	times := []time.Time{}
+	t := from
+	for i := 0; i < maxRunTimeCandidates && len(times) < n; i++ {
+		var next time.Time
+		for _, cronSchedule := range cronSchedules {
+			if fire := cronSchedule.Next(t); !fire.IsZero() && (next.IsZero() || fire.Before(next)) {
+				next = fire
+			}
+		}
+		if next.IsZero() {
+			break
+		}
+		t = next
+		if filter != nil {
+			run, err := filter(t)
+			if err != nil {
+				return nil, fmt.Errorf("failed to filter run at %s: %w", t, err)
+			}
+			if !run {
+				continue
+			}
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
+
+// SchedulesAfter returns up to limit of the times after cursor at which any of the schedules fires, and the cursor to
+// pass to get the times after them, e.g. to page through a CronWorkflow's upcoming runs. Each time is returned on
+// exactly one page. At most 10000 times are returned per page, and the next cursor is zero if there are no more times.
+func (c *CronWorkflowSpec) SchedulesAfter(ctx context.Context, cursor time.Time, limit int) ([]time.Time, time.Time, error) {
+	if limit <= 0 {
+		return []time.Time{}, cursor, nil
+	}
+	limit = min(limit, maxRunTimeCandidates)
+	times, err := c.NextRunTimes(ctx, cursor, limit, nil)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(times) < limit {
+		return times, time.Time{}, nil
+	}
+	return times, times[len(times)-1], nil
+}
+
+// ErrTooManyBackfillRuns is returned by BackfillTimes when more runs were missed than it may return
+var ErrTooManyBackfillRuns = errors.New("too many runs to backfill")
+
+// BackfillTimes returns the times after from, and up to and including to, at which any of the schedules fires, e.g.
+// to backfill every run missed while the controller was down. Unlike catching up on the last missed run, each of
+// these is a run. If there are more than maxRuns times, it returns ErrTooManyBackfillRuns, so that a large window is not
+// backfilled without confirmation.
+func (c *CronWorkflowSpec) BackfillTimes(ctx context.Context, from, to time.Time, maxRuns int) ([]time.Time, error) {
+	cronSchedules, err := c.ParsedSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	times := []time.Time{}
+	for t := from; ; {
+		var next time.Time
+		for _, cronSchedule := range cronSchedules {
+			if fire := cronSchedule.Next(t); !fire.IsZero() && (next.IsZero() || fire.Before(next)) {
+				next = fire
+			}
+		}
+		if next.IsZero() || next.After(to) {
+			return times, nil
+		}
+		if len(times) == maxRuns {
+			return nil, fmt.Errorf("%w: more than %d runs between %s and %s", ErrTooManyBackfillRuns, maxRuns, from, to)
+		}
+		times = append(times, next)
+		t = next
+	}
+}
+
+// nominalPeriodSpan and nominalPeriodMaxFires bound the fires NominalPeriod samples: long enough to include a leap
+// year and every month and weekday, or enough fires to include every hour of a week of a schedule that fires each minute
+const (
+	nominalPeriodSpan     = 4*366*24*time.Hour + 24*time.Hour
+	nominalPeriodMaxFires = 10080
+)
+
+// NominalPeriod estimates the interval between consecutive fires of the only schedule, e.g. 5m for `*/5 * * * *`, for
+// features that are a fraction or multiple of it. It returns false if the schedule is irregular, such as
+// `0 9 * * 1-5` which fires 24h and 72h apart, or if there are several schedules or a schedule window. Fires are
+// sampled from a fixed date, so the result does not depend on when it is called, and intervals across a DST transition
+// are ignored, so that `0 9 * * *` is 24h in any timezone.
+func (c *CronWorkflowSpec) NominalPeriod(ctx context.Context) (time.Duration, bool) {
+	if c.ScheduleCount() != 1 || c.ScheduleWindow != nil {
+		return 0, false
+	}
+	cronSchedules, err := c.ParsedSchedules(ctx)
+	if err != nil {
+		return 0, false
+	}
+	start := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if spec, ok := cronSchedules[0].(*cron.SpecSchedule); ok {
+		// fires are returned in the location of the time they follow, which must be the schedule's to see its offset
+		start = start.In(spec.Location)
+	}
+	var period time.Duration
+	intervals := 0
+	prev := cronSchedules[0].Next(start)
+	for range nominalPeriodMaxFires {
+		next := cronSchedules[0].Next(prev)
+		if prev.IsZero() || next.IsZero() || next.Sub(start) > nominalPeriodSpan {
+			break
+		}
+		_, prevOffset := prev.Zone()
+		_, nextOffset := next.Zone()
+		if prevOffset == nextOffset {
+			if interval := next.Sub(prev); period == 0 {
+				period = interval
+			} else if interval != period {
+				return 0, false
+			}
+			intervals++
+		}
+		prev = next
+	}
+	// a single interval is not enough to tell that the schedule is regular
+	return period, intervals > 1
+}
+
+// NextEffectiveRun returns the next time after now at which the CronWorkflow will run, for status reporting. It
+// returns nil if the CronWorkflow is suspended or stopped, since it will not run until that changes, or if none of
+// its schedules fires again.
+func (c *CronWorkflow) NextEffectiveRun(ctx context.Context, now time.Time) (*time.Time, error) {
+	if !c.IsSchedulable() {
+		return nil, nil
+	}
+	next, err := c.Spec.NextRunTime(ctx, now)
+	if err != nil || next.IsZero() {
+		return nil, err
+	}
+	return &next, nil
+}
+
+// windowSchedule fires once a day at a minute within [start, end) chosen by hashing the seed and the date, so that
+// the minute is random across days and seeds but always the same for a given seed and day.
+// +k8s:openapi-gen=false
+type windowSchedule struct {
+	start, end int // minutes since midnight
+	loc        *time.Location
+	seed       string
+}
+
+// ParseScheduleWindow returns a schedule that fires once a day within window, in timezone (or the local time if it is
+// empty). The minute it fires at on each day is derived from seed, which should be unique to the CronWorkflow, e.g.
+// its UID, so that CronWorkflows with the same window are spread across it.
+func ParseScheduleWindow(window ScheduleWindow, timezone, seed string) (cron.Schedule, error) {
+	start, err := parseTimeOfDay(window.Start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start: %w", err)
+	}
+	end, err := parseTimeOfDay(window.End)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end: %w", err)
+	}
+	if end <= start {
+		return nil, fmt.Errorf("end %s must be after start %s", window.End, window.Start)
+	}
+	loc := time.Local
+	if timezone != "" {
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, err
+		}
+	}
+	return &windowSchedule{start: start, end: end, loc: loc, seed: seed}, nil
+}
+
+func parseTimeOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a time of day in HH:MM format", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Next returns the first time after t that the window fires
+func (s *windowSchedule) Next(t time.Time) time.Time {
+	y, m, d := t.In(s.loc).Date()
+	// a day's window may fire before t, in which case it is the next day's. The extra day allows for DST transitions.
+	for i := 0; i < 3; i++ {
+		if next := s.fireTime(y, m, d+i); next.After(t) {
+			return next
+		}
+	}
+	return time.Time{}
+}
+
+// fireTime returns when the window fires on the given day. Unlike a cron schedule, which skips a time that does not
+// exist because of a DST transition, a time in the window that does not exist is moved forward by the transition so
+// that it still runs that day.
+func (s *windowSchedule) fireTime(y int, m time.Month, d int) time.Time {
+	day := time.Date(y, m, d, 0, 0, 0, 0, s.loc)
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s.seed + "/" + day.Format(time.DateOnly)))
+	minute := s.start + int(h.Sum64()%uint64(s.end-s.start))
+	return time.Date(y, m, d, 0, minute, 0, 0, s.loc)
+}
+
+// GetScheduleWindow returns the schedule for Spec.ScheduleWindow, seeded with the CronWorkflow's UID, or nil if it is
+// not set
+func (c *CronWorkflow) GetScheduleWindow() (cron.Schedule, error) {
+	if c.Spec.ScheduleWindow == nil {
+		return nil, nil
+	}
+	return ParseScheduleWindow(*c.Spec.ScheduleWindow, c.Spec.Timezone, string(c.UID))
+}
+
+// ScheduleFingerprint returns a short hash of the schedules and timezone that is the same for any spec that fires at
+// the same times, e.g. for a metric label or to detect schedule changes. Schedules are normalized, and their order
+// and duplicates do not matter.
+func (c *CronWorkflowSpec) ScheduleFingerprint() string {
+	schedules := c.Schedules
+	if c.Schedule != "" {
+		schedules = []string{c.Schedule}
+	}
+	var normalized []string
+	for _, schedule := range schedules {
+		schedule = NormalizeCronSchedule(c.withTimezone(schedule))
+		if rest, ok := strings.CutPrefix(schedule, "TZ="); ok {
+			schedule = "CRON_TZ=" + rest
+		}
+		normalized = append(normalized, schedule)
+	}
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+	if w := c.ScheduleWindow; w != nil {
+		normalized = append(normalized, fmt.Sprintf("window %s-%s %s", w.Start, w.End, c.Timezone))
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strings.Join(normalized, "\n")))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+func unsupportedCronOperator(schedule string) string {
+	fields := strings.Fields(schedule)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		fields = fields[1:]
+	}
+	if len(fields) != 5 {
+		// descriptors such as @daily, or malformed schedules the parser reports on
+		return ""
+	}
+	dayOfMonth, dayOfWeek := fields[2], fields[4]
+	if strings.Contains(dayOfWeek, "#") {
+		return "#"
+	}
+	// month names are not allowed in the day-of-month field, so any letter is an operator
+	for _, op := range []string{"L", "W"} {
+		if strings.Contains(strings.ToUpper(dayOfMonth), op) {
+			return op
+		}
+	}
+	// day names are allowed in the day-of-week field, so only look for `L` and `<n>L`
+	for _, elem := range strings.Split(strings.ToUpper(dayOfWeek), ",") {
+		if n, ok := strings.CutSuffix(elem, "L"); ok && strings.Trim(n, "0123456789") == "" {
+			return "L"
+		}
+	}
+	return ""
+}
+
+var (
+	cronMonthNames = map[string]string{
+		"JAN": "1", "FEB": "2", "MAR": "3", "APR": "4", "MAY": "5", "JUN": "6",
+		"JUL": "7", "AUG": "8", "SEP": "9", "OCT": "10", "NOV": "11", "DEC": "12",
+	}
+	cronDayNames = map[string]string{
+		"SUN": "0", "MON": "1", "TUE": "2", "WED": "3", "THU": "4", "FRI": "5", "SAT": "6",
+	}
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// NormalizeCronSchedule returns a canonical form of schedule that fires at exactly the same times, so that equivalent
+// schedules compare equal: whitespace is collapsed, leading zeros are removed, month and day names in the month and
+// day-of-week fields are replaced by numbers, `?` is replaced by `*` and descriptors such as `@daily` are expanded. Any
+// `CRON_TZ=` or `TZ=` prefix is kept as is. Schedules joined with commas are split as GetLatestScheduleList splits
+// them, and normalized one by one, so normalizing them is the same as joining the normalized schedules.
+func NormalizeCronSchedule(schedule string) string {
+	schedules := splitSchedules(schedule)
+	for i, schedule := range schedules {
+		schedules[i] = normalizeCronSchedule(schedule)
+	}
+	return strings.Join(schedules, ",")
+}
+
+// normalizeCronSchedule normalizes a single schedule
+func normalizeCronSchedule(schedule string) string {
+	fields := strings.Fields(schedule)
+	exprFields := fields
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		exprFields = fields[1:]
+	}
+	if len(exprFields) == 1 {
+		if v, ok := cronDescriptors[exprFields[0]]; ok {
+			exprFields[0] = v
+			return strings.Join(fields, " ")
+		}
+	}
+	if len(exprFields) > 0 && strings.HasPrefix(exprFields[0], "@") {
+		// e.g. `@every 1h`, whose duration is not a cron field
+		return strings.Join(fields, " ")
+	}
+	for i, field := range exprFields {
+		// names are only allowed in the month and day-of-week fields
+		var names map[string]string
+		switch i {
+		case 3:
+			names = cronMonthNames
+		case 4:
+			names = cronDayNames
+		}
+		elems := strings.Split(field, ",")
+		for j, elem := range elems {
+			elems[j] = normalizeCronElement(elem, names)
+		}
+		exprFields[i] = strings.Join(elems, ",")
+	}
+	return strings.Join(fields, " ")
+}
+
+// normalizeCronElement normalizes a single range or step expression, e.g. `MON-FRI` or `*/05`
+func normalizeCronElement(elem string, names map[string]string) string {
+	var sb strings.Builder
+	start := 0
+	for i := 0; i <= len(elem); i++ {
+		if i < len(elem) && elem[i] != '-' && elem[i] != '/' {
+			continue
+		}
+		sb.WriteString(normalizeCronValue(elem[start:i], names))
+		if i < len(elem) {
+			sb.WriteByte(elem[i])
+		}
+		start = i + 1
+	}
+	return sb.String()
+}
+
+func normalizeCronValue(v string, names map[string]string) string {
+	if v == "?" {
+		return "*"
+	}
+	if n, ok := names[strings.ToUpper(v)]; ok {
+		return n
+	}
+	if n, err := strconv.ParseUint(v, 10, 32); err == nil && strings.Trim(v, "0123456789") == "" {
+		return strconv.FormatUint(n, 10)
+	}
+	return v
+}
@@ -0,0 +1,533 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	for _, schedule := range []string{"* * * * *", "0 9 * * MON-FRI", "0 0 1 JUL SAT,SUN", "CRON_TZ=America/Los_Angeles 0 9 * * 1", "@daily"} {
+		_, err := ParseCronSchedule(schedule)
+		require.NoError(t, err, schedule)
+	}
+	for schedule, op := range map[string]string{
+		"0 9 * * 1#2":             "#",
+		"0 0 L * *":               "L",
+		"0 0 * * 5L":              "L",
+		"0 0 15W * *":             "W",
+		"TZ=Asia/Tokyo 0 9 * * L": "L",
+	} {
+		_, err := ParseCronSchedule(schedule)
+		require.EqualError(t, err, "the '"+op+"' operator is not supported", schedule)
+	}
+	_, err := ParseCronSchedule("0 0 * *")
+	require.Error(t, err)
+	_, err = ParseCronSchedule("TZ=")
+	require.Error(t, err)
+}
+
+func TestNormalizeCronSchedule(t *testing.T) {
+	for schedule, normalized := range map[string]string{
+		"0 0 * * *":                        "0 0 * * *",
+		"00 00 * * *":                      "0 0 * * *",
+		"  0  0 *  * * ":                   "0 0 * * *",
+		"*/05 1-05 ? JAN-MAR mon,FRI":      "*/5 1-5 * 1-3 1,5",
+		"@daily":                           "0 0 * * *",
+		"CRON_TZ=Asia/Tokyo 00 09 * * SUN": "CRON_TZ=Asia/Tokyo 0 9 * * 0",
+		"@every 05m":                       "@every 05m",
+		"0 * * * *,00 1 * * *":             "0 * * * *,0 1 * * *",
+		// joined schedules with timezone prefixes, descriptors and lists in the last field
+		"0 0 * * MON,CRON_TZ=UTC 0 0 * * *":                 "0 0 * * 1,CRON_TZ=UTC 0 0 * * *",
+		"TZ=UTC 0 0 * JAN MON,FRI,CRON_TZ=UTC 00 * * * SUN": "TZ=UTC 0 0 * 1 1,5,CRON_TZ=UTC 0 * * * 0",
+		"0 0 * * MON,@daily":                                "0 0 * * 1,0 0 * * *",
+		"CRON_TZ=Asia/Tokyo @daily,@every 05m":              "CRON_TZ=Asia/Tokyo 0 0 * * *,@every 05m",
+		// names are only replaced in the month and day-of-week fields
+		"0 0 MON JAN SUN": "0 0 MON 1 0",
+		"0 0 * SUN JAN":   "0 0 * SUN JAN",
+	} {
+		assert.Equal(t, normalized, NormalizeCronSchedule(schedule), schedule)
+	}
+}
+
+func FuzzNormalizeCronSchedule(f *testing.F) {
+	for _, schedule := range []string{"00 00 * * *", "*/05 1-05 ? JAN-MAR mon,FRI", "@daily", "CRON_TZ=Asia/Tokyo 0 9 * * SUN", "@every 1h", "0 0 1,15 * 1-5/2", "0 0 * * MON,CRON_TZ=UTC 0 0 * * *", "TZ=UTC 0 0 * JAN MON,FRI,00 * * * SUN"} {
+		f.Add(schedule)
+	}
+	starts := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 13, 37, 0, 0, time.UTC),
+		time.Date(2024, 11, 3, 8, 59, 59, 0, time.UTC),
+	}
+	f.Fuzz(func(t *testing.T, schedule string) {
+		normalized := NormalizeCronSchedule(schedule)
+		assert.Equal(t, normalized, NormalizeCronSchedule(normalized), "normalization must be idempotent")
+		original, err := ParseCronSchedule(schedule)
+		if err != nil {
+			return
+		}
+		parsed, err := ParseCronSchedule(normalized)
+		require.NoError(t, err, "normalized %q to %q", schedule, normalized)
+		for _, start := range starts {
+			assert.Equal(t, original.Next(start), parsed.Next(start), "normalized %q to %q", schedule, normalized)
+		}
+	})
+}
+
+func TestFireTimesForDay(t *testing.T) {
+	ctx := context.Background()
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	day := func(month time.Month, d int) time.Time { return time.Date(2024, month, d, 12, 0, 0, 0, ny) }
+
+	t.Run("Dedupe", func(t *testing.T) {
+		spec := CronWorkflowSpec{Timezone: "America/New_York", Schedules: []string{"0 12 * * *", "0 */6 * * *"}}
+		times, err := spec.FireTimesForDay(ctx, day(time.June, 1))
+		require.NoError(t, err)
+		require.Len(t, times, 4)
+		for i, hour := range []int{0, 6, 12, 18} {
+			assert.Equal(t, time.Date(2024, time.June, 1, hour, 0, 0, 0, ny), times[i])
+		}
+	})
+	t.Run("SpringForward", func(t *testing.T) {
+		spec := CronWorkflowSpec{Timezone: "America/New_York", Schedules: []string{"0 * * * *"}}
+		times, err := spec.FireTimesForDay(ctx, day(time.March, 10))
+		require.NoError(t, err)
+		assert.Len(t, times, 23)
+		assert.Equal(t, time.Date(2024, time.March, 10, 0, 0, 0, 0, ny), times[0])
+		assert.Equal(t, time.Date(2024, time.March, 10, 23, 0, 0, 0, ny), times[22])
+	})
+	t.Run("FallBack", func(t *testing.T) {
+		spec := CronWorkflowSpec{Timezone: "America/New_York", Schedules: []string{"0 * * * *"}}
+		times, err := spec.FireTimesForDay(ctx, day(time.November, 3))
+		require.NoError(t, err)
+		assert.Len(t, times, 25)
+		assert.Equal(t, time.Hour, times[2].Sub(times[1]), "1am is repeated")
+	})
+	t.Run("OtherTimezone", func(t *testing.T) {
+		spec := CronWorkflowSpec{Timezone: "America/New_York", Schedules: []string{"0 0 * * *"}}
+		times, err := spec.FireTimesForDay(ctx, time.Date(2024, time.June, 2, 1, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{time.Date(2024, time.June, 1, 0, 0, 0, 0, ny)}, times)
+	})
+	t.Run("InvalidTimezone", func(t *testing.T) {
+		spec := CronWorkflowSpec{Timezone: "Nowhere/Special", Schedules: []string{"0 0 * * *"}}
+		_, err := spec.FireTimesForDay(ctx, day(time.June, 1))
+		require.Error(t, err)
+	})
+}
+
+func TestNextEffectiveRun(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.June, 1, 10, 30, 0, 0, time.UTC)
+	cron := func() *CronWorkflow {
+		return &CronWorkflow{Spec: CronWorkflowSpec{Timezone: "UTC", Schedules: []string{"0 12 * * *", "0 * * * *"}}}
+	}
+
+	t.Run("Schedulable", func(t *testing.T) {
+		next, err := cron().NextEffectiveRun(ctx, now)
+		require.NoError(t, err)
+		require.NotNil(t, next)
+		assert.Equal(t, time.Date(2024, time.June, 1, 11, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("OnFireTime", func(t *testing.T) {
+		next, err := cron().NextEffectiveRun(ctx, time.Date(2024, time.June, 1, 11, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.NotNil(t, next)
+		assert.Equal(t, time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("Suspended", func(t *testing.T) {
+		c := cron()
+		c.Spec.Suspend = true
+		next, err := c.NextEffectiveRun(ctx, now)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+	})
+	t.Run("Stopped", func(t *testing.T) {
+		c := cron()
+		c.Status.Phase = StoppedPhase
+		next, err := c.NextEffectiveRun(ctx, now)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+	})
+	t.Run("NeverFiresAgain", func(t *testing.T) {
+		c := cron()
+		c.Spec.Schedules = []string{"0 0 30 2 *"}
+		next, err := c.NextEffectiveRun(ctx, now)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+	})
+	t.Run("Malformed", func(t *testing.T) {
+		c := cron()
+		c.Spec.Schedules = []string{"not a schedule"}
+		_, err := c.NextEffectiveRun(ctx, now)
+		require.Error(t, err)
+	})
+}
+
+func TestParseScheduleWindow(t *testing.T) {
+	window := ScheduleWindow{Start: "01:00", End: "03:00"}
+	day := func(d, hour int) time.Time { return time.Date(2024, time.June, d, hour, 0, 0, 0, time.UTC) }
+
+	t.Run("WithinWindow", func(t *testing.T) {
+		schedule, err := ParseScheduleWindow(window, "UTC", "my-uid")
+		require.NoError(t, err)
+		for d := 1; d <= 30; d++ {
+			next := schedule.Next(day(d, 0))
+			assert.Equal(t, d, next.Day())
+			assert.False(t, next.Before(day(d, 1)), next)
+			assert.True(t, next.Before(day(d, 3)), next)
+			assert.Zero(t, next.Second())
+			assert.Equal(t, next.Add(24*time.Hour).Day(), schedule.Next(next).Day(), "fires once a day")
+		}
+	})
+	t.Run("DeterministicPerDay", func(t *testing.T) {
+		schedule, err := ParseScheduleWindow(window, "UTC", "my-uid")
+		require.NoError(t, err)
+		restarted, err := ParseScheduleWindow(window, "UTC", "my-uid")
+		require.NoError(t, err)
+		next := schedule.Next(day(1, 0))
+		assert.Equal(t, next, schedule.Next(day(1, 0)))
+		assert.Equal(t, next, restarted.Next(day(1, 0)))
+		assert.Equal(t, next, restarted.Next(next.Add(-time.Minute)), "the time does not depend on when it is asked for")
+		assert.Equal(t, next.Add(24*time.Hour).Day(), restarted.Next(next).Day())
+	})
+	t.Run("VariesByDayAndSeed", func(t *testing.T) {
+		schedule, err := ParseScheduleWindow(window, "UTC", "my-uid")
+		require.NoError(t, err)
+		other, err := ParseScheduleWindow(window, "UTC", "other-uid")
+		require.NoError(t, err)
+		minutes := map[int]bool{}
+		differs := false
+		for d := 1; d <= 30; d++ {
+			next := schedule.Next(day(d, 0))
+			minutes[next.Hour()*60+next.Minute()] = true
+			differs = differs || !next.Equal(other.Next(day(d, 0)))
+		}
+		assert.Greater(t, len(minutes), 1)
+		assert.True(t, differs)
+	})
+	t.Run("Timezone", func(t *testing.T) {
+		schedule, err := ParseScheduleWindow(window, "Asia/Tokyo", "my-uid")
+		require.NoError(t, err)
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		next := schedule.Next(day(1, 0)).In(tokyo)
+		assert.GreaterOrEqual(t, next.Hour(), 1)
+		assert.Less(t, next.Hour(), 3)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseScheduleWindow(ScheduleWindow{Start: "1am", End: "03:00"}, "", "")
+		require.EqualError(t, err, `invalid start: "1am" is not a time of day in HH:MM format`)
+		_, err = ParseScheduleWindow(ScheduleWindow{Start: "03:00", End: "01:00"}, "", "")
+		require.EqualError(t, err, "end 01:00 must be after start 03:00")
+		_, err = ParseScheduleWindow(window, "Nowhere/Special", "")
+		require.Error(t, err)
+	})
+}
+
+func TestScheduleFingerprint(t *testing.T) {
+	fingerprint := func(spec CronWorkflowSpec) string { return spec.ScheduleFingerprint() }
+	base := fingerprint(CronWorkflowSpec{Schedules: []string{"0 0 * * *", "30 9 * * MON-FRI"}, Timezone: "Asia/Tokyo"})
+	assert.Len(t, base, 8)
+
+	for name, spec := range map[string]CronWorkflowSpec{
+		"Reordered":  {Schedules: []string{"30 9 * * MON-FRI", "0 0 * * *"}, Timezone: "Asia/Tokyo"},
+		"Normalized": {Schedules: []string{"@daily", "30 09 ? * 1-5"}, Timezone: "Asia/Tokyo"},
+		"Duplicated": {Schedules: []string{"0 0 * * *", "0 0 * * *", "30 9 * * MON-FRI"}, Timezone: "Asia/Tokyo"},
+		"Prefixed":   {Schedules: []string{"CRON_TZ=Asia/Tokyo 0 0 * * *", "TZ=Asia/Tokyo 30 9 * * MON-FRI"}},
+	} {
+		assert.Equal(t, base, fingerprint(spec), name)
+	}
+	assert.Equal(t, fingerprint(CronWorkflowSpec{Schedules: []string{"0 0 * * *"}}), fingerprint(CronWorkflowSpec{Schedule: "@midnight"}))
+
+	for name, spec := range map[string]CronWorkflowSpec{
+		"Schedule": {Schedules: []string{"0 1 * * *", "30 9 * * MON-FRI"}, Timezone: "Asia/Tokyo"},
+		"Removed":  {Schedules: []string{"0 0 * * *"}, Timezone: "Asia/Tokyo"},
+		"Timezone": {Schedules: []string{"0 0 * * *", "30 9 * * MON-FRI"}, Timezone: "Europe/Paris"},
+		"Window":   {ScheduleWindow: &ScheduleWindow{Start: "01:00", End: "03:00"}, Timezone: "Asia/Tokyo"},
+	} {
+		assert.NotEqual(t, base, fingerprint(spec), name)
+	}
+}
+
+func TestNextRunTimesBySchedule(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2024, time.June, 1, 10, 30, 0, 0, time.UTC)
+	at := func(hour int) time.Time { return time.Date(2024, time.June, 1, hour, 0, 0, 0, time.UTC) }
+	spec := CronWorkflowSpec{Timezone: "UTC", Schedules: []string{"0 * * * *", "0 */2 * * *", "CRON_TZ=Asia/Tokyo 0 0 * * *"}}
+
+	times, err := spec.NextRunTimesBySchedule(ctx, from, 3)
+	require.NoError(t, err)
+	require.Len(t, times, 3)
+	assert.Equal(t, []time.Time{at(11), at(12), at(13)}, utc(times["0 * * * *"]))
+	// 12:00 and 14:00 overlap with the hourly schedule, and are listed for both
+	assert.Equal(t, []time.Time{at(12), at(14), at(16)}, utc(times["0 */2 * * *"]))
+	// midnight in Tokyo is 15:00 UTC
+	assert.Equal(t, []time.Time{at(15), at(15).AddDate(0, 0, 1), at(15).AddDate(0, 0, 2)}, utc(times["CRON_TZ=Asia/Tokyo 0 0 * * *"]))
+
+	times, err = spec.NextRunTimesBySchedule(ctx, from, 0)
+	require.NoError(t, err)
+	assert.Empty(t, times["0 * * * *"])
+
+	_, err = (&CronWorkflowSpec{Schedules: []string{"0 * * *"}}).NextRunTimesBySchedule(ctx, from, 1)
+	require.Error(t, err)
+}
+
+func utc(times []time.Time) []time.Time {
+	for i, t := range times {
+		times[i] = t.UTC()
+	}
+	return times
+}
+
+func TestFiresAt(t *testing.T) {
+	ctx := context.Background()
+	spec := CronWorkflowSpec{Timezone: "UTC", Schedules: []string{"0 * * * *", "30 9 * * *", "CRON_TZ=Asia/Tokyo 0 0 * * *"}}
+	at := func(hour, minute, second, nsec int) time.Time {
+		return time.Date(2024, time.June, 1, hour, minute, second, nsec, time.UTC)
+	}
+	for _, tt := range []struct {
+		t        time.Time
+		fires    bool
+		schedule string
+	}{
+		{at(10, 0, 0, 0), true, "0 * * * *"},
+		{at(9, 30, 0, 0), true, "30 9 * * *"},
+		// midnight in Tokyo, which the hourly schedule also fires at, so that is listed first
+		{at(15, 0, 0, 0), true, "0 * * * *"},
+		{at(10, 0, 0, 0).In(time.FixedZone("", 2*60*60)), true, "0 * * * *"},
+		{at(9, 59, 59, 0), false, ""},
+		{at(10, 0, 1, 0), false, ""},
+		{at(10, 0, 0, 1), false, ""},
+		{at(9, 29, 59, 999999999), false, ""},
+		{at(10, 1, 0, 0), false, ""},
+	} {
+		fires, schedule, err := spec.FiresAt(ctx, tt.t)
+		require.NoError(t, err)
+		assert.Equal(t, tt.fires, fires, tt.t)
+		assert.Equal(t, tt.schedule, schedule, tt.t)
+	}
+
+	tokyo := CronWorkflowSpec{Schedules: []string{"CRON_TZ=Asia/Tokyo 0 0 * * *"}}
+	fires, schedule, err := tokyo.FiresAt(ctx, at(15, 0, 0, 0))
+	require.NoError(t, err)
+	assert.True(t, fires)
+	assert.Equal(t, "CRON_TZ=Asia/Tokyo 0 0 * * *", schedule)
+
+	_, _, err = (&CronWorkflowSpec{Schedules: []string{"0 * * *"}}).FiresAt(ctx, at(10, 0, 0, 0))
+	require.Error(t, err)
+}
+
+func TestMatchScheduleAt(t *testing.T) {
+	ctx := context.Background()
+	at := func(hour, minute int) time.Time { return time.Date(2024, time.June, 1, hour, minute, 0, 0, time.UTC) }
+	spec := CronWorkflowSpec{Timezone: "UTC", Schedules: []string{"*/15 * * * *", "0 * * * *", "0 9 * * *", "30 9 * * *"}}
+	for _, tt := range []struct {
+		t        time.Time
+		schedule string
+		index    int
+	}{
+		{at(9, 15), "*/15 * * * *", 0},
+		// every schedule but the last fires at 09:00, the lowest index owns the run
+		{at(9, 0), "*/15 * * * *", 0},
+		{at(9, 30), "*/15 * * * *", 0},
+	} {
+		schedule, index, ok := spec.MatchScheduleAt(ctx, tt.t)
+		assert.True(t, ok, tt.t)
+		assert.Equal(t, tt.schedule, schedule, tt.t)
+		assert.Equal(t, tt.index, index, tt.t)
+	}
+
+	// reordering the schedules changes the owner, but not whether the run is owned
+	spec.Schedules = []string{"0 9 * * *", "0 * * * *", "*/15 * * * *"}
+	schedule, index, ok := spec.MatchScheduleAt(ctx, at(9, 0))
+	assert.True(t, ok)
+	assert.Equal(t, "0 9 * * *", schedule)
+	assert.Equal(t, 0, index)
+	schedule, index, ok = spec.MatchScheduleAt(ctx, at(10, 0))
+	assert.True(t, ok)
+	assert.Equal(t, "0 * * * *", schedule)
+	assert.Equal(t, 1, index)
+
+	_, index, ok = spec.MatchScheduleAt(ctx, at(9, 1))
+	assert.False(t, ok)
+	assert.Equal(t, -1, index)
+
+	spec.Schedules = []string{"invalid"}
+	_, _, ok = spec.MatchScheduleAt(ctx, at(9, 0))
+	assert.False(t, ok)
+}
+
+func TestTruncateToScheduleResolution(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	reconciled := time.Date(2024, time.June, 1, 10, 30, 42, 123456789, tokyo)
+
+	t.Run("Minute", func(t *testing.T) {
+		for _, spec := range []CronWorkflowSpec{
+			{Schedules: []string{"* * * * *"}},
+			{Schedule: "@hourly", Timezone: "Asia/Tokyo"},
+			{ScheduleWindow: &ScheduleWindow{Start: "01:00", End: "03:00"}},
+		} {
+			truncated := spec.TruncateToScheduleResolution(reconciled)
+			assert.True(t, time.Date(2024, time.June, 1, 10, 30, 0, 0, tokyo).Equal(truncated), spec)
+			assert.Equal(t, truncated, spec.TruncateToScheduleResolution(truncated), "on a boundary already")
+		}
+	})
+	t.Run("Second", func(t *testing.T) {
+		for _, spec := range []CronWorkflowSpec{
+			{Schedules: []string{"0 * * * *", "@every 30s"}},
+			{Schedule: "CRON_TZ=UTC @every 2m"},
+		} {
+			truncated := spec.TruncateToScheduleResolution(reconciled)
+			assert.True(t, time.Date(2024, time.June, 1, 10, 30, 42, 0, tokyo).Equal(truncated), spec)
+		}
+	})
+}
+
+func TestUnschedulableSchedules(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	spec := CronWorkflowSpec{Schedules: []string{"0 * * * *", "0 0 30 2 *", "0 0 31 11 *", "0 0 1 1 *", "0 0 29 2 *"}, Timezone: "Asia/Tokyo"}
+
+	unschedulable, err := spec.UnschedulableSchedules(ctx, now, 5*366*24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0 0 30 2 *", "0 0 31 11 *"}, unschedulable, "February 30th and November 31st never exist")
+
+	unschedulable, err = spec.UnschedulableSchedules(ctx, now, 30*24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0 0 30 2 *", "0 0 31 11 *", "0 0 1 1 *", "0 0 29 2 *"}, unschedulable, "only the hourly schedule fires within 30 days")
+
+	unschedulable, err = (&CronWorkflowSpec{Schedule: "@daily"}).UnschedulableSchedules(ctx, now, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, unschedulable)
+
+	_, err = (&CronWorkflowSpec{Schedules: []string{"0 0 * * 8"}}).UnschedulableSchedules(ctx, now, time.Hour)
+	require.Error(t, err, "an invalid weekday fails to parse")
+}
+
+func TestLateness(t *testing.T) {
+	scheduled := time.Date(2024, time.June, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, 90*time.Second, Lateness(scheduled, scheduled.Add(90*time.Second+400*time.Millisecond)))
+	assert.Equal(t, 2*time.Hour, Lateness(scheduled, scheduled.Add(2*time.Hour)))
+	assert.Zero(t, Lateness(scheduled, scheduled))
+	assert.Zero(t, Lateness(scheduled, scheduled.Add(-time.Minute)), "not late before it is scheduled")
+}
+
+func TestNextRunTimes(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2024, time.June, 1, 10, 30, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time { return time.Date(2024, time.June, 1, hour, minute, 0, 0, time.UTC) }
+	spec := CronWorkflowSpec{Schedules: []string{"0 * * * *", "30 11 * * *"}, Timezone: "UTC"}
+
+	times, err := spec.NextRunTimes(ctx, from, 4, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{at(11, 0), at(11, 30), at(12, 0), at(13, 0)}, utc(times))
+
+	times, err = spec.NextRunTimes(ctx, from, 3, func(t time.Time) (bool, error) { return t.Minute() == 0, nil })
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{at(11, 0), at(12, 0), at(13, 0)}, utc(times))
+
+	times, err = spec.NextRunTimes(ctx, from, 3, func(time.Time) (bool, error) { return false, nil })
+	require.NoError(t, err)
+	assert.Empty(t, times, "the search is limited")
+
+	_, err = spec.NextRunTimes(ctx, from, 3, func(time.Time) (bool, error) { return false, fmt.Errorf("bad expression") })
+	require.EqualError(t, err, "failed to filter run at 2024-06-01 11:00:00 +0000 UTC: bad expression")
+}
+
+func TestSchedulesAfter(t *testing.T) {
+	ctx := context.Background()
+	cursor := time.Date(2024, time.June, 1, 10, 30, 0, 0, time.UTC)
+	spec := CronWorkflowSpec{Schedules: []string{"0 * * * *", "*/20 * * * *", "30 11 * * *"}, Timezone: "UTC"}
+
+	want, err := spec.NextRunTimes(ctx, cursor, 30, nil)
+	require.NoError(t, err)
+	var got []time.Time
+	for len(got) < len(want) {
+		page, next, err := spec.SchedulesAfter(ctx, cursor, 7)
+		require.NoError(t, err)
+		require.Len(t, page, 7)
+		assert.True(t, next.Equal(page[6]))
+		got = append(got, page...)
+		cursor = next
+	}
+	// pages are contiguous, without gaps or duplicates, even where schedules fire at the same time
+	assert.Equal(t, utc(want), utc(got[:len(want)]))
+
+	page, next, err := spec.SchedulesAfter(ctx, cursor, 0)
+	require.NoError(t, err)
+	assert.Empty(t, page)
+	assert.Equal(t, cursor, next)
+
+	spec.Schedules = []string{"0 0 30 2 *"}
+	page, next, err = spec.SchedulesAfter(ctx, cursor, 7)
+	require.NoError(t, err)
+	assert.Empty(t, page)
+	assert.True(t, next.IsZero(), "there are no more times")
+
+	spec.Schedules = []string{"invalid"}
+	_, _, err = spec.SchedulesAfter(ctx, cursor, 7)
+	require.Error(t, err)
+}
+
+func TestBackfillTimes(t *testing.T) {
+	ctx := context.Background()
+	at := func(hour, minute int) time.Time { return time.Date(2024, time.June, 1, hour, minute, 0, 0, time.UTC) }
+	spec := CronWorkflowSpec{Schedules: []string{"0 * * * *", "30 11 * * *", "0 12 * * *"}, Timezone: "UTC"}
+
+	times, err := spec.BackfillTimes(ctx, at(10, 30), at(13, 0), 4)
+	require.NoError(t, err)
+	// the window's end is included, and a time several schedules fire at is one run
+	assert.Equal(t, []time.Time{at(11, 0), at(11, 30), at(12, 0), at(13, 0)}, utc(times))
+
+	times, err = spec.BackfillTimes(ctx, at(11, 0), at(11, 15), 4)
+	require.NoError(t, err)
+	assert.Empty(t, times, "the window's start is not included")
+
+	_, err = spec.BackfillTimes(ctx, at(10, 30), at(14, 0), 4)
+	require.ErrorIs(t, err, ErrTooManyBackfillRuns)
+	assert.EqualError(t, err, "too many runs to backfill: more than 4 runs between 2024-06-01 10:30:00 +0000 UTC and 2024-06-01 14:00:00 +0000 UTC")
+
+	_, err = spec.BackfillTimes(ctx, at(10, 30), at(11, 0), 0)
+	require.ErrorIs(t, err, ErrTooManyBackfillRuns)
+}
+
+func TestNominalPeriod(t *testing.T) {
+	ctx := context.Background()
+	for schedule, expected := range map[string]time.Duration{
+		"*/5 * * * *": 5 * time.Minute,
+		"0 * * * *":   time.Hour,
+		"0 */6 * * *": 6 * time.Hour,
+		"0 9 * * *":   24 * time.Hour,
+		"0 9 * * 1":   7 * 24 * time.Hour,
+		"@every 90m":  90 * time.Minute,
+		"@hourly":     time.Hour,
+	} {
+		for _, timezone := range []string{"", "America/New_York", "Australia/Sydney"} {
+			period, ok := (&CronWorkflowSpec{Schedule: schedule, Timezone: timezone}).NominalPeriod(ctx)
+			assert.True(t, ok, "%s %s", schedule, timezone)
+			assert.Equal(t, expected, period, "%s %s", schedule, timezone)
+		}
+	}
+	for _, spec := range []CronWorkflowSpec{
+		{Schedule: "0 9 * * 1-5"},
+		{Schedule: "*/7 * * * *"},
+		{Schedule: "*/5 0-22 * * *"},
+		{Schedule: "0 0 1 * *"},
+		{Schedule: "0 0 1 1 *"},
+		{Schedule: "0 0 30 2 *"},
+		{Schedule: "invalid"},
+		{Schedules: []string{"*/5 * * * *", "*/10 * * * *"}},
+		{ScheduleWindow: &ScheduleWindow{Start: "09:00", End: "10:00"}},
+		{},
+	} {
+		_, ok := spec.NominalPeriod(ctx)
+		assert.False(t, ok, "%v", spec)
+	}
+}
@@ -2,7 +2,12 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,6 +47,10 @@ const (
 
 const annotationKeyLatestSchedule = workflow.CronWorkflowFullName + "/last-used-schedule"
 
+// AnnotationKeySkipNext, when "true", skips the next scheduled run of the CronWorkflow. The controller removes it once
+// the run has been skipped.
+const AnnotationKeySkipNext = workflow.CronWorkflowFullName + "/skip-next"
+
 // CronWorkflowSpec is the specification of a CronWorkflow
 type CronWorkflowSpec struct {
 	// WorkflowSpec is the spec of the workflow to be run
@@ -69,6 +78,82 @@ type CronWorkflowSpec struct {
 	Schedules []string `json:"schedules,omitempty" protobuf:"bytes,11,opt,name=schedules"`
 	// v3.6 and after: When is an expression that determines if a run should be scheduled.
 	When string `json:"when,omitempty" protobuf:"bytes,12,opt,name=when"`
+	// DeleteAfterStopped is how long to keep the CronWorkflow once its StopStrategy has stopped it. After that it is
+	// deleted, along with any Workflows it owns. It is kept forever if not set.
+	DeleteAfterStopped *metav1.Duration `json:"deleteAfterStopped,omitempty" protobuf:"bytes,13,opt,name=deleteAfterStopped"`
+	// WhenData references a ConfigMap whose data is available to When as `cronworkflow.data.<key>`. Keys that are not
+	// in the ConfigMap resolve to an empty string.
+	WhenData *v1.LocalObjectReference `json:"whenData,omitempty" protobuf:"bytes,14,opt,name=whenData"`
+	// ScheduleStartingDeadlineSeconds overrides StartingDeadlineSeconds for individual schedules, keyed by the schedule
+	// as written in Schedules, e.g. so a daily report can run late but a heartbeat every minute cannot.
+	ScheduleStartingDeadlineSeconds map[string]int64 `json:"scheduleStartingDeadlineSeconds,omitempty" protobuf:"bytes,15,rep,name=scheduleStartingDeadlineSeconds"`
+	// ActiveDeadlineSeconds is how long a Workflow started by the CronWorkflow may be active before it is considered
+	// stuck and terminated, so that a hung Workflow does not block a ForbidConcurrent CronWorkflow forever.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" protobuf:"varint,16,opt,name=activeDeadlineSeconds"`
+	// MaxRuns is how many Workflows the CronWorkflow may run. Once that many have completed, it is stopped as if by its
+	// StopStrategy. It runs without limit if not set.
+	MaxRuns *int64 `json:"maxRuns,omitempty" protobuf:"varint,17,opt,name=maxRuns"`
+	// ScheduleWindow runs the Workflow once a day at a random time within a window, instead of at fixed times, to
+	// spread the load of many CronWorkflows. It may not be used with Schedule or Schedules.
+	ScheduleWindow *ScheduleWindow `json:"scheduleWindow,omitempty" protobuf:"bytes,18,opt,name=scheduleWindow"`
+	// SuspendPolicy is whether Workflows that are active when the CronWorkflow is suspended still count towards its
+	// ConcurrencyPolicy once it is resumed. Either way, Workflows that have already been created run to completion.
+	// Defaults to DrainActive.
+	SuspendPolicy SuspendPolicy `json:"suspendPolicy,omitempty" protobuf:"bytes,19,opt,name=suspendPolicy,casttype=SuspendPolicy"`
+	// Freeze references a ConfigMap key that stops runs from being scheduled while its value is "true", so that many
+	// CronWorkflows can be paused from one place, e.g. during a deploy, without setting Suspend on each of them.
+	Freeze *v1.ConfigMapKeySelector `json:"freeze,omitempty" protobuf:"bytes,20,opt,name=freeze"`
+	// StartingDeadlineFraction is the starting deadline for missed runs as a fraction of the time from the missed run
+	// to the next one, e.g. 0.5 to run a missed run unless it is more than half way to the next. It may not be used
+	// with StartingDeadlineSeconds.
+	StartingDeadlineFraction *Amount `json:"startingDeadlineFraction,omitempty" protobuf:"bytes,21,opt,name=startingDeadlineFraction"`
+	// ErroredJobsHistoryLimit is the number of errored jobs to be kept at a time. If it is not set, errored jobs count
+	// towards FailedJobsHistoryLimit.
+	ErroredJobsHistoryLimit *int32 `json:"erroredJobsHistoryLimit,omitempty" protobuf:"varint,22,opt,name=erroredJobsHistoryLimit"`
+	// ReplaceGracePeriod, with the Replace concurrency policy, is how long an active Workflow runs before it may be
+	// replaced. A run is skipped, rather than replacing a Workflow that started more recently.
+	ReplaceGracePeriod *metav1.Duration `json:"replaceGracePeriod,omitempty" protobuf:"bytes,23,opt,name=replaceGracePeriod"`
+}
+
+// SuspendPolicy is how suspending a CronWorkflow affects its active Workflows
+type SuspendPolicy string
+
+const (
+	// SuspendPolicyDrainActive keeps counting Workflows that are active when the CronWorkflow is suspended towards
+	// its ConcurrencyPolicy until they complete, so e.g. with Forbid a run after it is resumed is skipped until they do.
+	SuspendPolicyDrainActive SuspendPolicy = "DrainActive"
+	// SuspendPolicyImmediate stops counting Workflows that are active when the CronWorkflow is suspended towards its
+	// ConcurrencyPolicy, so a run after it is resumed is neither skipped because of them nor, with Replace, terminates
+	// them. They are still counted towards Succeeded, Failed and MaxRuns.
+	SuspendPolicyImmediate SuspendPolicy = "Immediate"
+)
+
+// ScheduleMode is how a CronWorkflow's runs are scheduled, see CronWorkflowSpec.ScheduleMode
+type ScheduleMode string
+
+const (
+	// ScheduleModeNone is a CronWorkflow with neither schedules nor a schedule window, which never runs
+	ScheduleModeNone ScheduleMode = ""
+	// ScheduleModeCron is cron expressions, including descriptors such as `@daily`, which fire at fixed times
+	ScheduleModeCron ScheduleMode = "Cron"
+	// ScheduleModeInterval is `@every` schedules, which fire at an interval from when the controller schedules them
+	// rather than at fixed times
+	ScheduleModeInterval ScheduleMode = "Interval"
+	// ScheduleModeWindow is a ScheduleWindow
+	ScheduleModeWindow ScheduleMode = "Window"
+	// ScheduleModeAmbiguous is a combination of the other modes, or of Schedule and Schedules, which is invalid since
+	// it is unclear which takes precedence
+	ScheduleModeAmbiguous ScheduleMode = "Ambiguous"
+)
+
+// ScheduleWindow is a daily window of time in which a CronWorkflow runs once. The minute it runs at is chosen at
+// random for each day, but is the same every time it is chosen for that day, e.g. after the controller restarts.
+type ScheduleWindow struct {
+	// Start is the time of day the window opens, as HH:MM in the CronWorkflow's timezone
+	Start string `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the time of day the window closes, as HH:MM in the CronWorkflow's timezone. It must be after Start,
+	// and the CronWorkflow runs before it.
+	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
 }
 
 // StopStrategy defines if the CronWorkflow should stop scheduling based on an expression. v3.6 and after
@@ -98,6 +183,29 @@ type CronWorkflowStatus struct {
 	// v3.6 and after: Phase is an enum of Active or Stopped. It changes to Stopped when stopStrategy.expression is true
 	// +optional
 	Phase CronWorkflowPhase `json:"phase" protobuf:"varint,6,rep,name=phase"`
+	// StoppedAt is the time the CronWorkflow was stopped by its StopStrategy
+	// +optional
+	StoppedAt *metav1.Time `json:"stoppedAt,omitempty" protobuf:"bytes,7,opt,name=stoppedAt"`
+	// StoppedReason describes why the CronWorkflow was stopped
+	// +optional
+	StoppedReason string `json:"stoppedReason,omitempty" protobuf:"bytes,8,opt,name=stoppedReason"`
+	// Released are the UIDs of the active Workflows that no longer count towards the ConcurrencyPolicy, because the
+	// CronWorkflow was suspended with SuspendPolicy Immediate while they were active, or because the schedule that fired
+	// them was removed
+	// +optional
+	Released []types.UID `json:"released,omitempty" protobuf:"bytes,9,rep,name=released,casttype=k8s.io/apimachinery/pkg/types.UID"`
+	// ActivePhases are the phases of the active Workflows, keyed by UID, as of the last reconciliation, so that they can
+	// be seen without getting each Workflow
+	// +optional
+	ActivePhases map[string]WorkflowPhase `json:"activePhases,omitempty" protobuf:"bytes,10,rep,name=activePhases,castvalue=WorkflowPhase"`
+	// LastRunSchedule is the schedule, with its timezone, that fired the last scheduled run at LastScheduledTime, so that
+	// it is known even after the schedules change
+	// +optional
+	LastRunSchedule string `json:"lastRunSchedule,omitempty" protobuf:"bytes,11,opt,name=lastRunSchedule"`
+	// SubmissionErrorRetryable is true if the error the SubmissionError condition reports is transient, and so
+	// retrying may succeed
+	// +optional
+	SubmissionErrorRetryable bool `json:"submissionErrorRetryable,omitempty" protobuf:"varint,12,opt,name=submissionErrorRetryable"`
 }
 
 type CronWorkflowPhase string
@@ -108,10 +216,27 @@ const (
 )
 
 func (c *CronWorkflow) IsUsingNewSchedule() bool {
-	lastUsedSchedule, exists := c.Annotations[annotationKeyLatestSchedule]
-	// If last-used-schedule does not exist, or if it does not match the current schedule then the CronWorkflow schedule
-	// was just updated
-	return !exists || lastUsedSchedule != c.Spec.GetScheduleWithTimezoneString()
+	// If last-used-schedule does not exist, or if it does not match the current schedules then the CronWorkflow schedule
+	// was just updated. Schedules are normalized so that rewriting an expression in an equivalent form is not a change,
+	// and compared as a set so that reordering them is not a change either.
+	if _, exists := c.Annotations[annotationKeyLatestSchedule]; !exists {
+		return true
+	}
+	var schedules []string
+	if c.Spec.Schedule != "" {
+		schedules = []string{NormalizeCronSchedule(c.Spec.withTimezone(c.Spec.Schedule))}
+	} else {
+		for _, schedule := range c.Spec.Schedules {
+			schedules = append(schedules, NormalizeCronSchedule(c.Spec.withTimezone(schedule)))
+		}
+	}
+	var lastUsed []string
+	for _, schedule := range c.GetLatestScheduleList() {
+		lastUsed = append(lastUsed, NormalizeCronSchedule(schedule))
+	}
+	slices.Sort(schedules)
+	slices.Sort(lastUsed)
+	return !slices.Equal(lastUsed, schedules)
 }
 
 func (c *CronWorkflow) SetSchedule(schedule string) {
@@ -139,6 +264,68 @@ func (c *CronWorkflow) GetLatestSchedule() string {
 	return c.Annotations[annotationKeyLatestSchedule]
 }
 
+// GetLatestScheduleList returns the schedules GetLatestSchedule joined with commas, as SetSchedules was given them
+// but trimmed of whitespace, or nil if there are none
+func (c *CronWorkflow) GetLatestScheduleList() []string {
+	return splitSchedules(c.GetLatestSchedule())
+}
+
+// splitSchedules splits schedules joined with commas. Because a schedule may contain commas itself, e.g.
+// `0 12 * * 1,2`, s is split at every comma and then the parts are joined back up until each has all of a schedule's
+// fields. A part after a schedule that has all of its fields only starts another schedule if it has more than one
+// field or is a descriptor such as `@daily`, otherwise it continues the schedule's last field.
+func splitSchedules(s string) []string {
+	var schedules []string
+	for _, part := range strings.Split(s, ",") {
+		if n := len(schedules); n > 0 {
+			last := schedules[n-1]
+			starts := strings.Contains(strings.TrimSpace(part), " ") || strings.HasPrefix(strings.TrimSpace(part), "@")
+			if len(strings.Fields(last)) < scheduleFieldCount(last) || !starts {
+				schedules[n-1] = last + "," + part
+				continue
+			}
+		}
+		schedules = append(schedules, part)
+	}
+	for i, schedule := range schedules {
+		schedules[i] = strings.TrimSpace(schedule)
+	}
+	if len(schedules) == 1 && schedules[0] == "" {
+		return nil
+	}
+	return schedules
+}
+
+// scheduleFieldCount returns how many whitespace separated fields the schedule has when it is complete, including
+// any timezone prefix
+func scheduleFieldCount(schedule string) int {
+	fields := strings.Fields(schedule)
+	n := 0
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		fields = fields[1:]
+		n++
+	}
+	switch {
+	case len(fields) > 0 && fields[0] == "@every":
+		return n + 2
+	case len(fields) > 0 && strings.HasPrefix(fields[0], "@"):
+		return n + 1
+	default:
+		return n + 5
+	}
+}
+
+// ShouldSkipNext returns true if the next scheduled run is to be skipped, see AnnotationKeySkipNext
+func (c *CronWorkflow) ShouldSkipNext() bool {
+	skip, _ := strconv.ParseBool(c.Annotations[AnnotationKeySkipNext])
+	return skip
+}
+
+// ClearSkipNext removes AnnotationKeySkipNext, once the run it asked to skip has been skipped
+func (c *CronWorkflow) ClearSkipNext() {
+	delete(c.Annotations, AnnotationKeySkipNext)
+}
+
 // GetScheduleString returns the schedule expression without timezone. If multiple
 // expressions are configured it returns a comma separated list of cron expressions
 func (c *CronWorkflowSpec) GetScheduleString() string {
@@ -152,27 +339,136 @@ func (c *CronWorkflowSpec) GetScheduleWithTimezoneString() string {
 }
 
 func (c *CronWorkflowSpec) getScheduleString(withTimezone bool) string {
-	var scheduleString string
-	if c.Schedule != "" {
+	if c.ScheduleCount() == 1 {
+		schedule := c.Schedule
+		if schedule == "" {
+			schedule = c.Schedules[0]
+		}
 		if withTimezone {
-			scheduleString = c.withTimezone(c.Schedule)
-		} else {
-			scheduleString = c.Schedule
+			schedule = c.withTimezone(schedule)
 		}
-	} else {
-		var sb strings.Builder
-		for i, schedule := range c.Schedules {
-			if withTimezone {
-				schedule = c.withTimezone(schedule)
-			}
-			sb.WriteString(schedule)
-			if i != len(c.Schedules)-1 {
-				sb.WriteString(",")
+		return schedule
+	}
+	var sb strings.Builder
+	for i, schedule := range c.Schedules {
+		if withTimezone {
+			schedule = c.withTimezone(schedule)
+		}
+		sb.WriteString(schedule)
+		if i != len(c.Schedules)-1 {
+			sb.WriteString(",")
+		}
+	}
+	return sb.String()
+}
+
+// HasBothSchedules returns true if both the legacy Spec.Schedule and Spec.Schedules are set. This is rejected by
+// validation; where it is not, Spec.Schedule takes precedence and Spec.Schedules is ignored.
+func (c *CronWorkflowSpec) HasBothSchedules() bool {
+	return c.Schedule != "" && len(c.Schedules) > 0
+}
+
+// StartingDeadlineForSchedule returns the starting deadline for missed runs of schedule: its entry in
+// ScheduleStartingDeadlineSeconds if there is one, otherwise StartingDeadlineSeconds. Entries match equivalent
+// schedules, e.g. "@daily" matches "0 0 * * *". It returns false if neither is set, i.e. missed runs are not run.
+func (c *CronWorkflowSpec) StartingDeadlineForSchedule(schedule string) (time.Duration, bool) {
+	if seconds, ok := c.ScheduleStartingDeadlineSeconds[schedule]; ok {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if len(c.ScheduleStartingDeadlineSeconds) > 0 {
+		normalized := NormalizeCronSchedule(schedule)
+		for key, seconds := range c.ScheduleStartingDeadlineSeconds {
+			if NormalizeCronSchedule(key) == normalized {
+				return time.Duration(seconds) * time.Second, true
 			}
 		}
-		scheduleString = sb.String()
 	}
-	return scheduleString
+	if c.StartingDeadlineSeconds != nil {
+		return time.Duration(*c.StartingDeadlineSeconds) * time.Second, true
+	}
+	return 0, false
+}
+
+// EffectiveStartingDeadline returns how long after scheduledTime a missed run may still be run: StartingDeadlineFraction
+// of the time to the next time any schedule fires, or else StartingDeadlineSeconds. It returns zero, i.e. missed runs
+// are not run, if neither is set. Entries in ScheduleStartingDeadlineSeconds are not considered.
+func (c *CronWorkflowSpec) EffectiveStartingDeadline(scheduledTime time.Time) time.Duration {
+	if c.StartingDeadlineFraction != nil {
+		fraction, err := c.StartingDeadlineFraction.Float64()
+		if err != nil {
+			return 0
+		}
+		next := c.nextFireTime(scheduledTime)
+		if next.IsZero() {
+			return 0
+		}
+		return time.Duration(fraction * float64(next.Sub(scheduledTime)))
+	}
+	if c.StartingDeadlineSeconds != nil {
+		return time.Duration(*c.StartingDeadlineSeconds) * time.Second
+	}
+	return 0
+}
+
+// nextFireTime returns the earliest time after t at which any of the schedules fires, ignoring any it cannot parse
+func (c *CronWorkflowSpec) nextFireTime(t time.Time) time.Time {
+	schedules := c.Schedules
+	if c.Schedule != "" {
+		schedules = []string{c.Schedule}
+	}
+	var next time.Time
+	for _, schedule := range schedules {
+		cronSchedule, err := ParseCronSchedule(c.withTimezone(schedule))
+		if err != nil {
+			continue
+		}
+		if n := cronSchedule.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+// ScheduleCount returns the number of schedules configured, counting the legacy Spec.Schedule as one
+func (c *CronWorkflowSpec) ScheduleCount() int {
+	if c.Schedule != "" {
+		return 1
+	}
+	return len(c.Schedules)
+}
+
+// ScheduleMode returns how the CronWorkflow's runs are scheduled. A CronWorkflow with a schedule window and schedules,
+// with both Schedule and Schedules, or with both `@every` and cron expression schedules is ScheduleModeAmbiguous.
+func (c *CronWorkflowSpec) ScheduleMode() ScheduleMode {
+	if c.HasBothSchedules() {
+		return ScheduleModeAmbiguous
+	}
+	schedules := c.Schedules
+	if c.Schedule != "" {
+		schedules = []string{c.Schedule}
+	}
+	mode := ScheduleModeNone
+	if c.ScheduleWindow != nil {
+		mode = ScheduleModeWindow
+	}
+	for _, schedule := range schedules {
+		scheduleMode := ScheduleModeCron
+		if strings.HasPrefix(withoutTimezone(schedule), "@every ") {
+			scheduleMode = ScheduleModeInterval
+		}
+		if mode != ScheduleModeNone && mode != scheduleMode {
+			return ScheduleModeAmbiguous
+		}
+		mode = scheduleMode
+	}
+	return mode
+}
+
+// IsRecurring returns true if the CronWorkflow keeps running on a schedule or schedule window until it is suspended
+// or stopped, rather than running a fixed number of times because MaxRuns is set. A StopStrategy does not make it
+// finite, since its expression may never be true.
+func (c *CronWorkflowSpec) IsRecurring() bool {
+	return (c.ScheduleCount() > 0 || c.ScheduleWindow != nil) && c.MaxRuns == nil
 }
 
 // GetSchedulesWithTimezone returns all schedules configured for the CronWorkflow with a timezone. It handles
@@ -182,11 +478,27 @@ func (c *CronWorkflowSpec) GetSchedulesWithTimezone(ctx context.Context) []strin
 }
 
 // GetSchedules returns all schedules configured for the CronWorkflow. It handles both Spec.Schedules
-// and Spec.Schedule for backwards compatibility
+// and Spec.Schedule for backwards compatibility. If both are set, only Spec.Schedule is returned, see GetAllSchedules.
 func (c *CronWorkflowSpec) GetSchedules(ctx context.Context) []string {
 	return c.getSchedules(ctx, false)
 }
 
+// GetAllSchedules returns the union of Spec.Schedule and Spec.Schedules, without duplicates. Unlike GetSchedules, it
+// does not drop Spec.Schedules when Spec.Schedule is set, as may be done temporarily while migrating to Spec.Schedules.
+func (c *CronWorkflowSpec) GetAllSchedules(ctx context.Context) []string {
+	var schedules []string
+	if c.Schedule != "" {
+		schedules = append(schedules, c.Schedule)
+		deprecation.Record(ctx, deprecation.Schedule)
+	}
+	for _, schedule := range c.Schedules {
+		if !slices.Contains(schedules, schedule) {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules
+}
+
 func (c *CronWorkflowSpec) getSchedules(ctx context.Context, withTimezone bool) []string {
 	var schedules []string
 	if c.Schedule != "" {
@@ -196,6 +508,9 @@ func (c *CronWorkflowSpec) getSchedules(ctx context.Context, withTimezone bool)
 		}
 		schedules = append(schedules, schedule)
 		deprecation.Record(ctx, deprecation.Schedule)
+		if c.HasBothSchedules() {
+			deprecation.Record(ctx, deprecation.ScheduleAndSchedules)
+		}
 	} else {
 		schedules = make([]string, len(c.Schedules))
 		for i, schedule := range c.Schedules {
@@ -208,13 +523,202 @@ func (c *CronWorkflowSpec) getSchedules(ctx context.Context, withTimezone bool)
 	return schedules
 }
 
-func (c *CronWorkflowSpec) withTimezone(scheduleString string) string {
+// GetSchedulesForDisplay returns the bare cron expressions of all schedules, with any CRON_TZ= or TZ= prefix removed,
+// for UIs that show the timezone separately
+func (c *CronWorkflowSpec) GetSchedulesForDisplay() []string {
+	schedules := c.Schedules
+	if c.Schedule != "" {
+		schedules = []string{c.Schedule}
+	}
+	display := make([]string, len(schedules))
+	for i, schedule := range schedules {
+		display[i] = withoutTimezone(schedule)
+	}
+	return display
+}
+
+// ResolveTimezone returns Timezone if it is set, and otherwise namespaceDefault, the default timezone for CronWorkflows
+// in the CronWorkflow's namespace. Like Timezone, an empty result means the controller's local time.
+func (c *CronWorkflowSpec) ResolveTimezone(namespaceDefault string) string {
+	if c.Timezone != "" {
+		return c.Timezone
+	}
+	return namespaceDefault
+}
+
+// TimezoneDisplay returns the timezone with its UTC offset at the given time, e.g. "Asia/Tokyo (UTC+09:00)", for UIs.
+// The offset is that at the given time, so it reflects daylight saving time. The controller's local timezone is used if
+// none is set. An invalid timezone is returned as it is.
+func (c *CronWorkflowSpec) TimezoneDisplay(at time.Time) string {
+	loc := time.Local
+	if c.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(c.Timezone); err != nil {
+			return c.Timezone
+		}
+	}
+	_, offset := at.In(loc).Zone()
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("%s (UTC%c%02d:%02d)", loc, sign, offset/3600, offset%3600/60)
+}
+
+func withoutTimezone(schedule string) string {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "CRON_TZ=") || strings.HasPrefix(schedule, "TZ=") {
+		_, expression, _ := strings.Cut(schedule, " ")
+		return strings.TrimSpace(expression)
+	}
+	return schedule
+}
+
+// scheduleTimezone returns the timezone the schedule is evaluated in: the timezone of its own CRON_TZ= or TZ= prefix,
+// or else the spec's timezone
+func (c *CronWorkflowSpec) scheduleTimezone(schedule string) string {
+	schedule = strings.TrimSpace(schedule)
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if strings.HasPrefix(schedule, prefix) {
+			timezone, _, _ := strings.Cut(strings.TrimPrefix(schedule, prefix), " ")
+			return timezone
+		}
+	}
+	return c.Timezone
+}
+
+// Timezones returns the distinct timezones the schedules are evaluated in, in the order they are first used, with
+// "Local" for the controller's local time. Schedules with their own CRON_TZ= prefix are evaluated in that timezone.
+func (c *CronWorkflowSpec) Timezones() []string {
+	schedules := c.Schedules
+	if c.Schedule != "" {
+		schedules = []string{c.Schedule}
+	}
+	var timezones []string
+	add := func(timezone string) {
+		if timezone == "" {
+			timezone = "Local"
+		}
+		if !slices.Contains(timezones, timezone) {
+			timezones = append(timezones, timezone)
+		}
+	}
+	for _, schedule := range schedules {
+		add(c.scheduleTimezone(schedule))
+	}
+	if len(schedules) == 0 || c.ScheduleWindow != nil {
+		add(c.Timezone)
+	}
+	return timezones
+}
+
+// ReferencedTimezones returns the distinct timezones the spec refers to, sorted: its Timezone and those of the
+// schedules' own CRON_TZ= or TZ= prefixes, whether or not they are valid, and even if Timezone is not used because
+// every schedule has its own. Unlike Timezones, the controller's local time is not included, since it is not named.
+func (c *CronWorkflowSpec) ReferencedTimezones() []string {
+	var timezones []string
 	if c.Timezone != "" {
+		timezones = append(timezones, c.Timezone)
+	}
+	schedules := c.Schedules
+	if c.Schedule != "" {
+		schedules = append([]string{c.Schedule}, schedules...)
+	}
+	for _, schedule := range schedules {
+		if timezone := c.scheduleTimezone(schedule); timezone != "" {
+			timezones = append(timezones, timezone)
+		}
+	}
+	slices.Sort(timezones)
+	return slices.Compact(timezones)
+}
+
+// InvalidTimezones returns those of ReferencedTimezones that cannot be loaded, sorted
+func (c *CronWorkflowSpec) InvalidTimezones() []string {
+	var invalid []string
+	for _, timezone := range c.ReferencedTimezones() {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			invalid = append(invalid, timezone)
+		}
+	}
+	return invalid
+}
+
+// SummarizeTimezones counts the CronWorkflows in the list by the timezones their schedules are evaluated in, with
+// "Local" for the controller's local time. A CronWorkflow whose schedules use several timezones is counted once for
+// each of them.
+func SummarizeTimezones(list *CronWorkflowList) map[string]int {
+	counts := map[string]int{}
+	for _, cronWf := range list.Items {
+		for _, timezone := range cronWf.Spec.Timezones() {
+			counts[timezone]++
+		}
+	}
+	return counts
+}
+
+// withTimezone prefixes the schedule with the spec's timezone, unless the schedule has its own
+func (c *CronWorkflowSpec) withTimezone(scheduleString string) string {
+	if c.Timezone != "" && withoutTimezone(scheduleString) == strings.TrimSpace(scheduleString) {
 		scheduleString = "CRON_TZ=" + c.Timezone + " " + scheduleString
 	}
 	return scheduleString
 }
 
+// IsSchedulable returns false if new runs must not be scheduled, i.e. the CronWorkflow is suspended or stopped.
+func (c *CronWorkflow) IsSchedulable() bool {
+	return !c.Spec.Suspend && c.Status.Phase != StoppedPhase
+}
+
+// ActiveSchedulesAt returns the schedules, as written, that fire at exactly now given the current state of the
+// CronWorkflow, see MatchScheduleAt. Unlike MatchScheduleAt, every schedule that fires at now is returned, and a
+// schedule that cannot be parsed is never returned. It returns none if the CronWorkflow is not schedulable.
+func (c *CronWorkflow) ActiveSchedulesAt(ctx context.Context, now time.Time) []string {
+	if !c.IsSchedulable() {
+		return nil
+	}
+	var active []string
+	for _, schedule := range c.Spec.GetSchedules(ctx) {
+		cronSchedule, err := ParseCronSchedule(c.Spec.withTimezone(schedule))
+		if err == nil && firesAt(cronSchedule, now) {
+			active = append(active, schedule)
+		}
+	}
+	return active
+}
+
+// GetStoppedReason returns why the CronWorkflow was stopped, or an empty string if it is not stopped
+func (c *CronWorkflowStatus) GetStoppedReason() string {
+	if c.Phase != StoppedPhase {
+		return ""
+	}
+	return c.StoppedReason
+}
+
+// ShouldReplace returns true if an active Workflow that started at activeStart may be replaced at now, i.e. it has been
+// running for at least ReplaceGracePeriod.
+func (c *CronWorkflowSpec) ShouldReplace(activeStart, now time.Time) bool {
+	return c.ReplaceGracePeriod == nil || now.Sub(activeStart) >= c.ReplaceGracePeriod.Duration
+}
+
+// StuckActive returns the references to the active Workflows that started longer than Spec.ActiveDeadlineSeconds
+// before now, given the start time of each Workflow. Active Workflows without a start time are not considered stuck. It
+// returns none if no deadline is set.
+func (c *CronWorkflow) StuckActive(now time.Time, starts map[types.UID]time.Time) []v1.ObjectReference {
+	if c.Spec.ActiveDeadlineSeconds == nil {
+		return nil
+	}
+	deadline := time.Duration(*c.Spec.ActiveDeadlineSeconds) * time.Second
+	var stuck []v1.ObjectReference
+	for _, ref := range c.Status.Active {
+		if start, ok := starts[ref.UID]; ok && now.Sub(start) > deadline {
+			stuck = append(stuck, ref)
+		}
+	}
+	return stuck
+}
+
 func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 	for _, ref := range c.Active {
 		if uid == ref.UID {
@@ -224,7 +728,155 @@ func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 	return false
 }
 
+// AddActive adds ref to Active, keeping Active sorted by UID so that the serialized status is stable
+func (c *CronWorkflowStatus) AddActive(ref v1.ObjectReference) {
+	c.Active = append(c.Active, ref)
+	slices.SortFunc(c.Active, func(a, b v1.ObjectReference) int {
+		return strings.Compare(string(a.UID), string(b.UID))
+	})
+}
+
+// RemoveActive removes the reference with uid from Active, and from Released and ActivePhases. It does not modify the
+// previous Active, Released or ActivePhases.
+func (c *CronWorkflowStatus) RemoveActive(uid types.UID) {
+	var active []v1.ObjectReference
+	for _, ref := range c.Active {
+		if ref.UID != uid {
+			active = append(active, ref)
+		}
+	}
+	c.Active = active
+	if slices.Contains(c.Released, uid) {
+		c.Released = slices.DeleteFunc(slices.Clone(c.Released), func(u types.UID) bool { return u == uid })
+	}
+	if _, ok := c.ActivePhases[string(uid)]; ok {
+		c.ActivePhases = maps.Clone(c.ActivePhases)
+		delete(c.ActivePhases, string(uid))
+		if len(c.ActivePhases) == 0 {
+			c.ActivePhases = nil
+		}
+	}
+}
+
+// SetActivePhase records the phase of the active Workflow with uid, and returns true if it changed. A Workflow without
+// a phase yet is Pending. It does nothing for Workflows that are not in Active, so that ActivePhases only ever has
+// entries for Active.
+func (c *CronWorkflowStatus) SetActivePhase(uid types.UID, phase WorkflowPhase) bool {
+	if !c.HasActiveUID(uid) {
+		return false
+	}
+	if phase == WorkflowUnknown {
+		phase = WorkflowPending
+	}
+	if p, ok := c.ActivePhases[string(uid)]; ok && p == phase {
+		return false
+	}
+	c.ActivePhases = maps.Clone(c.ActivePhases)
+	if c.ActivePhases == nil {
+		c.ActivePhases = map[string]WorkflowPhase{}
+	}
+	c.ActivePhases[string(uid)] = phase
+	return true
+}
+
+// IsFrozen returns true if Spec.Freeze is set and its key in flags, the data of the ConfigMap it references, is true
+func (c *CronWorkflowSpec) IsFrozen(flags map[string]string) bool {
+	if c.Freeze == nil {
+		return false
+	}
+	frozen, err := strconv.ParseBool(strings.TrimSpace(flags[c.Freeze.Key]))
+	return err == nil && frozen
+}
+
+// GetSuspendPolicy returns Spec.SuspendPolicy, or SuspendPolicyDrainActive if it is not set
+func (c *CronWorkflowSpec) GetSuspendPolicy() SuspendPolicy {
+	if c.SuspendPolicy == "" {
+		return SuspendPolicyDrainActive
+	}
+	return c.SuspendPolicy
+}
+
+// ReleaseActiveIfSuspended applies the SuspendPolicy: if the CronWorkflow is suspended with SuspendPolicyImmediate,
+// its active Workflows are added to Status.Released so that they no longer count towards the ConcurrencyPolicy. It
+// returns true if the status changed. It does not modify the previous Released.
+func (c *CronWorkflow) ReleaseActiveIfSuspended() bool {
+	if !c.Spec.Suspend || c.Spec.GetSuspendPolicy() != SuspendPolicyImmediate {
+		return false
+	}
+	var released []types.UID
+	for _, ref := range c.Status.Active {
+		if !slices.Contains(c.Status.Released, ref.UID) && !slices.Contains(released, ref.UID) {
+			released = append(released, ref.UID)
+		}
+	}
+	if len(released) == 0 {
+		return false
+	}
+	c.Status.Released = append(slices.Clone(c.Status.Released), released...)
+	return true
+}
+
+// OrphanedActiveUIDs returns the UIDs of the active Workflows that were fired by a schedule that has since been
+// removed, in the order of Active. schedules are the schedules, with their timezone, that fired the active Workflows,
+// keyed by UID. Workflows without one, e.g. those fired by a schedule window, are never orphaned. Schedules are
+// normalized, so rewriting a schedule in an equivalent form does not orphan its Workflows.
+func (c *CronWorkflow) OrphanedActiveUIDs(ctx context.Context, schedules map[types.UID]string) []types.UID {
+	current := map[string]bool{}
+	for _, schedule := range c.Spec.GetSchedulesWithTimezone(ctx) {
+		current[NormalizeCronSchedule(schedule)] = true
+	}
+	var orphaned []types.UID
+	for _, ref := range c.Status.Active {
+		if schedule := schedules[ref.UID]; schedule != "" && !current[NormalizeCronSchedule(schedule)] {
+			orphaned = append(orphaned, ref.UID)
+		}
+	}
+	return orphaned
+}
+
+// ConcurrencyActive returns the active Workflows that count towards the ConcurrencyPolicy, i.e. those that have not
+// been released by suspending the CronWorkflow with SuspendPolicyImmediate
+func (c *CronWorkflowStatus) ConcurrencyActive() []v1.ObjectReference {
+	var active []v1.ObjectReference
+	for _, ref := range c.Active {
+		if !slices.Contains(c.Released, ref.UID) {
+			active = append(active, ref)
+		}
+	}
+	return active
+}
+
 const (
 	// ConditionTypeSubmissionError signifies that there was an error when submitting the CronWorkflow as a Workflow
 	ConditionTypeSubmissionError ConditionType = "SubmissionError"
+	// ConditionTypeNeverFires signifies that some of the CronWorkflow's schedules will never fire, e.g. `0 0 30 2 *`
+	ConditionTypeNeverFires ConditionType = "NeverFires"
+	// ConditionTypeStopExpressionError signifies that the StopStrategy expression failed to evaluate, e.g. because a
+	// variable it uses is nil, so the CronWorkflow keeps scheduling as if it were false
+	ConditionTypeStopExpressionError ConditionType = "StopExpressionError"
+	// ConditionTypeOrphanedActive signifies that some of the CronWorkflow's active Workflows were fired by a schedule
+	// that has since been removed. They run to completion, but no longer count towards the ConcurrencyPolicy.
+	ConditionTypeOrphanedActive ConditionType = "OrphanedActive"
+)
+
+// CronWorkflowEventReason is the reason of a Kubernetes event emitted for a scheduling decision of a CronWorkflow
+type CronWorkflowEventReason string
+
+const (
+	// CronWorkflowEventReasonScheduled signifies that a Workflow was submitted
+	CronWorkflowEventReasonScheduled CronWorkflowEventReason = "Scheduled"
+	// CronWorkflowEventReasonSkipped signifies that a run was skipped, e.g. because of the concurrency policy or the
+	// when expression
+	CronWorkflowEventReasonSkipped CronWorkflowEventReason = "Skipped"
+	// CronWorkflowEventReasonStopped signifies that the CronWorkflow stopped scheduling because of its stop strategy
+	CronWorkflowEventReasonStopped CronWorkflowEventReason = "Stopped"
+	// CronWorkflowEventReasonMissedDeadline signifies that a missed run was not run because it was past the starting
+	// deadline
+	CronWorkflowEventReasonMissedDeadline CronWorkflowEventReason = "MissedDeadline"
+	// CronWorkflowEventReasonSubmissionFailed signifies that a Workflow could not be submitted, and that retrying
+	// will not help
+	CronWorkflowEventReasonSubmissionFailed CronWorkflowEventReason = "SubmissionFailed"
+	// CronWorkflowEventReasonActiveDeadlineExceeded signifies that an active Workflow was terminated because it was
+	// active for longer than the CronWorkflow's active deadline
+	CronWorkflowEventReasonActiveDeadlineExceeded CronWorkflowEventReason = "ActiveDeadlineExceeded"
 )
@@ -2,10 +2,20 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
+	"math/rand"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-workflows/v3/util/deprecation"
 )
 
 func TestCronWorkflowStatus_HasActiveUID(t *testing.T) {
@@ -17,6 +27,68 @@ func TestCronWorkflowStatus_HasActiveUID(t *testing.T) {
 	assert.False(t, cwfStatus.HasActiveUID("foo"))
 }
 
+func TestCronWorkflowStatus_AddActive(t *testing.T) {
+	uids := []types.UID{"a", "b", "c", "d", "e"}
+	expected := []v1.ObjectReference{{UID: "a"}, {UID: "b"}, {UID: "c"}, {UID: "d"}, {UID: "e"}}
+	for range 10 {
+		var status CronWorkflowStatus
+		for _, i := range rand.Perm(len(uids)) {
+			status.AddActive(v1.ObjectReference{UID: uids[i]})
+		}
+		assert.Equal(t, expected, status.Active)
+	}
+
+	status := CronWorkflowStatus{Active: expected}
+	status.RemoveActive("c")
+	assert.Equal(t, []v1.ObjectReference{{UID: "a"}, {UID: "b"}, {UID: "d"}, {UID: "e"}}, status.Active)
+	assert.Len(t, expected, 5)
+}
+
+func TestCronWorkflowStatus_SetActivePhase(t *testing.T) {
+	status := CronWorkflowStatus{Active: []v1.ObjectReference{{UID: "a"}, {UID: "b"}}}
+	assert.False(t, status.SetActivePhase("c", WorkflowRunning), "only active workflows have a phase")
+	assert.True(t, status.SetActivePhase("a", WorkflowUnknown))
+	assert.True(t, status.SetActivePhase("b", WorkflowRunning))
+	assert.False(t, status.SetActivePhase("b", WorkflowRunning))
+	assert.Equal(t, map[string]WorkflowPhase{"a": WorkflowPending, "b": WorkflowRunning}, status.ActivePhases)
+
+	phases := status.ActivePhases
+	status.RemoveActive("a")
+	assert.Equal(t, map[string]WorkflowPhase{"b": WorkflowRunning}, status.ActivePhases)
+	assert.Len(t, phases, 2)
+	status.RemoveActive("b")
+	assert.Nil(t, status.ActivePhases)
+}
+
+func TestCronWorkflow_OrphanedActiveUIDs(t *testing.T) {
+	ctx := context.Background()
+	cwf := CronWorkflow{
+		Spec:   CronWorkflowSpec{Schedules: []string{"0 * * * *", "CRON_TZ=UTC 30 9 * * MON"}, Timezone: "Asia/Tokyo"},
+		Status: CronWorkflowStatus{Active: []v1.ObjectReference{{UID: "a"}, {UID: "b"}, {UID: "c"}, {UID: "d"}, {UID: "e"}}},
+	}
+	schedules := map[types.UID]string{
+		"a": "CRON_TZ=Asia/Tokyo 0 * * * *",
+		"b": "CRON_TZ=Asia/Tokyo 0 12 * * *",
+		"c": "CRON_TZ=UTC 30 09 * * 1",
+		// fired by a schedule window, or before schedules were recorded
+		"d": "",
+		// the schedule's timezone changed
+		"e": "CRON_TZ=UTC 0 * * * *",
+	}
+	assert.Equal(t, []types.UID{"b", "e"}, cwf.OrphanedActiveUIDs(ctx, schedules))
+
+	cwf.Spec.Schedules = nil
+	cwf.Spec.Schedule = "0 12 * * *"
+	assert.Equal(t, []types.UID{"a", "c", "e"}, cwf.OrphanedActiveUIDs(ctx, schedules))
+}
+
+func TestCronWorkflowStatus_GetStoppedReason(t *testing.T) {
+	status := CronWorkflowStatus{Phase: ActivePhase, StoppedReason: "stale"}
+	assert.Empty(t, status.GetStoppedReason())
+	status.Phase = StoppedPhase
+	assert.Equal(t, "stale", status.GetStoppedReason())
+}
+
 func TestCronWorkflowSpec_GetScheduleStrings(t *testing.T) {
 	cwfSpec := CronWorkflowSpec{
 		Timezone: "",
@@ -45,3 +117,426 @@ func TestCronWorkflowSpec_GetScheduleStrings(t *testing.T) {
 	assert.Equal(t, "* * * * *,0 * * * *", cwfSpec.GetScheduleString())
 	assert.Equal(t, "CRON_TZ=America/Los_Angeles * * * * *,CRON_TZ=America/Los_Angeles 0 * * * *", cwfSpec.GetScheduleWithTimezoneString())
 }
+
+func TestCronWorkflowSpec_ScheduleCount(t *testing.T) {
+	assert.Equal(t, 0, (&CronWorkflowSpec{}).ScheduleCount())
+	assert.Equal(t, 1, (&CronWorkflowSpec{Schedule: "* * * * *"}).ScheduleCount())
+	assert.Equal(t, 1, (&CronWorkflowSpec{Schedules: []string{"* * * * *"}}).ScheduleCount())
+	assert.Equal(t, 2, (&CronWorkflowSpec{Schedules: []string{"* * * * *", "0 * * * *"}}).ScheduleCount())
+	assert.Equal(t, "0 * * * *", (&CronWorkflowSpec{Schedules: []string{"0 * * * *"}}).GetScheduleString())
+}
+
+func TestCronWorkflowSpec_HasBothSchedules(t *testing.T) {
+	var recorded []string
+	deprecation.Initialize(func(_ context.Context, feature, _ string) { recorded = append(recorded, feature) })
+	defer deprecation.Initialize(nil)
+	ctx := context.Background()
+
+	both := CronWorkflowSpec{Schedule: "* * * * *", Schedules: []string{"0 * * * *"}}
+	assert.True(t, both.HasBothSchedules())
+	assert.Equal(t, []string{"* * * * *"}, both.GetSchedules(ctx))
+	assert.Equal(t, "* * * * *", both.GetScheduleString())
+	assert.Equal(t, []string{"cronworkflow schedule", "cronworkflow schedule and schedules"}, recorded)
+
+	recorded = nil
+	legacy := CronWorkflowSpec{Schedule: "* * * * *"}
+	assert.False(t, legacy.HasBothSchedules())
+	legacy.GetSchedules(ctx)
+	assert.Equal(t, []string{"cronworkflow schedule"}, recorded)
+
+	recorded = nil
+	list := CronWorkflowSpec{Schedules: []string{"0 * * * *"}}
+	assert.False(t, list.HasBothSchedules())
+	list.GetSchedules(ctx)
+	assert.Empty(t, recorded)
+}
+
+func TestCronWorkflowSpec_StartingDeadlineForSchedule(t *testing.T) {
+	defaultDeadline := int64(60)
+	for _, tt := range []struct {
+		name        string
+		spec        CronWorkflowSpec
+		schedule    string
+		deadline    time.Duration
+		hasDeadline bool
+	}{
+		{"Unset", CronWorkflowSpec{}, "* * * * *", 0, false},
+		{"Default", CronWorkflowSpec{StartingDeadlineSeconds: &defaultDeadline}, "* * * * *", time.Minute, true},
+		{"Override", CronWorkflowSpec{StartingDeadlineSeconds: &defaultDeadline, ScheduleStartingDeadlineSeconds: map[string]int64{"0 0 * * *": 3600}}, "0 0 * * *", time.Hour, true},
+		{"OverrideWithoutDefault", CronWorkflowSpec{ScheduleStartingDeadlineSeconds: map[string]int64{"0 0 * * *": 3600}}, "0 0 * * *", time.Hour, true},
+		{"OverrideOtherSchedule", CronWorkflowSpec{StartingDeadlineSeconds: &defaultDeadline, ScheduleStartingDeadlineSeconds: map[string]int64{"0 0 * * *": 3600}}, "* * * * *", time.Minute, true},
+		{"OverrideOtherScheduleWithoutDefault", CronWorkflowSpec{ScheduleStartingDeadlineSeconds: map[string]int64{"0 0 * * *": 3600}}, "* * * * *", 0, false},
+		{"OverrideZero", CronWorkflowSpec{StartingDeadlineSeconds: &defaultDeadline, ScheduleStartingDeadlineSeconds: map[string]int64{"* * * * *": 0}}, "* * * * *", 0, true},
+		{"OverrideEquivalent", CronWorkflowSpec{ScheduleStartingDeadlineSeconds: map[string]int64{"@daily": 3600}}, "0 0 * * *", time.Hour, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			deadline, ok := tt.spec.StartingDeadlineForSchedule(tt.schedule)
+			assert.Equal(t, tt.hasDeadline, ok)
+			assert.Equal(t, tt.deadline, deadline)
+		})
+	}
+}
+
+func TestCronWorkflowSpec_EffectiveStartingDeadline(t *testing.T) {
+	fraction := func(f string) *Amount { return &Amount{Value: json.Number(f)} }
+	seconds := int64(90)
+	scheduledTime := time.Date(2024, time.June, 1, 10, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name     string
+		spec     CronWorkflowSpec
+		deadline time.Duration
+	}{
+		{"Unset", CronWorkflowSpec{Schedules: []string{"0 * * * *"}}, 0},
+		{"Seconds", CronWorkflowSpec{Schedules: []string{"0 * * * *"}, StartingDeadlineSeconds: &seconds}, 90 * time.Second},
+		{"Half", CronWorkflowSpec{Schedules: []string{"0 * * * *"}, StartingDeadlineFraction: fraction("0.5")}, 30 * time.Minute},
+		{"Quarter", CronWorkflowSpec{Schedules: []string{"0 * * * *"}, StartingDeadlineFraction: fraction("0.25")}, 15 * time.Minute},
+		{"Whole", CronWorkflowSpec{Schedules: []string{"0 */2 * * *"}, StartingDeadlineFraction: fraction("1")}, 2 * time.Hour},
+		{"Daily", CronWorkflowSpec{Schedule: "0 10 * * *", Timezone: "UTC", StartingDeadlineFraction: fraction("0.1")}, 144 * time.Minute},
+		{"NearestSchedule", CronWorkflowSpec{Schedules: []string{"0 * * * *", "10 * * * *"}, StartingDeadlineFraction: fraction("0.5")}, 5 * time.Minute},
+		{"Timezone", CronWorkflowSpec{Schedules: []string{"0 19 * * *"}, Timezone: "Asia/Tokyo", StartingDeadlineFraction: fraction("0.5")}, 12 * time.Hour},
+		{"Malformed", CronWorkflowSpec{Schedules: []string{"0 * * * *"}, StartingDeadlineFraction: fraction("half")}, 0},
+		{"Unparseable", CronWorkflowSpec{Schedules: []string{"0 * * *"}, StartingDeadlineFraction: fraction("0.5")}, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.deadline, tt.spec.EffectiveStartingDeadline(scheduledTime))
+		})
+	}
+}
+
+func TestCronWorkflow_StuckActive(t *testing.T) {
+	now := time.Date(2021, 2, 19, 12, 0, 0, 0, time.UTC)
+	cronWf := CronWorkflow{Status: CronWorkflowStatus{Active: []v1.ObjectReference{{UID: "fresh"}, {UID: "stuck"}, {UID: "unknown"}, {UID: "very-stuck"}}}}
+	starts := map[types.UID]time.Time{
+		"fresh":      now.Add(-time.Minute),
+		"stuck":      now.Add(-2 * time.Hour),
+		"very-stuck": now.Add(-48 * time.Hour),
+		"inactive":   now.Add(-48 * time.Hour),
+	}
+	assert.Empty(t, cronWf.StuckActive(now, starts))
+
+	deadline := int64(3600)
+	cronWf.Spec.ActiveDeadlineSeconds = &deadline
+	assert.Equal(t, []v1.ObjectReference{{UID: "stuck"}, {UID: "very-stuck"}}, cronWf.StuckActive(now, starts))
+	assert.Empty(t, cronWf.StuckActive(now, nil))
+}
+
+func TestCronWorkflowSpec_GetAllSchedules(t *testing.T) {
+	ctx := context.Background()
+	cwfSpec := CronWorkflowSpec{Schedule: "* * * * *", Schedules: []string{"0 * * * *", "* * * * *", "0 * * * *"}}
+	assert.Equal(t, []string{"* * * * *"}, cwfSpec.GetSchedules(ctx))
+	assert.Equal(t, []string{"* * * * *", "0 * * * *"}, cwfSpec.GetAllSchedules(ctx))
+	assert.Equal(t, []string{"0 * * * *"}, (&CronWorkflowSpec{Schedules: []string{"0 * * * *"}}).GetAllSchedules(ctx))
+	assert.Empty(t, (&CronWorkflowSpec{}).GetAllSchedules(ctx))
+}
+
+func TestCronWorkflowSpec_ShouldReplace(t *testing.T) {
+	start := time.Date(2024, time.June, 1, 10, 0, 0, 0, time.UTC)
+	spec := CronWorkflowSpec{}
+	assert.True(t, spec.ShouldReplace(start, start), "no grace period")
+
+	spec.ReplaceGracePeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	assert.False(t, spec.ShouldReplace(start, start))
+	assert.False(t, spec.ShouldReplace(start, start.Add(5*time.Minute-time.Nanosecond)))
+	assert.True(t, spec.ShouldReplace(start, start.Add(5*time.Minute)))
+	assert.True(t, spec.ShouldReplace(start, start.Add(time.Hour)))
+}
+
+func TestCronWorkflow_SkipNext(t *testing.T) {
+	cwf := &CronWorkflow{}
+	assert.False(t, cwf.ShouldSkipNext(), "no annotations")
+	cwf.ClearSkipNext()
+
+	cwf.Annotations = map[string]string{AnnotationKeySkipNext: "true", "other": "kept"}
+	assert.True(t, cwf.ShouldSkipNext())
+	cwf.ClearSkipNext()
+	assert.False(t, cwf.ShouldSkipNext())
+	assert.Equal(t, map[string]string{"other": "kept"}, cwf.Annotations)
+
+	for _, v := range []string{"false", "", "yes"} {
+		cwf.Annotations[AnnotationKeySkipNext] = v
+		assert.False(t, cwf.ShouldSkipNext(), v)
+	}
+}
+
+func TestCronWorkflowSpec_TimezoneDisplay(t *testing.T) {
+	// daylight saving time starts in New York on 10 March 2024, and in Adelaide it ends on 7 April 2024
+	beforeDST := time.Date(2024, time.March, 10, 6, 0, 0, 0, time.UTC)
+	afterDST := time.Date(2024, time.March, 10, 8, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		timezone string
+		at       time.Time
+		display  string
+	}{
+		{"Asia/Tokyo", beforeDST, "Asia/Tokyo (UTC+09:00)"},
+		{"America/New_York", beforeDST, "America/New_York (UTC-05:00)"},
+		{"America/New_York", afterDST, "America/New_York (UTC-04:00)"},
+		{"Australia/Adelaide", time.Date(2024, time.April, 6, 12, 0, 0, 0, time.UTC), "Australia/Adelaide (UTC+10:30)"},
+		{"Australia/Adelaide", time.Date(2024, time.April, 7, 12, 0, 0, 0, time.UTC), "Australia/Adelaide (UTC+09:30)"},
+		{"UTC", afterDST, "UTC (UTC+00:00)"},
+		{"Mars/Olympus_Mons", afterDST, "Mars/Olympus_Mons"},
+	} {
+		spec := CronWorkflowSpec{Timezone: tt.timezone}
+		assert.Equal(t, tt.display, spec.TimezoneDisplay(tt.at), tt.timezone)
+	}
+
+	local := time.Local
+	defer func() { time.Local = local }()
+	time.Local = time.FixedZone("Local", -3*60*60)
+	assert.Equal(t, "Local (UTC-03:00)", (&CronWorkflowSpec{}).TimezoneDisplay(afterDST))
+}
+
+func TestCronWorkflowSpec_GetSchedulesForDisplay(t *testing.T) {
+	cwfSpec := CronWorkflowSpec{Timezone: "America/Los_Angeles", Schedule: "CRON_TZ=America/Los_Angeles * * * * *"}
+	assert.Equal(t, []string{"* * * * *"}, cwfSpec.GetSchedulesForDisplay())
+
+	cwfSpec = CronWorkflowSpec{
+		Timezone:  "America/Los_Angeles",
+		Schedules: []string{"* * * * *", "CRON_TZ=Europe/London 0 * * * *", "TZ=UTC  5 4 * * *", " @daily "},
+	}
+	assert.Equal(t, []string{"* * * * *", "0 * * * *", "5 4 * * *", "@daily"}, cwfSpec.GetSchedulesForDisplay())
+	assert.Empty(t, (&CronWorkflowSpec{}).GetSchedulesForDisplay())
+}
+
+func BenchmarkCronWorkflowSpec_GetScheduleString(b *testing.B) {
+	for name, spec := range map[string]CronWorkflowSpec{
+		"Schedule":          {Schedule: "* * * * *"},
+		"SingleSchedules":   {Schedules: []string{"* * * * *"}},
+		"MultipleSchedules": {Schedules: []string{"* * * * *", "0 * * * *"}},
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				_ = spec.GetScheduleString()
+			}
+		})
+	}
+}
+
+func TestCronWorkflow_ActiveSchedulesAt(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.June, 1, 9, 0, 0, 0, time.UTC)
+	cwf := CronWorkflow{Spec: CronWorkflowSpec{Schedules: []string{"* * * * *", "0 * * * *", "30 * * * *"}, Timezone: "UTC"}}
+	assert.True(t, cwf.IsSchedulable())
+	assert.Equal(t, []string{"* * * * *", "0 * * * *"}, cwf.ActiveSchedulesAt(ctx, now))
+	assert.Equal(t, []string{"* * * * *", "30 * * * *"}, cwf.ActiveSchedulesAt(ctx, now.Add(30*time.Minute)))
+	assert.Empty(t, cwf.ActiveSchedulesAt(ctx, now.Add(30*time.Second)), "no schedule fires between minutes")
+
+	// a schedule's own timezone is respected
+	cwf.Spec.Schedules = []string{"CRON_TZ=America/New_York 0 5 * * *", "0 5 * * *"}
+	assert.Equal(t, []string{"CRON_TZ=America/New_York 0 5 * * *"}, cwf.ActiveSchedulesAt(ctx, now))
+
+	cwf.Spec.Suspend = true
+	assert.False(t, cwf.IsSchedulable())
+	assert.Empty(t, cwf.ActiveSchedulesAt(ctx, now))
+
+	cwf.Spec.Suspend = false
+	cwf.Status.Phase = StoppedPhase
+	assert.False(t, cwf.IsSchedulable())
+	assert.Empty(t, cwf.ActiveSchedulesAt(ctx, now))
+}
+
+func TestCronWorkflow_IsUsingNewSchedule(t *testing.T) {
+	cwf := CronWorkflow{Spec: CronWorkflowSpec{Schedules: []string{"0 0 * * MON", "*/5 * * * *"}}}
+	assert.True(t, cwf.IsUsingNewSchedule())
+
+	cwf.SetSchedule("00 00 * * 1,*/05 * * * *")
+	assert.False(t, cwf.IsUsingNewSchedule())
+
+	cwf.Spec.Schedules = []string{"0 1 * * MON", "*/5 * * * *"}
+	assert.True(t, cwf.IsUsingNewSchedule())
+}
+
+func TestCronWorkflow_GetLatestScheduleList(t *testing.T) {
+	var cwf CronWorkflow
+	assert.Nil(t, cwf.GetLatestScheduleList())
+
+	for _, schedules := range [][]string{
+		{"0 0 * * *"},
+		{"0,30 * * * *"},
+		{"0,30 * * * *", "0 0 * * *", "0 12 * * 1,2"},
+		{"CRON_TZ=Asia/Tokyo 0 9 * * 1-5", "TZ=UTC 0,15,30 * * * *", "@daily", "@every 1h30m", "CRON_TZ=UTC @hourly"},
+		{"@daily", "0 12 * * 1,2", "@weekly"},
+	} {
+		cwf.SetSchedules(schedules)
+		assert.Equal(t, schedules, cwf.GetLatestScheduleList())
+	}
+
+	cwf.SetSchedule(" 0 0 * * * , */5 * * * *")
+	assert.Equal(t, []string{"0 0 * * *", "*/5 * * * *"}, cwf.GetLatestScheduleList())
+}
+
+func TestCronWorkflow_IsUsingNewScheduleReordered(t *testing.T) {
+	cwf := CronWorkflow{Spec: CronWorkflowSpec{Schedules: []string{"0,30 * * * *", "0 0 * * *", "0 12 * * 1,2"}, Timezone: "Asia/Tokyo"}}
+	cwf.SetSchedule(cwf.Spec.GetScheduleWithTimezoneString())
+	assert.Equal(t, "CRON_TZ=Asia/Tokyo 0,30 * * * *,CRON_TZ=Asia/Tokyo 0 0 * * *,CRON_TZ=Asia/Tokyo 0 12 * * 1,2", cwf.GetLatestSchedule())
+	assert.False(t, cwf.IsUsingNewSchedule())
+
+	cwf.Spec.Schedules = []string{"0 12 * * 1,2", "0,30 * * * *", "0 0 * * *"}
+	assert.False(t, cwf.IsUsingNewSchedule())
+	assert.Equal(t, "CRON_TZ=Asia/Tokyo 0,30 * * * *,CRON_TZ=Asia/Tokyo 0 0 * * *,CRON_TZ=Asia/Tokyo 0 12 * * 1,2", cwf.GetLatestSchedule())
+
+	cwf.Spec.Schedules = []string{"0 12 * * 1,2", "0,30 * * * *"}
+	assert.True(t, cwf.IsUsingNewSchedule(), "a schedule was removed")
+
+	cwf.Spec.Schedules = []string{"0 12 * * 1,2", "0,30 * * * *", "0 0 * * *", "0 0 * * *"}
+	assert.True(t, cwf.IsUsingNewSchedule(), "a schedule was duplicated")
+
+	cwf.Spec.Schedules = []string{"0 12 * * 1", "2,0,30 * * * *", "0 0 * * *"}
+	assert.True(t, cwf.IsUsingNewSchedule(), "a comma moved between schedules")
+
+	cwf.Spec.Timezone = ""
+	cwf.Spec.Schedules = []string{"0,30 * * * *", "0 0 * * *", "0 12 * * 1,2"}
+	assert.True(t, cwf.IsUsingNewSchedule(), "the timezone was removed")
+}
+
+func TestCronWorkflowSpec_ScheduleStringRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	spec := CronWorkflowSpec{Timezone: "Asia/Tokyo", Schedules: []string{"0 9 * * *", "CRON_TZ=America/New_York 0 9 * * *", "TZ=UTC 0 9 * * *"}}
+	schedules := spec.GetSchedulesWithTimezone(ctx)
+	assert.Equal(t, []string{"CRON_TZ=Asia/Tokyo 0 9 * * *", "CRON_TZ=America/New_York 0 9 * * *", "TZ=UTC 0 9 * * *"}, schedules)
+	scheduleString := spec.GetScheduleWithTimezoneString()
+	assert.Equal(t, strings.Join(schedules, ","), scheduleString)
+	assert.Equal(t, scheduleString, spec.GetScheduleWithTimezoneString(), "stable")
+
+	from := time.Date(2021, 2, 19, 0, 0, 0, 0, time.UTC)
+	for schedule, want := range map[string]time.Time{
+		schedules[0]: time.Date(2021, 2, 20, 0, 0, 0, 0, time.UTC),
+		schedules[1]: time.Date(2021, 2, 19, 14, 0, 0, 0, time.UTC),
+		schedules[2]: time.Date(2021, 2, 19, 9, 0, 0, 0, time.UTC),
+	} {
+		cronSchedule, err := ParseCronSchedule(schedule)
+		require.NoError(t, err)
+		assert.True(t, want.Equal(cronSchedule.Next(from)), schedule)
+	}
+
+	cwf := CronWorkflow{Spec: spec}
+	cwf.SetSchedule(scheduleString)
+	assert.False(t, cwf.IsUsingNewSchedule())
+}
+
+func TestCronWorkflowSpec_IsFrozen(t *testing.T) {
+	spec := CronWorkflowSpec{}
+	assert.False(t, spec.IsFrozen(map[string]string{"freeze": "true"}), "no freeze reference")
+
+	spec.Freeze = &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "deploy"}, Key: "freeze"}
+	for value, frozen := range map[string]bool{"true": true, " True\n": true, "1": true, "false": false, "": false, "yes": false} {
+		assert.Equal(t, frozen, spec.IsFrozen(map[string]string{"freeze": value}), value)
+	}
+	assert.False(t, spec.IsFrozen(nil))
+	assert.False(t, spec.IsFrozen(map[string]string{"other": "true"}))
+}
+
+func TestResolveTimezone(t *testing.T) {
+	spec := CronWorkflowSpec{}
+	assert.Empty(t, spec.ResolveTimezone(""), "the controller's local time")
+	assert.Equal(t, "Europe/Paris", spec.ResolveTimezone("Europe/Paris"))
+	spec.Timezone = "Asia/Tokyo"
+	assert.Equal(t, "Asia/Tokyo", spec.ResolveTimezone("Europe/Paris"), "the CronWorkflow's timezone overrides the namespace default")
+	assert.Equal(t, "Asia/Tokyo", spec.ResolveTimezone(""))
+}
+
+func TestIsRecurring(t *testing.T) {
+	for name, tt := range map[string]struct {
+		spec      CronWorkflowSpec
+		recurring bool
+	}{
+		"None":                     {CronWorkflowSpec{}, false},
+		"Schedule":                 {CronWorkflowSpec{Schedule: "0 * * * *"}, true},
+		"Schedules":                {CronWorkflowSpec{Schedules: []string{"0 * * * *", "30 * * * *"}}, true},
+		"Every":                    {CronWorkflowSpec{Schedules: []string{"@every 90m"}}, true},
+		"ScheduleWindow":           {CronWorkflowSpec{ScheduleWindow: &ScheduleWindow{Start: "01:00", End: "03:00"}}, true},
+		"MaxRuns":                  {CronWorkflowSpec{Schedules: []string{"0 * * * *"}, MaxRuns: ptr.To(int64(3))}, false},
+		"ScheduleWindowAndMaxRuns": {CronWorkflowSpec{ScheduleWindow: &ScheduleWindow{Start: "01:00", End: "03:00"}, MaxRuns: ptr.To(int64(1))}, false},
+		"MaxRunsOnly":              {CronWorkflowSpec{MaxRuns: ptr.To(int64(1))}, false},
+		"StopStrategy":             {CronWorkflowSpec{Schedule: "0 * * * *", StopStrategy: &StopStrategy{Expression: "cronworkflow.succeeded >= 1"}}, true},
+		"Suspended":                {CronWorkflowSpec{Schedule: "0 * * * *", Suspend: true}, true},
+	} {
+		assert.Equal(t, tt.recurring, tt.spec.IsRecurring(), name)
+	}
+}
+
+func TestScheduleMode(t *testing.T) {
+	window := &ScheduleWindow{Start: "01:00", End: "03:00"}
+	for name, tt := range map[string]struct {
+		spec CronWorkflowSpec
+		mode ScheduleMode
+	}{
+		"None":                 {CronWorkflowSpec{}, ScheduleModeNone},
+		"Schedule":             {CronWorkflowSpec{Schedule: "0 * * * *"}, ScheduleModeCron},
+		"Schedules":            {CronWorkflowSpec{Schedules: []string{"0 * * * *", "@daily", "CRON_TZ=UTC 30 * * * *"}}, ScheduleModeCron},
+		"Every":                {CronWorkflowSpec{Schedule: "@every 90m"}, ScheduleModeInterval},
+		"EveryWithTimezone":    {CronWorkflowSpec{Schedules: []string{"CRON_TZ=UTC @every 2m", "@every 1h"}}, ScheduleModeInterval},
+		"ScheduleWindow":       {CronWorkflowSpec{ScheduleWindow: window}, ScheduleModeWindow},
+		"MaxRuns":              {CronWorkflowSpec{Schedule: "0 * * * *", MaxRuns: ptr.To(int64(1))}, ScheduleModeCron},
+		"ScheduleAndSchedules": {CronWorkflowSpec{Schedule: "0 * * * *", Schedules: []string{"30 * * * *"}}, ScheduleModeAmbiguous},
+		"EveryAndCron":         {CronWorkflowSpec{Schedules: []string{"0 * * * *", "@every 90m"}}, ScheduleModeAmbiguous},
+		"CronAndWindow":        {CronWorkflowSpec{Schedule: "0 * * * *", ScheduleWindow: window}, ScheduleModeAmbiguous},
+		"EveryAndWindow":       {CronWorkflowSpec{Schedules: []string{"@every 90m"}, ScheduleWindow: window}, ScheduleModeAmbiguous},
+	} {
+		assert.Equal(t, tt.mode, tt.spec.ScheduleMode(), name)
+	}
+}
+
+func TestSummarizeTimezones(t *testing.T) {
+	list := &CronWorkflowList{Items: []CronWorkflow{
+		{Spec: CronWorkflowSpec{Schedule: "0 * * * *"}},
+		{Spec: CronWorkflowSpec{Schedules: []string{"0 * * * *"}, Timezone: "Asia/Tokyo"}},
+		{Spec: CronWorkflowSpec{Schedules: []string{"0 9 * * *", "0 17 * * *"}, Timezone: "Asia/Tokyo"}},
+		// a schedule's own timezone takes precedence, and the CronWorkflow is counted once per timezone it uses
+		{Spec: CronWorkflowSpec{Schedules: []string{"CRON_TZ=Europe/Paris 0 9 * * *", "0 17 * * *"}, Timezone: "Asia/Tokyo"}},
+		{Spec: CronWorkflowSpec{Schedules: []string{"CRON_TZ=Europe/Paris 0 9 * * *", "TZ=Europe/Paris 0 17 * * *"}}},
+		{Spec: CronWorkflowSpec{ScheduleWindow: &ScheduleWindow{Start: "01:00", End: "03:00"}, Timezone: "America/New_York"}},
+	}}
+	assert.Equal(t, map[string]int{"Local": 1, "Asia/Tokyo": 3, "Europe/Paris": 2, "America/New_York": 1}, SummarizeTimezones(list))
+	assert.Empty(t, SummarizeTimezones(&CronWorkflowList{}))
+
+	assert.Equal(t, []string{"Europe/Paris", "Local"}, (&CronWorkflowSpec{Schedules: []string{"CRON_TZ=Europe/Paris 0 9 * * *", "0 17 * * *"}}).Timezones())
+}
+
+func TestReferencedTimezones(t *testing.T) {
+	for name, tt := range map[string]struct {
+		spec       CronWorkflowSpec
+		referenced []string
+		invalid    []string
+	}{
+		"None":     {CronWorkflowSpec{Schedule: "0 * * * *"}, nil, nil},
+		"Spec":     {CronWorkflowSpec{Schedule: "0 * * * *", Timezone: "Asia/Tokyo"}, []string{"Asia/Tokyo"}, nil},
+		"Schedule": {CronWorkflowSpec{Schedule: "TZ=UTC 0 * * * *"}, []string{"UTC"}, nil},
+		// the spec's timezone is referenced even though every schedule has its own
+		"Overridden": {
+			CronWorkflowSpec{Schedules: []string{"CRON_TZ=Europe/Paris 0 9 * * *", "TZ=Europe/Paris 0 17 * * *"}, Timezone: "Asia/Tokyo"},
+			[]string{"Asia/Tokyo", "Europe/Paris"},
+			nil,
+		},
+		"Mixed": {
+			CronWorkflowSpec{Schedules: []string{"CRON_TZ=Mars/Olympus 0 9 * * *", "0 12 * * *", "CRON_TZ=Europe/Paris 0 17 * * *", "TZ=Nowhere 0 18 * * *"}, Timezone: "America/New_York"},
+			[]string{"America/New_York", "Europe/Paris", "Mars/Olympus", "Nowhere"},
+			[]string{"Mars/Olympus", "Nowhere"},
+		},
+		"InvalidSpec": {
+			CronWorkflowSpec{ScheduleWindow: &ScheduleWindow{Start: "01:00", End: "03:00"}, Timezone: "Asia/Nowhere"},
+			[]string{"Asia/Nowhere"},
+			[]string{"Asia/Nowhere"},
+		},
+	} {
+		assert.Equal(t, tt.referenced, tt.spec.ReferencedTimezones(), name)
+		assert.Equal(t, tt.invalid, tt.spec.InvalidTimezones(), name)
+	}
+}
+
+func TestCronWorkflow_ReleaseActiveIfSuspended(t *testing.T) {
+	released := make([]types.UID, 1, 2)
+	released[0] = "a"
+	cronWf := &CronWorkflow{
+		Spec:   CronWorkflowSpec{Suspend: true, SuspendPolicy: SuspendPolicyImmediate},
+		Status: CronWorkflowStatus{Active: []v1.ObjectReference{{UID: "a"}, {UID: "b"}}, Released: released},
+	}
+	assert.True(t, cronWf.ReleaseActiveIfSuspended())
+	assert.Equal(t, []types.UID{"a", "b"}, cronWf.Status.Released)
+	assert.Equal(t, types.UID(""), released[:2][1], "the previous Released is not modified")
+	assert.False(t, cronWf.ReleaseActiveIfSuspended())
+
+	cronWf.Spec.SuspendPolicy = SuspendPolicyDrainActive
+	cronWf.Status.Released = nil
+	assert.False(t, cronWf.ReleaseActiveIfSuspended())
+}
@@ -22,6 +22,7 @@ import (
 	reflect "reflect"
 	strings "strings"
 
+	k8s_io_apimachinery_pkg_types "k8s.io/apimachinery/pkg/types"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -3060,10 +3061,38 @@ func (m *S3EncryptionOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 
+func (m *ScheduleWindow) Reset()      { *m = ScheduleWindow{} }
+func (*ScheduleWindow) ProtoMessage() {}
+func (*ScheduleWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{108}
+}
+func (m *ScheduleWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduleWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleWindow.Merge(m, src)
+}
+func (m *ScheduleWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleWindow proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3120,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3148,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3176,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3204,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3232,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
 func (*StopStrategy) ProtoMessage() {}
 func (*StopStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3260,7 @@ var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{115}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3288,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +3316,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3344,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3372,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
 func (*SyncDatabaseRef) ProtoMessage() {}
 func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,7 +3400,7 @@ var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +3428,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +3456,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +3484,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3483,7 +3512,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{123}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3511,7 +3540,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{124}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,7 +3568,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{125}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +3596,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{126}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +3624,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{127}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3623,7 +3652,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{128}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3651,7 +3680,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{129}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3679,7 +3708,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{130}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3707,7 +3736,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTask) Reset()      { *m = WorkflowArtifactGCTask{} }
 func (*WorkflowArtifactGCTask) ProtoMessage() {}
 func (*WorkflowArtifactGCTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{131}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *WorkflowArtifactGCTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3735,7 +3764,7 @@ var xxx_messageInfo_WorkflowArtifactGCTask proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTaskList) Reset()      { *m = WorkflowArtifactGCTaskList{} }
 func (*WorkflowArtifactGCTaskList) ProtoMessage() {}
 func (*WorkflowArtifactGCTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{132}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *WorkflowArtifactGCTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3763,7 +3792,7 @@ var xxx_messageInfo_WorkflowArtifactGCTaskList proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{133}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3791,7 +3820,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{134}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3819,7 +3848,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{135}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3847,7 +3876,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowLevelArtifactGC) Reset()      { *m = WorkflowLevelArtifactGC{} }
 func (*WorkflowLevelArtifactGC) ProtoMessage() {}
 func (*WorkflowLevelArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{136}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *WorkflowLevelArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3875,7 +3904,7 @@ var xxx_messageInfo_WorkflowLevelArtifactGC proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{137}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3903,7 +3932,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowMetadata) Reset()      { *m = WorkflowMetadata{} }
 func (*WorkflowMetadata) ProtoMessage() {}
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{138}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *WorkflowMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3931,7 +3960,7 @@ var xxx_messageInfo_WorkflowMetadata proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{139}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3959,7 +3988,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{140}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +4016,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{141}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4015,7 +4044,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskResult) Reset()      { *m = WorkflowTaskResult{} }
 func (*WorkflowTaskResult) ProtoMessage() {}
 func (*WorkflowTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{142}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *WorkflowTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4043,7 +4072,7 @@ var xxx_messageInfo_WorkflowTaskResult proto.InternalMessageInfo
 func (m *WorkflowTaskResultList) Reset()      { *m = WorkflowTaskResultList{} }
 func (*WorkflowTaskResultList) ProtoMessage() {}
 func (*WorkflowTaskResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{143}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *WorkflowTaskResultList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4071,7 +4100,7 @@ var xxx_messageInfo_WorkflowTaskResultList proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{144}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4099,7 +4128,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{145}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4127,7 +4156,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{146}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4155,7 +4184,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{147}
+	return fileDescriptor_724696e352c3df5f, []int{148}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4183,7 +4212,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{148}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4211,7 +4240,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{149}
+	return fileDescriptor_724696e352c3df5f, []int{150}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4239,7 +4268,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{150}
+	return fileDescriptor_724696e352c3df5f, []int{151}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4267,7 +4296,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{151}
+	return fileDescriptor_724696e352c3df5f, []int{152}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4342,7 +4371,9 @@ func init() {
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflow")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowList")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
+	proto.RegisterMapType((map[string]int64)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec.ScheduleStartingDeadlineSecondsEntry")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
+	proto.RegisterMapType((map[string]WorkflowPhase)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus.ActivePhasesEntry")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask.HooksEntry")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
@@ -4413,6 +4444,7 @@ func init() {
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
+	proto.RegisterType((*ScheduleWindow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScheduleWindow")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
@@ -4478,709 +4510,730 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 11225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6d, 0x70, 0x24, 0xc7,
-	0x75, 0x18, 0x67, 0x81, 0xc5, 0xc7, 0x5b, 0x00, 0x87, 0xeb, 0xfb, 0x5a, 0x82, 0xe4, 0x81, 0x1e,
-	0x8a, 0x0c, 0x69, 0x51, 0x38, 0xf1, 0x28, 0x25, 0x8c, 0x94, 0x48, 0xc2, 0xc7, 0x01, 0x07, 0x02,
-	0x38, 0x80, 0xbd, 0xb8, 0x3b, 0x93, 0xa2, 0x25, 0x0d, 0x76, 0x1b, 0xbb, 0x43, 0xec, 0xce, 0x2c,
-	0x67, 0x66, 0x71, 0x07, 0x7e, 0x48, 0x0a, 0xf5, 0x45, 0xc5, 0xb2, 0x15, 0xcb, 0x92, 0x2c, 0xc9,
-	0x49, 0x95, 0xa2, 0x48, 0x89, 0x4a, 0x76, 0x25, 0x65, 0xff, 0x4a, 0xec, 0x7f, 0xf9, 0xe1, 0x52,
-	0xca, 0xa9, 0x44, 0xae, 0x28, 0x65, 0xfd, 0xb0, 0xc1, 0xe8, 0x9c, 0xa8, 0x52, 0x49, 0xe9, 0x87,
-	0x55, 0x71, 0x12, 0x5f, 0x3e, 0xca, 0xd5, 0x9f, 0xd3, 0x3d, 0x3b, 0x8b, 0x03, 0x70, 0x0d, 0x1c,
-	0xcb, 0xfe, 0x05, 0xec, 0xeb, 0xd7, 0xef, 0x75, 0xf7, 0x74, 0xbf, 0x7e, 0xfd, 0xde, 0xeb, 0xd7,
-	0xb0, 0x56, 0xf7, 0x93, 0x46, 0x67, 0x63, 0xaa, 0x1a, 0xb6, 0x2e, 0x78, 0x51, 0x3d, 0x6c, 0x47,
-	0xe1, 0x4b, 0xec, 0x9f, 0x77, 0xdd, 0x08, 0xa3, 0xad, 0xcd, 0x66, 0x78, 0x23, 0xbe, 0xb0, 0xfd,
-	0xf4, 0x85, 0xf6, 0x56, 0xfd, 0x82, 0xd7, 0xf6, 0xe3, 0x0b, 0x12, 0x7a, 0x61, 0xfb, 0x29, 0xaf,
-	0xd9, 0x6e, 0x78, 0x4f, 0x5d, 0xa8, 0x93, 0x80, 0x44, 0x5e, 0x42, 0x6a, 0x53, 0xed, 0x28, 0x4c,
-	0x42, 0xf4, 0xa1, 0x94, 0xe2, 0x94, 0xa4, 0xc8, 0xfe, 0xf9, 0xa8, 0xa2, 0x38, 0xb5, 0xfd, 0xf4,
-	0x54, 0x7b, 0xab, 0x3e, 0x45, 0x29, 0x4e, 0x49, 0xe8, 0x94, 0xa4, 0x38, 0xf1, 0x2e, 0xad, 0x4d,
-	0xf5, 0xb0, 0x1e, 0x5e, 0x60, 0x84, 0x37, 0x3a, 0x9b, 0xec, 0x17, 0xfb, 0xc1, 0xfe, 0xe3, 0x0c,
-	0x27, 0xdc, 0xad, 0x67, 0xe2, 0x29, 0x3f, 0xa4, 0xed, 0xbb, 0x50, 0x0d, 0x23, 0x72, 0x61, 0xbb,
-	0xab, 0x51, 0x13, 0xef, 0xd0, 0x70, 0xda, 0x61, 0xd3, 0xaf, 0xee, 0xe4, 0x61, 0xbd, 0x27, 0xc5,
-	0x6a, 0x79, 0xd5, 0x86, 0x1f, 0x90, 0x68, 0x27, 0xed, 0x7a, 0x8b, 0x24, 0x5e, 0x5e, 0xad, 0x0b,
-	0xbd, 0x6a, 0x45, 0x9d, 0x20, 0xf1, 0x5b, 0xa4, 0xab, 0xc2, 0xdf, 0xbc, 0x53, 0x85, 0xb8, 0xda,
-	0x20, 0x2d, 0xaf, 0xab, 0xde, 0xd3, 0xbd, 0xea, 0x75, 0x12, 0xbf, 0x79, 0xc1, 0x0f, 0x92, 0x38,
-	0x89, 0xb2, 0x95, 0xdc, 0x4b, 0x30, 0x30, 0xdd, 0x0a, 0x3b, 0x41, 0x82, 0xde, 0x0f, 0xc5, 0x6d,
-	0xaf, 0xd9, 0x21, 0x65, 0xe7, 0x61, 0xe7, 0xf1, 0xe1, 0x99, 0x47, 0xbf, 0xbf, 0x3b, 0x79, 0xdf,
-	0xad, 0xdd, 0xc9, 0xe2, 0x35, 0x0a, 0xbc, 0xbd, 0x3b, 0x79, 0x9a, 0x04, 0xd5, 0xb0, 0xe6, 0x07,
-	0xf5, 0x0b, 0x2f, 0xc5, 0x61, 0x30, 0x75, 0xa5, 0xd3, 0xda, 0x20, 0x11, 0xe6, 0x75, 0xdc, 0xff,
-	0x50, 0x80, 0x13, 0xd3, 0x51, 0xb5, 0xe1, 0x6f, 0x93, 0x4a, 0x42, 0xe9, 0xd7, 0x77, 0x50, 0x03,
-	0xfa, 0x12, 0x2f, 0x62, 0xe4, 0x4a, 0x17, 0x57, 0xa6, 0xee, 0xf6, 0xbb, 0x4f, 0xad, 0x7b, 0x91,
-	0xa4, 0x3d, 0x33, 0x78, 0x6b, 0x77, 0xb2, 0x6f, 0xdd, 0x8b, 0x30, 0x65, 0x81, 0x9a, 0xd0, 0x1f,
-	0x84, 0x01, 0x29, 0x17, 0x18, 0xab, 0x2b, 0x77, 0xcf, 0xea, 0x4a, 0x18, 0xa8, 0x7e, 0xcc, 0x0c,
-	0xdd, 0xda, 0x9d, 0xec, 0xa7, 0x10, 0xcc, 0xb8, 0xd0, 0x7e, 0xbd, 0xe2, 0xb7, 0xcb, 0x7d, 0xb6,
-	0xfa, 0xf5, 0x82, 0xdf, 0x36, 0xfb, 0xf5, 0x82, 0xdf, 0xc6, 0x94, 0x85, 0xfb, 0xf9, 0x02, 0x0c,
-	0x4f, 0x47, 0xf5, 0x4e, 0x8b, 0x04, 0x49, 0x8c, 0x3e, 0x01, 0xd0, 0xf6, 0x22, 0xaf, 0x45, 0x12,
-	0x12, 0xc5, 0x65, 0xe7, 0xe1, 0xbe, 0xc7, 0x4b, 0x17, 0x97, 0xee, 0x9e, 0xfd, 0x9a, 0xa4, 0x39,
-	0x83, 0xc4, 0x27, 0x07, 0x05, 0x8a, 0xb1, 0xc6, 0x12, 0xbd, 0x0a, 0xc3, 0x5e, 0x94, 0xf8, 0x9b,
-	0x5e, 0x35, 0x89, 0xcb, 0x05, 0xc6, 0xff, 0xd9, 0xbb, 0xe7, 0x3f, 0x2d, 0x48, 0xce, 0x9c, 0x14,
-	0xec, 0x87, 0x25, 0x24, 0xc6, 0x29, 0x3f, 0xf7, 0x77, 0xfb, 0xa1, 0x34, 0x1d, 0x25, 0x0b, 0xb3,
-	0x95, 0xc4, 0x4b, 0x3a, 0x31, 0xfa, 0x03, 0x07, 0x4e, 0xc5, 0x7c, 0xd8, 0x7c, 0x12, 0xaf, 0x45,
-	0x61, 0x95, 0xc4, 0x31, 0xa9, 0x89, 0x71, 0xd9, 0xb4, 0xd2, 0x2e, 0xc9, 0x6c, 0xaa, 0xd2, 0xcd,
-	0xe8, 0x52, 0x90, 0x44, 0x3b, 0x33, 0x4f, 0x89, 0x36, 0x9f, 0xca, 0xc1, 0x78, 0xe3, 0xad, 0x49,
-	0x24, 0xbb, 0x42, 0x29, 0xf1, 0x4f, 0x8c, 0xf3, 0x5a, 0x8d, 0xbe, 0xee, 0xc0, 0x48, 0x3b, 0xac,
-	0xc5, 0x98, 0x54, 0xc3, 0x4e, 0x9b, 0xd4, 0xc4, 0xf0, 0x7e, 0xd4, 0x6e, 0x37, 0xd6, 0x34, 0x0e,
-	0xbc, 0xfd, 0xa7, 0x45, 0xfb, 0x47, 0xf4, 0x22, 0x6c, 0x34, 0x05, 0x3d, 0x03, 0x23, 0x41, 0x98,
-	0x54, 0xda, 0xa4, 0xea, 0x6f, 0xfa, 0xa4, 0xc6, 0x26, 0xfe, 0x50, 0x5a, 0xf3, 0x8a, 0x56, 0x86,
-	0x0d, 0xcc, 0x89, 0x79, 0x28, 0xf7, 0x1a, 0x39, 0x34, 0x0e, 0x7d, 0x5b, 0x64, 0x87, 0x0b, 0x1b,
-	0x4c, 0xff, 0x45, 0xa7, 0xa5, 0x00, 0xa2, 0xcb, 0x78, 0x48, 0x48, 0x96, 0xf7, 0x15, 0x9e, 0x71,
-	0x26, 0x3e, 0x08, 0x27, 0xbb, 0x9a, 0x7e, 0x10, 0x02, 0xee, 0x0f, 0x06, 0x60, 0x48, 0x7e, 0x0a,
-	0xf4, 0x30, 0xf4, 0x07, 0x5e, 0x4b, 0xca, 0xb9, 0x11, 0xd1, 0x8f, 0xfe, 0x2b, 0x5e, 0x8b, 0xae,
-	0x70, 0xaf, 0x45, 0x28, 0x46, 0xdb, 0x4b, 0x1a, 0x8c, 0x8e, 0x86, 0xb1, 0xe6, 0x25, 0x0d, 0xcc,
-	0x4a, 0xd0, 0x83, 0xd0, 0xdf, 0x0a, 0x6b, 0x84, 0x8d, 0x45, 0x91, 0x4b, 0x88, 0x95, 0xb0, 0x46,
-	0x30, 0x83, 0xd2, 0xfa, 0x9b, 0x51, 0xd8, 0x2a, 0xf7, 0x9b, 0xf5, 0xe7, 0xa3, 0xb0, 0x85, 0x59,
-	0x09, 0xfa, 0x9a, 0x03, 0xe3, 0x72, 0x6e, 0x2f, 0x87, 0x55, 0x2f, 0xf1, 0xc3, 0xa0, 0x5c, 0x64,
-	0x12, 0x05, 0xdb, 0x5b, 0x52, 0x92, 0xf2, 0x4c, 0x59, 0x34, 0x61, 0x3c, 0x5b, 0x82, 0xbb, 0x5a,
-	0x81, 0x2e, 0x02, 0xd4, 0x9b, 0xe1, 0x86, 0xd7, 0xa4, 0x03, 0x52, 0x1e, 0x60, 0x5d, 0x50, 0x92,
-	0x61, 0x41, 0x95, 0x60, 0x0d, 0x0b, 0xdd, 0x84, 0x41, 0x8f, 0x4b, 0xff, 0xf2, 0x20, 0xeb, 0xc4,
-	0x73, 0x36, 0x3a, 0x61, 0x6c, 0x27, 0x33, 0xa5, 0x5b, 0xbb, 0x93, 0x83, 0x02, 0x88, 0x25, 0x3b,
-	0xf4, 0x24, 0x0c, 0x85, 0x6d, 0xda, 0x6e, 0xaf, 0x59, 0x1e, 0x62, 0x13, 0x73, 0x5c, 0xb4, 0x75,
-	0x68, 0x55, 0xc0, 0xb1, 0xc2, 0x40, 0x4f, 0xc0, 0x60, 0xdc, 0xd9, 0xa0, 0xdf, 0xb1, 0x3c, 0xcc,
-	0x3a, 0x76, 0x42, 0x20, 0x0f, 0x56, 0x38, 0x18, 0xcb, 0x72, 0xf4, 0x5e, 0x28, 0x45, 0xa4, 0xda,
-	0x89, 0x62, 0x42, 0x3f, 0x6c, 0x19, 0x18, 0xed, 0x53, 0x02, 0xbd, 0x84, 0xd3, 0x22, 0xac, 0xe3,
-	0xa1, 0x0f, 0xc0, 0x18, 0xfd, 0xc0, 0x97, 0x6e, 0xb6, 0x23, 0x12, 0xc7, 0xf4, 0xab, 0x96, 0x18,
-	0xa3, 0xb3, 0xa2, 0xe6, 0xd8, 0xbc, 0x51, 0x8a, 0x33, 0xd8, 0xe8, 0x35, 0x00, 0x4f, 0xc9, 0x8c,
-	0xf2, 0x08, 0x1b, 0xcc, 0x65, 0x7b, 0x33, 0x62, 0x61, 0x76, 0x66, 0x8c, 0x7e, 0xc7, 0xf4, 0x37,
-	0xd6, 0xf8, 0xd1, 0xf1, 0xa9, 0x91, 0x26, 0x49, 0x48, 0xad, 0x3c, 0xca, 0x3a, 0xac, 0xc6, 0x67,
-	0x8e, 0x83, 0xb1, 0x2c, 0x77, 0x7f, 0xa3, 0x00, 0x1a, 0x15, 0x34, 0x03, 0x43, 0x42, 0xae, 0x89,
-	0x25, 0x39, 0xf3, 0x98, 0xfc, 0x0e, 0xf2, 0x0b, 0xde, 0xde, 0xcd, 0x95, 0x87, 0xaa, 0x1e, 0x7a,
-	0x1d, 0x4a, 0xed, 0xb0, 0xb6, 0x42, 0x12, 0xaf, 0xe6, 0x25, 0x9e, 0xd8, 0xcd, 0x2d, 0xec, 0x30,
-	0x92, 0xe2, 0xcc, 0x09, 0xfa, 0xe9, 0xd6, 0x52, 0x16, 0x58, 0xe7, 0x87, 0x9e, 0x05, 0x14, 0x93,
-	0x68, 0xdb, 0xaf, 0x92, 0xe9, 0x6a, 0x95, 0xaa, 0x44, 0x6c, 0x01, 0xf4, 0xb1, 0xce, 0x4c, 0x88,
-	0xce, 0xa0, 0x4a, 0x17, 0x06, 0xce, 0xa9, 0xe5, 0xfe, 0xb0, 0x00, 0x63, 0x5a, 0x5f, 0xdb, 0xa4,
-	0x8a, 0xbe, 0xeb, 0xc0, 0x09, 0xb5, 0x9d, 0xcd, 0xec, 0x5c, 0xa1, 0xb3, 0x8a, 0x6f, 0x56, 0xc4,
-	0xe6, 0xf7, 0xa5, 0xbc, 0xd4, 0x4f, 0xc1, 0x87, 0xcb, 0xfa, 0x73, 0xa2, 0x0f, 0x27, 0x32, 0xa5,
-	0x38, 0xdb, 0xac, 0x89, 0xaf, 0x3a, 0x70, 0x3a, 0x8f, 0x44, 0x8e, 0xcc, 0x6d, 0xe8, 0x32, 0xd7,
-	0xaa, 0xf0, 0xa2, 0x5c, 0x69, 0x67, 0x74, 0x39, 0xfe, 0xff, 0x0b, 0x30, 0xae, 0x4f, 0x21, 0xa6,
-	0x09, 0xfc, 0x6b, 0x07, 0xce, 0xc8, 0x1e, 0x60, 0x12, 0x77, 0x9a, 0x99, 0xe1, 0x6d, 0x59, 0x1d,
-	0x5e, 0xbe, 0x93, 0x4e, 0xe7, 0xf1, 0xe3, 0xc3, 0xfc, 0x90, 0x18, 0xe6, 0x33, 0xb9, 0x38, 0x38,
-	0xbf, 0xa9, 0x13, 0xdf, 0x76, 0x60, 0xa2, 0x37, 0xd1, 0x9c, 0x81, 0x6f, 0x9b, 0x03, 0xff, 0x82,
-	0xbd, 0x4e, 0x72, 0xf6, 0x6c, 0xf8, 0x59, 0x67, 0xf5, 0x0f, 0xf0, 0x5b, 0x43, 0xd0, 0xb5, 0x87,
-	0xa0, 0xa7, 0xa0, 0x24, 0xc4, 0xf1, 0x72, 0x58, 0x8f, 0x59, 0x23, 0x87, 0xf8, 0x5a, 0x9b, 0x4e,
-	0xc1, 0x58, 0xc7, 0x41, 0x35, 0x28, 0xc4, 0x4f, 0x8b, 0xa6, 0x5b, 0x10, 0x6f, 0x95, 0xa7, 0x95,
-	0x16, 0x39, 0x70, 0x6b, 0x77, 0xb2, 0x50, 0x79, 0x1a, 0x17, 0xe2, 0xa7, 0xa9, 0xa6, 0x5e, 0xf7,
-	0x13, 0x7b, 0x9a, 0xfa, 0x82, 0x9f, 0x28, 0x3e, 0x4c, 0x53, 0x5f, 0xf0, 0x13, 0x4c, 0x59, 0xd0,
-	0x13, 0x48, 0x23, 0x49, 0xda, 0x6c, 0xc7, 0xb7, 0x72, 0x02, 0xb9, 0xbc, 0xbe, 0xbe, 0xa6, 0x78,
-	0x31, 0xfd, 0x82, 0x42, 0x30, 0xe3, 0x82, 0xde, 0x74, 0xe8, 0x88, 0xf3, 0xc2, 0x30, 0xda, 0x11,
-	0x8a, 0xc3, 0x55, 0x7b, 0x53, 0x20, 0x8c, 0x76, 0x14, 0x73, 0xf1, 0x21, 0x55, 0x01, 0xd6, 0x59,
-	0xb3, 0x8e, 0xd7, 0x36, 0x63, 0xa6, 0x27, 0xd8, 0xe9, 0xf8, 0xdc, 0x7c, 0x25, 0xd3, 0xf1, 0xb9,
-	0xf9, 0x0a, 0x66, 0x5c, 0xe8, 0x07, 0x8d, 0xbc, 0x1b, 0x42, 0xc7, 0xb0, 0xf0, 0x41, 0xb1, 0x77,
-	0xc3, 0xfc, 0xa0, 0xd8, 0xbb, 0x81, 0x29, 0x0b, 0xca, 0x29, 0x8c, 0x63, 0xa6, 0x52, 0x58, 0xe1,
-	0xb4, 0x5a, 0xa9, 0x98, 0x9c, 0x56, 0x2b, 0x15, 0x4c, 0x59, 0xb0, 0x49, 0x5a, 0x8d, 0x99, 0x3e,
-	0x62, 0x67, 0x92, 0xce, 0x66, 0x38, 0x2d, 0xcc, 0x56, 0x30, 0x65, 0x41, 0x45, 0x86, 0xf7, 0x4a,
-	0x27, 0xe2, 0xca, 0x4c, 0xe9, 0xe2, 0xaa, 0x85, 0xf9, 0x42, 0xc9, 0x29, 0x6e, 0xc3, 0xb7, 0x76,
-	0x27, 0x8b, 0x0c, 0x84, 0x39, 0x23, 0xf7, 0xf7, 0xfb, 0x52, 0x71, 0x21, 0xe5, 0x39, 0xfa, 0x55,
-	0xb6, 0x11, 0x0a, 0x59, 0x20, 0x54, 0x5f, 0xe7, 0xc8, 0x54, 0xdf, 0x53, 0x7c, 0xc7, 0x33, 0xd8,
-	0xe1, 0x2c, 0x7f, 0xf4, 0x25, 0xa7, 0xfb, 0x6c, 0xeb, 0xd9, 0xdf, 0xcb, 0xd2, 0x8d, 0x99, 0xef,
-	0x15, 0x7b, 0x1e, 0x79, 0x27, 0xde, 0x74, 0x52, 0x25, 0x22, 0xee, 0xb5, 0x0f, 0x7c, 0xcc, 0xdc,
-	0x07, 0x2c, 0x1e, 0xc8, 0x75, 0xb9, 0xff, 0x79, 0x07, 0x46, 0x25, 0x9c, 0xaa, 0xc7, 0x31, 0xba,
-	0x09, 0x43, 0xb2, 0xa5, 0xe2, 0xeb, 0xd9, 0xb4, 0x05, 0x28, 0x25, 0x5e, 0x35, 0x46, 0x71, 0x73,
-	0xbf, 0x3b, 0x00, 0x28, 0xdd, 0xab, 0xda, 0x61, 0xec, 0x33, 0x49, 0x74, 0x88, 0x5d, 0x28, 0xd0,
-	0x76, 0xa1, 0x6b, 0x36, 0x77, 0xa1, 0xb4, 0x59, 0xc6, 0x7e, 0xf4, 0xa5, 0x8c, 0xdc, 0xe6, 0x1b,
-	0xd3, 0x47, 0x8f, 0x44, 0x6e, 0x6b, 0x4d, 0xd8, 0x5b, 0x82, 0x6f, 0x0b, 0x09, 0xce, 0xb7, 0xae,
-	0x5f, 0xb0, 0x2b, 0xc1, 0xb5, 0x56, 0x64, 0x65, 0x79, 0xc4, 0x25, 0x2c, 0xdf, 0xbb, 0xae, 0x5b,
-	0x95, 0xb0, 0x1a, 0x57, 0x53, 0xd6, 0x46, 0x5c, 0xd6, 0x0e, 0xd8, 0xe2, 0xa9, 0xc9, 0xda, 0x2c,
-	0x4f, 0x25, 0x75, 0x5f, 0x91, 0x52, 0x97, 0xef, 0x5a, 0xcf, 0x5b, 0x96, 0xba, 0x1a, 0xdf, 0x6e,
-	0xf9, 0xfb, 0x32, 0x9c, 0xe9, 0xc6, 0xc3, 0x64, 0x13, 0x5d, 0x80, 0xe1, 0x6a, 0x18, 0x6c, 0xfa,
-	0xf5, 0x15, 0xaf, 0x2d, 0xce, 0x6b, 0x4a, 0x16, 0xcd, 0xca, 0x02, 0x9c, 0xe2, 0xa0, 0x87, 0xb8,
-	0xe0, 0xe1, 0x16, 0x91, 0x92, 0x40, 0xed, 0x5b, 0x22, 0x3b, 0x4c, 0x0a, 0xbd, 0x6f, 0xe8, 0x6b,
-	0xdf, 0x9c, 0xbc, 0xef, 0x93, 0x7f, 0xfc, 0xf0, 0x7d, 0xee, 0x1f, 0xf6, 0xc1, 0x03, 0xb9, 0x3c,
-	0x85, 0xb6, 0xfe, 0x5b, 0x86, 0xb6, 0xae, 0x95, 0x0b, 0x29, 0x72, 0xdd, 0xa6, 0x22, 0xab, 0x91,
-	0xcf, 0xd3, 0xcb, 0xb5, 0x62, 0x9c, 0xdf, 0x28, 0x3a, 0x50, 0x81, 0xd7, 0x22, 0x71, 0xdb, 0xab,
-	0x12, 0xd1, 0x7b, 0x35, 0x50, 0x57, 0x64, 0x01, 0x4e, 0x71, 0xf8, 0x11, 0x7a, 0xd3, 0xeb, 0x34,
-	0x13, 0x61, 0x28, 0xd3, 0x8e, 0xd0, 0x0c, 0x8c, 0x65, 0x39, 0xfa, 0x87, 0x0e, 0xa0, 0x6e, 0xae,
-	0x62, 0x21, 0xae, 0x1f, 0xc5, 0x38, 0xcc, 0x9c, 0xbd, 0xa5, 0x1d, 0xc2, 0xb5, 0x9e, 0xe6, 0xb4,
-	0x43, 0xfb, 0xa6, 0x1f, 0x4f, 0xf7, 0x21, 0x7e, 0x38, 0xd8, 0x87, 0x0d, 0x8d, 0x99, 0x5a, 0xaa,
-	0x55, 0x12, 0xc7, 0xdc, 0x1c, 0xa7, 0x9b, 0x5a, 0x18, 0x18, 0xcb, 0x72, 0x34, 0x09, 0x45, 0x12,
-	0x45, 0x61, 0x24, 0xce, 0xda, 0x6c, 0x1a, 0x5f, 0xa2, 0x00, 0xcc, 0xe1, 0xee, 0x4f, 0x0a, 0x50,
-	0xee, 0x75, 0x3a, 0x41, 0xbf, 0xa3, 0x9d, 0xab, 0xc5, 0xc9, 0x49, 0x1c, 0xfc, 0xc2, 0xa3, 0x3b,
-	0x13, 0x65, 0x0f, 0x80, 0x3d, 0x4e, 0xd8, 0xa2, 0x14, 0x67, 0x1b, 0x38, 0xf1, 0x65, 0xed, 0x84,
-	0xad, 0x93, 0xc8, 0xd9, 0xe0, 0x37, 0xcd, 0x0d, 0x7e, 0xcd, 0x76, 0xa7, 0xf4, 0x6d, 0xfe, 0x4f,
-	0x8a, 0x70, 0x4a, 0x96, 0x56, 0x08, 0xdd, 0x2a, 0x9f, 0xeb, 0x90, 0x68, 0x07, 0xfd, 0x91, 0x03,
-	0xa7, 0xbd, 0xac, 0xe9, 0xc6, 0x27, 0x47, 0x30, 0xd0, 0x1a, 0xd7, 0xa9, 0xe9, 0x1c, 0x8e, 0x7c,
-	0xa0, 0x2f, 0x8a, 0x81, 0x3e, 0x9d, 0x87, 0xd2, 0xc3, 0xee, 0x9e, 0xdb, 0x01, 0xf4, 0x0c, 0x8c,
-	0x48, 0x38, 0x33, 0xf7, 0xf0, 0x25, 0xae, 0x8c, 0xdb, 0xd3, 0x5a, 0x19, 0x36, 0x30, 0x69, 0xcd,
-	0x84, 0xb4, 0xda, 0x4d, 0x2f, 0x21, 0x9a, 0xa1, 0x48, 0xd5, 0x5c, 0xd7, 0xca, 0xb0, 0x81, 0x89,
-	0x1e, 0x83, 0x81, 0x20, 0xac, 0x91, 0xc5, 0x9a, 0x30, 0x10, 0x8f, 0x89, 0x3a, 0x03, 0x57, 0x18,
-	0x14, 0x8b, 0x52, 0xf4, 0x68, 0x6a, 0x8d, 0x2b, 0xb2, 0x25, 0x54, 0xca, 0xb3, 0xc4, 0xa1, 0x7f,
-	0xec, 0xc0, 0x30, 0xad, 0xb1, 0xbe, 0xd3, 0x26, 0x74, 0x6f, 0xa3, 0x5f, 0xa4, 0x76, 0x34, 0x5f,
-	0xe4, 0x8a, 0x64, 0x63, 0x9a, 0x3a, 0x86, 0x15, 0xfc, 0x8d, 0xb7, 0x26, 0x87, 0xe4, 0x0f, 0x9c,
-	0xb6, 0x6a, 0x62, 0x01, 0xee, 0xef, 0xf9, 0x35, 0x0f, 0xe4, 0x0a, 0xf8, 0x3b, 0x30, 0x66, 0x36,
-	0xe2, 0x40, 0x7e, 0x80, 0x7f, 0xa9, 0x2d, 0x3b, 0xde, 0x2f, 0x21, 0xcf, 0xee, 0x99, 0x36, 0xab,
-	0x26, 0xc3, 0x9c, 0x98, 0x7a, 0xe6, 0x64, 0x98, 0x13, 0x93, 0x61, 0xce, 0xfd, 0x03, 0x27, 0x5d,
-	0x9a, 0x9a, 0x9a, 0x47, 0x37, 0xe6, 0x4e, 0xd4, 0x14, 0x82, 0x58, 0x6d, 0xcc, 0x57, 0xf1, 0x32,
-	0xa6, 0x70, 0xf4, 0x65, 0x4d, 0x3a, 0xd2, 0x6a, 0x1d, 0xe1, 0xd6, 0xb0, 0x64, 0xa2, 0x37, 0x08,
-	0x77, 0xcb, 0x3f, 0x51, 0x80, 0xb3, 0x4d, 0x70, 0xbf, 0x54, 0x80, 0x87, 0xf6, 0x54, 0x5a, 0x73,
-	0x1b, 0xee, 0xdc, 0xf3, 0x86, 0xd3, 0x6d, 0x2d, 0x22, 0xed, 0xf0, 0x2a, 0x5e, 0x16, 0xdf, 0x4b,
-	0x6d, 0x6b, 0x98, 0x83, 0xb1, 0x2c, 0xa7, 0xaa, 0xc3, 0x16, 0xd9, 0x99, 0x0f, 0xa3, 0x96, 0x97,
-	0x08, 0xe9, 0xa0, 0x54, 0x87, 0x25, 0x59, 0x80, 0x53, 0x1c, 0xf7, 0x8f, 0x1c, 0xc8, 0x36, 0x00,
-	0x79, 0x30, 0xd6, 0x89, 0x49, 0x44, 0xb7, 0xd4, 0x0a, 0xa9, 0x46, 0x44, 0x4e, 0xcf, 0x47, 0xa7,
-	0xb8, 0xb7, 0x9f, 0xf6, 0x70, 0xaa, 0x1a, 0x46, 0x64, 0x6a, 0xfb, 0xa9, 0x29, 0x8e, 0xb1, 0x44,
-	0x76, 0x2a, 0xa4, 0x49, 0x28, 0x8d, 0x19, 0x74, 0x6b, 0x77, 0x72, 0xec, 0xaa, 0x41, 0x00, 0x67,
-	0x08, 0x52, 0x16, 0x6d, 0x2f, 0x8e, 0x6f, 0x84, 0x51, 0x4d, 0xb0, 0x28, 0x1c, 0x98, 0xc5, 0x9a,
-	0x41, 0x00, 0x67, 0x08, 0xba, 0x3f, 0xa4, 0xc7, 0x47, 0x5d, 0x6b, 0x45, 0xdf, 0xa4, 0xba, 0x0f,
-	0x85, 0xcc, 0x34, 0xc3, 0x8d, 0xd9, 0x30, 0x48, 0x3c, 0x3f, 0x20, 0x32, 0x58, 0x60, 0xdd, 0x92,
-	0x8e, 0x6c, 0xd0, 0x4e, 0x6d, 0xf8, 0xdd, 0x65, 0x38, 0xa7, 0x2d, 0x54, 0xc7, 0xd9, 0x68, 0x86,
-	0x1b, 0x59, 0x2f, 0x20, 0x45, 0xc2, 0xac, 0xc4, 0xfd, 0x99, 0x03, 0xe7, 0x7a, 0x28, 0xe3, 0xe8,
-	0xab, 0x0e, 0x8c, 0x6e, 0xbc, 0x2d, 0xfa, 0x66, 0x36, 0x03, 0x7d, 0x00, 0xc6, 0x28, 0x80, 0xee,
-	0x44, 0x62, 0x6e, 0x16, 0x4c, 0x0f, 0xd5, 0x8c, 0x51, 0x8a, 0x33, 0xd8, 0xee, 0xaf, 0x15, 0x20,
-	0x87, 0x0b, 0x7a, 0x12, 0x86, 0x48, 0x50, 0x6b, 0x87, 0x7e, 0x90, 0x08, 0x61, 0xa4, 0xa4, 0xde,
-	0x25, 0x01, 0xc7, 0x0a, 0x43, 0x9c, 0x3f, 0xc4, 0xc0, 0x14, 0xba, 0xce, 0x1f, 0xa2, 0xe5, 0x29,
-	0x0e, 0xaa, 0xc3, 0xb8, 0xc7, 0xfd, 0x2b, 0x6c, 0xee, 0xb1, 0x69, 0xda, 0x77, 0x90, 0x69, 0x7a,
-	0x9a, 0xb9, 0x3f, 0x33, 0x24, 0x70, 0x17, 0x51, 0xf4, 0x5e, 0x28, 0x75, 0x62, 0x52, 0x99, 0x5b,
-	0x9a, 0x8d, 0x48, 0x8d, 0x9f, 0x8a, 0x35, 0xbf, 0xdf, 0xd5, 0xb4, 0x08, 0xeb, 0x78, 0xee, 0x9f,
-	0x3a, 0x30, 0x38, 0xe3, 0x55, 0xb7, 0xc2, 0xcd, 0x4d, 0x3a, 0x14, 0xb5, 0x4e, 0x94, 0x1a, 0xb6,
-	0xb4, 0xa1, 0x98, 0x13, 0x70, 0xac, 0x30, 0xd0, 0x3a, 0x0c, 0xf0, 0x05, 0x2f, 0x96, 0xdd, 0xbb,
-	0xb5, 0xfe, 0xa8, 0x38, 0x1e, 0x36, 0x1d, 0x3a, 0x89, 0xdf, 0x9c, 0xe2, 0x71, 0x3c, 0x53, 0x8b,
-	0x41, 0xb2, 0x1a, 0x55, 0x92, 0xc8, 0x0f, 0xea, 0x33, 0x40, 0xb7, 0x8b, 0x79, 0x46, 0x03, 0x0b,
-	0x5a, 0xb4, 0x1b, 0x2d, 0xef, 0xa6, 0x64, 0x27, 0xc4, 0x8f, 0xea, 0xc6, 0x4a, 0x5a, 0x84, 0x75,
-	0x3c, 0xba, 0x9b, 0x54, 0xbd, 0xb6, 0xd0, 0x4b, 0xd4, 0x6e, 0x32, 0xeb, 0xb5, 0x31, 0x85, 0xbb,
-	0x7f, 0xe8, 0xc0, 0xf0, 0x8c, 0x17, 0xfb, 0xd5, 0xbf, 0x42, 0xb2, 0xe9, 0x23, 0x50, 0x9c, 0xf5,
-	0xaa, 0x0d, 0x82, 0xae, 0x66, 0xcf, 0xc4, 0xa5, 0x8b, 0x8f, 0xe7, 0xb1, 0x51, 0xe7, 0x63, 0x9d,
-	0xd3, 0x68, 0xaf, 0x93, 0xb3, 0xfb, 0x96, 0x03, 0x63, 0xb3, 0x4d, 0x9f, 0x04, 0xc9, 0x2c, 0x89,
-	0x12, 0x36, 0x70, 0x75, 0x18, 0xaf, 0x2a, 0xc8, 0x61, 0x86, 0x8e, 0x4d, 0xe6, 0xd9, 0x0c, 0x09,
-	0xdc, 0x45, 0x14, 0xd5, 0xe0, 0x04, 0x87, 0xa5, 0x8b, 0xe6, 0x40, 0xe3, 0xc7, 0x8c, 0xa7, 0xb3,
-	0x26, 0x05, 0x9c, 0x25, 0xe9, 0xfe, 0xd4, 0x81, 0x73, 0xb3, 0xcd, 0x4e, 0x9c, 0x90, 0xe8, 0xba,
-	0x10, 0x56, 0x52, 0xfb, 0x45, 0x1f, 0x83, 0xa1, 0x96, 0x74, 0xe8, 0x3a, 0x77, 0x98, 0xdf, 0x4c,
-	0xdc, 0x51, 0x6c, 0xda, 0x98, 0xd5, 0x8d, 0x97, 0x48, 0x35, 0x59, 0x21, 0x89, 0x97, 0x46, 0x1f,
-	0xa4, 0x30, 0xac, 0xa8, 0xa2, 0x36, 0xf4, 0xc7, 0x6d, 0x52, 0xb5, 0x17, 0xfc, 0x25, 0xfb, 0x50,
-	0x69, 0x93, 0x6a, 0x2a, 0xf6, 0x99, 0x2b, 0x92, 0x71, 0x72, 0xff, 0x8f, 0x03, 0x0f, 0xf4, 0xe8,
-	0xef, 0xb2, 0x1f, 0x27, 0xe8, 0xc5, 0xae, 0x3e, 0x4f, 0xed, 0xaf, 0xcf, 0xb4, 0x36, 0xeb, 0xb1,
-	0x92, 0x17, 0x12, 0xa2, 0xf5, 0xf7, 0xe3, 0x50, 0xf4, 0x13, 0xd2, 0x92, 0x56, 0x6a, 0x0b, 0xf6,
-	0xa4, 0x1e, 0x7d, 0x99, 0x19, 0x95, 0x21, 0x80, 0x8b, 0x94, 0x1f, 0xe6, 0x6c, 0xdd, 0x2d, 0x18,
-	0x98, 0x0d, 0x9b, 0x9d, 0x56, 0xb0, 0xbf, 0x40, 0x9a, 0x64, 0xa7, 0x4d, 0xb2, 0x5b, 0x28, 0x3b,
-	0x1d, 0xb0, 0x12, 0x69, 0x57, 0xea, 0xcb, 0xb7, 0x2b, 0xb9, 0xff, 0xc6, 0x01, 0xba, 0xaa, 0x6a,
-	0xbe, 0x70, 0x34, 0x72, 0x72, 0x9c, 0xe1, 0x43, 0x3a, 0xb9, 0xdb, 0xbb, 0x93, 0xa3, 0x0a, 0x51,
-	0xa3, 0xff, 0x11, 0x18, 0x88, 0xd9, 0x89, 0x5d, 0xb4, 0x61, 0x5e, 0xaa, 0xd7, 0xfc, 0x1c, 0x7f,
-	0x7b, 0x77, 0x72, 0x5f, 0x51, 0x9d, 0x53, 0x8a, 0xb6, 0xf0, 0x89, 0x0a, 0xaa, 0x54, 0x1f, 0x6c,
-	0x91, 0x38, 0xf6, 0xea, 0xf2, 0x00, 0xa8, 0xf4, 0xc1, 0x15, 0x0e, 0xc6, 0xb2, 0xdc, 0xfd, 0x8a,
-	0x03, 0xa3, 0x6a, 0x6f, 0xa3, 0xda, 0x3d, 0xba, 0xa2, 0xef, 0x82, 0x7c, 0xa6, 0x3c, 0xd4, 0x43,
-	0xe2, 0x88, 0x7d, 0x7e, 0xef, 0x4d, 0xf2, 0x3d, 0x30, 0x52, 0x23, 0x6d, 0x12, 0xd4, 0x48, 0x50,
-	0xa5, 0xa7, 0x73, 0x3a, 0x43, 0x86, 0x67, 0xc6, 0xe9, 0x71, 0x74, 0x4e, 0x83, 0x63, 0x03, 0xcb,
-	0xfd, 0x96, 0x03, 0xf7, 0x2b, 0x72, 0x15, 0x92, 0x60, 0x92, 0x44, 0x3b, 0x2a, 0x8a, 0xf3, 0x60,
-	0x9b, 0xd9, 0x75, 0xaa, 0x1e, 0x27, 0x11, 0x67, 0x7e, 0xb8, 0xdd, 0xac, 0xc4, 0x95, 0x69, 0x46,
-	0x04, 0x4b, 0x6a, 0xee, 0xaf, 0xf4, 0xc1, 0x69, 0xbd, 0x91, 0x4a, 0xc0, 0x7c, 0xca, 0x01, 0x50,
-	0x23, 0x40, 0xf7, 0xeb, 0x3e, 0x3b, 0xae, 0x2d, 0xe3, 0x4b, 0xa5, 0x22, 0x48, 0x81, 0x63, 0xac,
-	0xb1, 0x45, 0xcf, 0xc3, 0xc8, 0x36, 0x5d, 0x14, 0x64, 0x85, 0x6a, 0x13, 0x71, 0xb9, 0x8f, 0x35,
-	0x63, 0x32, 0xef, 0x63, 0x5e, 0x4b, 0xf1, 0x52, 0x6b, 0x81, 0x06, 0x8c, 0xb1, 0x41, 0x8a, 0x1e,
-	0x84, 0x46, 0x23, 0xfd, 0x93, 0x08, 0x93, 0xf9, 0x87, 0x2d, 0xf6, 0x31, 0xfb, 0xd5, 0x67, 0x4e,
-	0xde, 0xda, 0x9d, 0x1c, 0x35, 0x40, 0xd8, 0x6c, 0x84, 0xfb, 0x3c, 0xb0, 0xb1, 0xf0, 0x83, 0x0e,
-	0x59, 0x0d, 0xd0, 0x23, 0xd2, 0x84, 0xc7, 0xdd, 0x2e, 0x4a, 0x72, 0xe8, 0x66, 0x3c, 0x7a, 0xd4,
-	0xdd, 0xf4, 0xfc, 0x26, 0x8b, 0x6e, 0xa4, 0x58, 0xea, 0xa8, 0x3b, 0xcf, 0xa0, 0x58, 0x94, 0xba,
-	0x53, 0x30, 0x38, 0x4b, 0xfb, 0x4e, 0x22, 0x4a, 0x57, 0x0f, 0x4a, 0x1e, 0x35, 0x82, 0x92, 0x65,
-	0xf0, 0xf1, 0x3a, 0x9c, 0x99, 0x8d, 0x88, 0x97, 0x90, 0xca, 0xd3, 0x33, 0x9d, 0xea, 0x16, 0x49,
-	0x78, 0xe4, 0x57, 0x8c, 0xde, 0x0f, 0xa3, 0x21, 0xdb, 0x32, 0x96, 0xc3, 0xea, 0x96, 0x1f, 0xd4,
-	0x85, 0x45, 0xf6, 0x8c, 0xa0, 0x32, 0xba, 0xaa, 0x17, 0x62, 0x13, 0xd7, 0xfd, 0xcf, 0x05, 0x18,
-	0x99, 0x8d, 0xc2, 0x40, 0x8a, 0xc5, 0x63, 0xd8, 0xca, 0x12, 0x63, 0x2b, 0xb3, 0xe0, 0x0d, 0xd5,
-	0xdb, 0xdf, 0x6b, 0x3b, 0x43, 0xaf, 0x29, 0x11, 0xd9, 0x67, 0xeb, 0x84, 0x62, 0xf0, 0x65, 0xb4,
-	0xd3, 0x8f, 0x6d, 0x0a, 0x50, 0xf7, 0xbf, 0x38, 0x30, 0xae, 0xa3, 0x1f, 0xc3, 0x0e, 0x1a, 0x9b,
-	0x3b, 0xe8, 0x15, 0xbb, 0xfd, 0xed, 0xb1, 0x6d, 0xbe, 0x35, 0x68, 0xf6, 0x93, 0xb9, 0xc2, 0xbf,
-	0xe6, 0xc0, 0xc8, 0x0d, 0x0d, 0x20, 0x3a, 0x6b, 0x5b, 0x89, 0x79, 0x87, 0x14, 0x33, 0x3a, 0xf4,
-	0x76, 0xe6, 0x37, 0x36, 0x5a, 0x42, 0xe5, 0x7e, 0x5c, 0x6d, 0x90, 0x5a, 0xa7, 0x29, 0xb7, 0x6f,
-	0x35, 0xa4, 0x15, 0x01, 0xc7, 0x0a, 0x03, 0xbd, 0x08, 0x27, 0xab, 0x61, 0x50, 0xed, 0x44, 0x11,
-	0x09, 0xaa, 0x3b, 0x6b, 0xec, 0x0a, 0x85, 0xd8, 0x10, 0xa7, 0x44, 0xb5, 0x93, 0xb3, 0x59, 0x84,
-	0xdb, 0x79, 0x40, 0xdc, 0x4d, 0x88, 0xfb, 0x12, 0x62, 0xba, 0x65, 0x89, 0xf3, 0x98, 0xe6, 0x4b,
-	0x60, 0x60, 0x2c, 0xcb, 0xd1, 0x55, 0x38, 0x17, 0x27, 0x5e, 0x94, 0xf8, 0x41, 0x7d, 0x8e, 0x78,
-	0xb5, 0xa6, 0x1f, 0xd0, 0xa3, 0x44, 0x18, 0xd4, 0xb8, 0xa7, 0xb1, 0x6f, 0xe6, 0x81, 0x5b, 0xbb,
-	0x93, 0xe7, 0x2a, 0xf9, 0x28, 0xb8, 0x57, 0x5d, 0xf4, 0x11, 0x98, 0x10, 0xde, 0x8a, 0xcd, 0x4e,
-	0xf3, 0xd9, 0x70, 0x23, 0xbe, 0xec, 0xc7, 0xf4, 0x98, 0xbf, 0xec, 0xb7, 0xfc, 0x84, 0xf9, 0x13,
-	0x8b, 0x33, 0xe7, 0x6f, 0xed, 0x4e, 0x4e, 0x54, 0x7a, 0x62, 0xe1, 0x3d, 0x28, 0x20, 0x0c, 0x67,
-	0xb9, 0xf0, 0xeb, 0xa2, 0x3d, 0xc8, 0x68, 0x4f, 0xdc, 0xda, 0x9d, 0x3c, 0x3b, 0x9f, 0x8b, 0x81,
-	0x7b, 0xd4, 0xa4, 0x5f, 0x30, 0xf1, 0x5b, 0xe4, 0x95, 0x30, 0x20, 0x2c, 0x8e, 0x45, 0xfb, 0x82,
-	0xeb, 0x02, 0x8e, 0x15, 0x06, 0x7a, 0x29, 0x9d, 0x89, 0x74, 0xb9, 0x88, 0x78, 0x94, 0x83, 0x4b,
-	0x38, 0x76, 0x34, 0xb9, 0xae, 0x51, 0x62, 0x81, 0x96, 0x06, 0x6d, 0xf4, 0x69, 0x07, 0x46, 0xe2,
-	0x24, 0x54, 0xd7, 0x1e, 0x44, 0x40, 0x8a, 0x85, 0x69, 0x5f, 0xd1, 0xa8, 0x72, 0xc5, 0x47, 0x87,
-	0x60, 0x83, 0x2b, 0x7a, 0x27, 0x0c, 0xcb, 0x09, 0x1c, 0x97, 0x4b, 0x4c, 0x57, 0x62, 0xc7, 0x38,
-	0x39, 0xbf, 0x63, 0x9c, 0x96, 0x53, 0x55, 0xf6, 0x46, 0x83, 0x04, 0x2c, 0x24, 0x57, 0x53, 0x65,
-	0xaf, 0x37, 0x48, 0x80, 0x59, 0x89, 0xfb, 0x93, 0x3e, 0x40, 0xdd, 0x82, 0x0f, 0x2d, 0xc1, 0x80,
-	0x57, 0x4d, 0xfc, 0x6d, 0x19, 0x8e, 0xf8, 0x48, 0x9e, 0x52, 0xc0, 0x07, 0x10, 0x93, 0x4d, 0x42,
-	0xe7, 0x3d, 0x49, 0xa5, 0xe5, 0x34, 0xab, 0x8a, 0x05, 0x09, 0x14, 0xc2, 0xc9, 0xa6, 0x17, 0x27,
-	0xb2, 0x85, 0x35, 0xfa, 0x21, 0xc5, 0x76, 0xf1, 0xf3, 0xfb, 0xfb, 0x54, 0xb4, 0xc6, 0xcc, 0x19,
-	0xba, 0x1e, 0x97, 0xb3, 0x84, 0x70, 0x37, 0x6d, 0xf4, 0x09, 0xa6, 0x5d, 0x71, 0xd5, 0x57, 0xaa,
-	0x35, 0x4b, 0x56, 0x34, 0x0f, 0x4e, 0xd3, 0xd0, 0xac, 0x04, 0x1b, 0xac, 0xb1, 0x44, 0x17, 0x60,
-	0x98, 0xad, 0x1b, 0x52, 0x23, 0x7c, 0xf5, 0xf7, 0xa5, 0x4a, 0x70, 0x45, 0x16, 0xe0, 0x14, 0x47,
-	0xd3, 0x32, 0xf8, 0x82, 0xef, 0xa1, 0x65, 0xa0, 0x67, 0xa0, 0xd8, 0x6e, 0x78, 0xb1, 0x0c, 0x71,
-	0x77, 0xa5, 0xd4, 0x5e, 0xa3, 0x40, 0x26, 0x9a, 0xb4, 0x6f, 0xc9, 0x80, 0x98, 0x57, 0x70, 0xff,
-	0x2d, 0xc0, 0xe0, 0xdc, 0xf4, 0xc2, 0xba, 0x17, 0x6f, 0xed, 0xe3, 0x0c, 0x44, 0x97, 0xa1, 0x50,
-	0x56, 0xb3, 0x82, 0x54, 0x2a, 0xb1, 0x58, 0x61, 0xa0, 0x00, 0x06, 0xfc, 0x80, 0x4a, 0x9e, 0xf2,
-	0x98, 0x2d, 0x37, 0x84, 0x3a, 0xcf, 0x31, 0x3b, 0xd1, 0x22, 0xa3, 0x8e, 0x05, 0x17, 0xf4, 0x1a,
-	0x0c, 0x7b, 0xf2, 0x86, 0x91, 0xd8, 0xff, 0x97, 0x6c, 0xd8, 0xd7, 0x05, 0x49, 0x3d, 0xc2, 0x49,
-	0x80, 0x70, 0xca, 0x10, 0x7d, 0xd2, 0x81, 0x92, 0xec, 0x3a, 0x26, 0x9b, 0xc2, 0xf5, 0xbd, 0x62,
-	0xaf, 0xcf, 0x98, 0x6c, 0xf2, 0xf0, 0x17, 0x0d, 0x80, 0x75, 0x96, 0x5d, 0x67, 0xa6, 0xe2, 0x7e,
-	0xce, 0x4c, 0xe8, 0x06, 0x0c, 0xdf, 0xf0, 0x93, 0x06, 0xdb, 0xe1, 0x85, 0xcb, 0x6d, 0xfe, 0xee,
-	0x5b, 0x4d, 0xc9, 0xa5, 0x23, 0x76, 0x5d, 0x32, 0xc0, 0x29, 0x2f, 0xba, 0x1c, 0xe8, 0x0f, 0x76,
-	0x43, 0x8b, 0xed, 0x0d, 0xc3, 0x66, 0x05, 0x56, 0x80, 0x53, 0x1c, 0x3a, 0xc4, 0x23, 0xf4, 0x57,
-	0x85, 0xbc, 0xdc, 0xa1, 0xa2, 0x45, 0x84, 0x34, 0x5a, 0x98, 0x57, 0x92, 0x22, 0x1f, 0xac, 0xeb,
-	0x1a, 0x0f, 0x6c, 0x70, 0x54, 0xa2, 0x73, 0xb8, 0x97, 0xe8, 0x44, 0xaf, 0xf1, 0x33, 0x1c, 0x3f,
-	0x4c, 0x88, 0xdd, 0x60, 0xd9, 0xce, 0xf9, 0x86, 0xd3, 0xe4, 0xb7, 0x1e, 0xd2, 0xdf, 0x58, 0xe3,
-	0x47, 0x25, 0x46, 0x18, 0x5c, 0xba, 0xe9, 0x27, 0xe2, 0xae, 0x86, 0x92, 0x18, 0xab, 0x0c, 0x8a,
-	0x45, 0x29, 0x0f, 0xed, 0xa0, 0x93, 0x20, 0x16, 0xbb, 0x80, 0x16, 0xda, 0xc1, 0xc0, 0x58, 0x96,
-	0xa3, 0x7f, 0xe4, 0x40, 0xb1, 0x11, 0x86, 0x5b, 0x71, 0x79, 0x94, 0x4d, 0x0e, 0x0b, 0x3a, 0xb5,
-	0x90, 0x38, 0x53, 0x97, 0x29, 0x59, 0xf3, 0xf6, 0x59, 0x91, 0xc1, 0x6e, 0xef, 0x4e, 0x8e, 0x2d,
-	0xfb, 0x9b, 0xa4, 0xba, 0x53, 0x6d, 0x12, 0x06, 0x79, 0xe3, 0x2d, 0x0d, 0x72, 0x69, 0x9b, 0x04,
-	0x09, 0xe6, 0xad, 0x9a, 0xf8, 0xbc, 0x03, 0x90, 0x12, 0xca, 0xf1, 0xa1, 0x12, 0x33, 0xea, 0xc0,
-	0xc2, 0x81, 0xda, 0x68, 0x9a, 0xee, 0x94, 0xfd, 0xf7, 0x0e, 0x94, 0x68, 0xe7, 0xa4, 0x08, 0x7c,
-	0x0c, 0x06, 0x12, 0x2f, 0xaa, 0x13, 0xe9, 0x47, 0x50, 0x9f, 0x63, 0x9d, 0x41, 0xb1, 0x28, 0x45,
-	0x01, 0x14, 0x13, 0x2f, 0xde, 0x92, 0x6a, 0xfc, 0xa2, 0xb5, 0x21, 0x4e, 0x35, 0x78, 0xfa, 0x2b,
-	0xc6, 0x9c, 0x0d, 0x7a, 0x1c, 0x86, 0xe8, 0xd6, 0x31, 0xef, 0xc5, 0x32, 0xb4, 0x67, 0x84, 0x0a,
-	0xf1, 0x79, 0x01, 0xc3, 0xaa, 0xd4, 0xfd, 0xb5, 0x02, 0xf4, 0xcf, 0xf1, 0x03, 0xdd, 0x40, 0x1c,
-	0x76, 0xa2, 0x2a, 0x11, 0x8a, 0xbd, 0x85, 0x39, 0x4d, 0xe9, 0x56, 0x18, 0x4d, 0xed, 0x48, 0xc5,
-	0x7e, 0x63, 0xc1, 0x0b, 0x7d, 0xd9, 0x81, 0xb1, 0x24, 0xf2, 0x82, 0x78, 0x93, 0x79, 0x6c, 0xfc,
-	0x30, 0x10, 0x43, 0x64, 0x61, 0x16, 0xae, 0x1b, 0x74, 0x2b, 0x09, 0x69, 0xa7, 0x8e, 0x23, 0xb3,
-	0x0c, 0x67, 0xda, 0xe0, 0xfe, 0xba, 0x03, 0x90, 0xb6, 0x1e, 0xbd, 0xe9, 0xc0, 0xa8, 0xa7, 0x87,
-	0x94, 0x8a, 0x31, 0x5a, 0xb5, 0xe7, 0xde, 0x65, 0x64, 0xb9, 0x2d, 0xc3, 0x00, 0x61, 0x93, 0xb1,
-	0xfb, 0x5e, 0x28, 0xb2, 0xd5, 0xc1, 0x0e, 0x3d, 0xc2, 0xf6, 0x9d, 0x35, 0x76, 0x49, 0x9b, 0x38,
-	0x56, 0x18, 0xee, 0x8b, 0x30, 0x76, 0xe9, 0x26, 0xa9, 0x76, 0x92, 0x30, 0xe2, 0x96, 0xff, 0x1e,
-	0x57, 0x88, 0x9c, 0x43, 0x5d, 0x21, 0xfa, 0x9e, 0x03, 0x25, 0x2d, 0xbe, 0x90, 0xee, 0xd4, 0xf5,
-	0xd9, 0x0a, 0x37, 0x70, 0x88, 0xa1, 0x5a, 0xb2, 0x12, 0xc1, 0xc8, 0x49, 0xa6, 0xdb, 0x88, 0x02,
-	0xe1, 0x94, 0xe1, 0x1d, 0xe2, 0xff, 0xdc, 0xdf, 0x77, 0xe0, 0x4c, 0x6e, 0x30, 0xe4, 0x3d, 0x6e,
-	0xb6, 0xe1, 0x83, 0x2f, 0xec, 0xc3, 0x07, 0xff, 0xdb, 0x0e, 0xa4, 0x94, 0xa8, 0x28, 0xda, 0x48,
-	0x5b, 0xae, 0x89, 0x22, 0xc1, 0x49, 0x94, 0xa2, 0xd7, 0xe0, 0x9c, 0xf9, 0x05, 0x0f, 0xe9, 0x6f,
-	0xe1, 0x87, 0xd3, 0x7c, 0x4a, 0xb8, 0x17, 0x0b, 0xf7, 0xeb, 0x0e, 0x14, 0x17, 0xbc, 0x4e, 0x9d,
-	0xec, 0xcb, 0x5c, 0x46, 0xe5, 0x58, 0x44, 0xbc, 0x66, 0x22, 0x8f, 0x0e, 0x42, 0x8e, 0x61, 0x01,
-	0xc3, 0xaa, 0x14, 0x4d, 0xc3, 0x70, 0xd8, 0x26, 0x86, 0x0b, 0xf1, 0x11, 0x39, 0x7a, 0xab, 0xb2,
-	0x80, 0x6e, 0x3b, 0x8c, 0xbb, 0x82, 0xe0, 0xb4, 0x96, 0xfb, 0x8d, 0x01, 0x28, 0x69, 0xd7, 0x66,
-	0xa8, 0x2e, 0x10, 0x91, 0x76, 0x98, 0xd5, 0x97, 0xe9, 0x84, 0xc1, 0xac, 0x84, 0xae, 0xc1, 0x88,
-	0x6c, 0xfb, 0x31, 0x17, 0x5b, 0xc6, 0x1a, 0xc4, 0x02, 0x8e, 0x15, 0x06, 0x9a, 0x84, 0x62, 0x8d,
-	0xb4, 0x93, 0x06, 0x6b, 0x5e, 0x3f, 0x8f, 0x1d, 0x9c, 0xa3, 0x00, 0xcc, 0xe1, 0x14, 0x61, 0x93,
-	0x24, 0xd5, 0x06, 0xb3, 0x0c, 0x8b, 0xe0, 0xc2, 0x79, 0x0a, 0xc0, 0x1c, 0x9e, 0xe3, 0xc5, 0x2c,
-	0x1e, 0xbd, 0x17, 0x73, 0xc0, 0xb2, 0x17, 0x13, 0xb5, 0xe1, 0x54, 0x1c, 0x37, 0xd6, 0x22, 0x7f,
-	0xdb, 0x4b, 0x48, 0x3a, 0xfb, 0x06, 0x0f, 0xc2, 0xe7, 0x1c, 0xbb, 0xc8, 0x5e, 0xb9, 0x9c, 0xa5,
-	0x82, 0xf3, 0x48, 0xa3, 0x0a, 0x9c, 0xf1, 0x83, 0x98, 0x54, 0x3b, 0x11, 0x59, 0xac, 0x07, 0x61,
-	0x44, 0x2e, 0x87, 0x31, 0x25, 0x27, 0xae, 0xe1, 0xaa, 0x70, 0xdb, 0xc5, 0x3c, 0x24, 0x9c, 0x5f,
-	0x17, 0x2d, 0xc0, 0xc9, 0x9a, 0x1f, 0x7b, 0x1b, 0x4d, 0x52, 0xe9, 0x6c, 0xb4, 0x42, 0x7e, 0x34,
-	0x1f, 0x66, 0x04, 0xef, 0x97, 0x76, 0xa4, 0xb9, 0x2c, 0x02, 0xee, 0xae, 0x83, 0x9e, 0x81, 0x91,
-	0xd8, 0x0f, 0xea, 0x4d, 0x32, 0x13, 0x79, 0x41, 0xb5, 0x21, 0xee, 0xef, 0x2a, 0x7b, 0x7b, 0x45,
-	0x2b, 0xc3, 0x06, 0x26, 0x5b, 0xf3, 0xbc, 0x4e, 0x46, 0x1b, 0x14, 0xd8, 0xa2, 0x14, 0x4d, 0xc3,
-	0x09, 0xd9, 0x87, 0xca, 0x96, 0xdf, 0x5e, 0x5f, 0xae, 0x30, 0xad, 0x70, 0x28, 0x0d, 0x26, 0x5a,
-	0x34, 0x8b, 0x71, 0x16, 0xdf, 0xfd, 0x91, 0x03, 0x23, 0x7a, 0xb4, 0x3c, 0x55, 0xd6, 0xa1, 0x31,
-	0x37, 0x5f, 0xe1, 0xdb, 0x89, 0x3d, 0xa5, 0xe1, 0xb2, 0xa2, 0x99, 0x9e, 0xb7, 0x53, 0x18, 0xd6,
-	0x78, 0xee, 0xe3, 0xee, 0xfb, 0x23, 0x50, 0xdc, 0x0c, 0xa9, 0x4e, 0xd3, 0x67, 0xda, 0xfa, 0xe7,
-	0x29, 0x10, 0xf3, 0x32, 0xf7, 0x7f, 0x38, 0x70, 0x36, 0xff, 0x22, 0xc0, 0xdb, 0xa1, 0x93, 0x17,
-	0x01, 0x68, 0x57, 0x8c, 0x7d, 0x41, 0xcb, 0x7e, 0x21, 0x4b, 0xb0, 0x86, 0xb5, 0xbf, 0x6e, 0xff,
-	0xbb, 0x02, 0x68, 0x3c, 0xd1, 0x17, 0x1c, 0x18, 0xa5, 0x6c, 0x97, 0xa2, 0x0d, 0xa3, 0xb7, 0xab,
-	0x76, 0x7a, 0xab, 0xc8, 0xa6, 0x2e, 0x0d, 0x03, 0x8c, 0x4d, 0xe6, 0xe8, 0x9d, 0x30, 0xec, 0xd5,
-	0x6a, 0x11, 0x89, 0x63, 0xe5, 0x1c, 0x64, 0x06, 0xaf, 0x69, 0x09, 0xc4, 0x69, 0x39, 0x95, 0xc3,
-	0x8d, 0xda, 0x66, 0x4c, 0x45, 0x9b, 0x90, 0xfd, 0x4a, 0x0e, 0x53, 0x26, 0x14, 0x8e, 0x15, 0x06,
-	0xba, 0x06, 0x67, 0x6b, 0x5e, 0xe2, 0x71, 0x15, 0x90, 0x44, 0x6b, 0x51, 0x98, 0x90, 0x2a, 0xdb,
-	0x37, 0x78, 0x2c, 0xc9, 0x79, 0x51, 0xf7, 0xec, 0x5c, 0x2e, 0x16, 0xee, 0x51, 0xdb, 0xfd, 0xe5,
-	0x7e, 0x30, 0xfb, 0x84, 0x6a, 0x70, 0x62, 0x2b, 0xda, 0x98, 0x65, 0x31, 0x1b, 0x87, 0x89, 0x9d,
-	0x60, 0x31, 0x0d, 0x4b, 0x26, 0x05, 0x9c, 0x25, 0x29, 0xb8, 0x2c, 0x91, 0x9d, 0xc4, 0xdb, 0x38,
-	0x74, 0xe4, 0xc4, 0x92, 0x49, 0x01, 0x67, 0x49, 0xa2, 0xf7, 0x42, 0x69, 0x2b, 0xda, 0x90, 0xbb,
-	0x47, 0x36, 0x4a, 0x67, 0x29, 0x2d, 0xc2, 0x3a, 0x1e, 0xfd, 0x34, 0x5b, 0xd1, 0x06, 0xdd, 0xb0,
-	0x65, 0x8e, 0x09, 0xf5, 0x69, 0x96, 0x04, 0x1c, 0x2b, 0x0c, 0xd4, 0x06, 0xb4, 0x25, 0x47, 0x4f,
-	0x45, 0xa8, 0x88, 0x4d, 0x6e, 0xff, 0x01, 0x2e, 0xec, 0xe6, 0xc0, 0x52, 0x17, 0x1d, 0x9c, 0x43,
-	0x1b, 0x3d, 0x0f, 0xe7, 0xb6, 0xa2, 0x0d, 0xa1, 0xc7, 0xac, 0x45, 0x7e, 0x50, 0xf5, 0xdb, 0x46,
-	0x3e, 0x89, 0x49, 0xd1, 0xdc, 0x73, 0x4b, 0xf9, 0x68, 0xb8, 0x57, 0x7d, 0xf7, 0x77, 0xfa, 0x81,
-	0xdd, 0x84, 0xa5, 0x62, 0xba, 0x45, 0x92, 0x46, 0x58, 0xcb, 0xaa, 0x66, 0x2b, 0x0c, 0x8a, 0x45,
-	0xa9, 0x8c, 0x8f, 0x2d, 0xf4, 0x88, 0x8f, 0xbd, 0x01, 0x83, 0x0d, 0xe2, 0xd5, 0x48, 0x24, 0x8d,
-	0x9b, 0xcb, 0x76, 0xee, 0xee, 0x5e, 0x66, 0x44, 0x53, 0x0b, 0x01, 0xff, 0x1d, 0x63, 0xc9, 0x0d,
-	0xbd, 0x0f, 0xc6, 0xa8, 0x8e, 0x15, 0x76, 0x12, 0xe9, 0x9f, 0xe0, 0xc6, 0x4d, 0xb6, 0xd9, 0xaf,
-	0x1b, 0x25, 0x38, 0x83, 0x89, 0xe6, 0x60, 0x5c, 0xf8, 0x12, 0x94, 0xd1, 0x54, 0x0c, 0xac, 0x4a,
-	0xf4, 0x51, 0xc9, 0x94, 0xe3, 0xae, 0x1a, 0x2c, 0xbe, 0x31, 0xac, 0x71, 0x77, 0xb2, 0x1e, 0xdf,
-	0x18, 0xd6, 0x76, 0x30, 0x2b, 0x41, 0xaf, 0xc0, 0x10, 0xfd, 0x3b, 0x1f, 0x85, 0x2d, 0x61, 0x36,
-	0x5a, 0xb3, 0x33, 0x3a, 0x94, 0x87, 0x38, 0xc4, 0x32, 0xdd, 0x73, 0x46, 0x70, 0xc1, 0x8a, 0x1f,
-	0x3d, 0x4a, 0xe9, 0xdb, 0xe5, 0x35, 0x12, 0xf9, 0x9b, 0x3b, 0x4c, 0x9f, 0x19, 0x4a, 0x8f, 0x52,
-	0x8b, 0x5d, 0x18, 0x38, 0xa7, 0x96, 0xfb, 0x85, 0x02, 0x8c, 0xe8, 0x17, 0xaa, 0xef, 0x14, 0x34,
-	0x1d, 0xa7, 0x93, 0x82, 0x1f, 0x9c, 0x2f, 0x5b, 0xe8, 0xf6, 0x9d, 0x26, 0x44, 0x03, 0xfa, 0xbd,
-	0x8e, 0x50, 0x64, 0xad, 0xd8, 0xe7, 0x58, 0x8f, 0x3b, 0x49, 0x83, 0xdf, 0xbc, 0x63, 0xe1, 0xcc,
-	0x8c, 0x83, 0xfb, 0x99, 0x3e, 0x18, 0x92, 0x85, 0xe8, 0xd3, 0x0e, 0x40, 0x1a, 0x37, 0x26, 0x44,
-	0xe9, 0x9a, 0x8d, 0xa0, 0x22, 0x3d, 0xe4, 0x4d, 0x33, 0xf3, 0x2b, 0x38, 0xd6, 0xf8, 0xa2, 0x04,
-	0x06, 0x42, 0xda, 0xb8, 0x8b, 0xf6, 0x92, 0x02, 0xac, 0x52, 0xc6, 0x17, 0x19, 0xf7, 0xd4, 0xa2,
-	0xc7, 0x60, 0x58, 0xf0, 0xa2, 0x87, 0xd3, 0x0d, 0x19, 0xce, 0x68, 0xcf, 0xfa, 0xad, 0x22, 0x24,
-	0xd3, 0xb3, 0xa6, 0x02, 0xe1, 0x94, 0xa1, 0xfb, 0x14, 0x8c, 0x99, 0x8b, 0x81, 0x1e, 0x56, 0x36,
-	0x76, 0x12, 0xc2, 0x4d, 0x21, 0x23, 0xfc, 0xb0, 0x32, 0x43, 0x01, 0x98, 0xc3, 0xdd, 0x1f, 0x3a,
-	0x00, 0xa9, 0x78, 0xd9, 0x87, 0xf7, 0xe1, 0x11, 0xdd, 0x8e, 0xd7, 0xeb, 0x44, 0xf8, 0x09, 0x18,
-	0x66, 0xff, 0xb0, 0x85, 0xde, 0x67, 0x2b, 0xf8, 0x20, 0x6d, 0xa7, 0x58, 0xea, 0x4c, 0xd7, 0xb8,
-	0x26, 0x19, 0xe1, 0x94, 0xa7, 0x1b, 0xc2, 0x78, 0x16, 0x1b, 0x7d, 0x18, 0x46, 0x62, 0xb9, 0xad,
-	0xa6, 0xd7, 0x03, 0xf7, 0xb9, 0xfd, 0x72, 0xd7, 0x9f, 0x56, 0x1d, 0x1b, 0xc4, 0xdc, 0x55, 0x18,
-	0xb0, 0x3a, 0x84, 0xee, 0x77, 0x1c, 0x18, 0x66, 0xde, 0xd7, 0x7a, 0xe4, 0xb5, 0xd2, 0x2a, 0x7d,
-	0x7b, 0x8c, 0x7a, 0x0c, 0x83, 0xdc, 0x7c, 0x20, 0xa3, 0x96, 0x2c, 0x48, 0x19, 0x9e, 0xcb, 0x2f,
-	0x95, 0x32, 0xdc, 0x4e, 0x11, 0x63, 0xc9, 0xc9, 0xfd, 0x6c, 0x01, 0x06, 0x16, 0x83, 0x76, 0xe7,
-	0xaf, 0x7d, 0x3e, 0xb9, 0x15, 0xe8, 0x5f, 0x4c, 0x48, 0xcb, 0x4c, 0x7b, 0x38, 0x32, 0xf3, 0xa8,
-	0x9e, 0xf2, 0xb0, 0x6c, 0xa6, 0x3c, 0xc4, 0xde, 0x0d, 0x19, 0xd4, 0x27, 0xcc, 0xd7, 0xe9, 0x15,
-	0xc9, 0x27, 0x61, 0x78, 0xd9, 0xdb, 0x20, 0xcd, 0x25, 0xb2, 0xc3, 0x2e, 0x34, 0xf2, 0x00, 0x13,
-	0x27, 0xb5, 0x39, 0x18, 0xc1, 0x20, 0x73, 0x30, 0xc6, 0xb0, 0xd5, 0x62, 0xa0, 0x27, 0x12, 0x92,
-	0xe6, 0x8c, 0x72, 0xcc, 0x13, 0x89, 0x96, 0x2f, 0x4a, 0xc3, 0x72, 0xa7, 0xa0, 0x94, 0x52, 0xd9,
-	0x07, 0xd7, 0x9f, 0x15, 0x60, 0xd4, 0xb0, 0xc2, 0x1b, 0xbe, 0x49, 0xe7, 0x8e, 0xbe, 0x49, 0xc3,
-	0x57, 0x58, 0xb8, 0xd7, 0xbe, 0xc2, 0xbe, 0xe3, 0xf7, 0x15, 0x9a, 0x1f, 0xa9, 0x7f, 0x5f, 0x1f,
-	0xa9, 0x09, 0xfd, 0xcb, 0x7e, 0xb0, 0xb5, 0x3f, 0x39, 0x13, 0x57, 0xc3, 0x76, 0x97, 0x9c, 0xa9,
-	0x50, 0x20, 0xe6, 0x65, 0x52, 0x73, 0xe9, 0xcb, 0xd7, 0x5c, 0xdc, 0x4f, 0x3b, 0x30, 0xb2, 0xe2,
-	0x05, 0xfe, 0x26, 0x89, 0x13, 0x36, 0xaf, 0x92, 0x23, 0xbd, 0xd8, 0x36, 0xd2, 0x23, 0x45, 0xc3,
-	0x1b, 0x0e, 0x9c, 0x5c, 0x21, 0xad, 0xd0, 0x7f, 0xc5, 0x4b, 0x63, 0x66, 0x69, 0xdb, 0x1b, 0x7e,
-	0x22, 0x42, 0x04, 0x55, 0xdb, 0x2f, 0xfb, 0x09, 0xa6, 0xf0, 0x3b, 0x98, 0x98, 0xd9, 0x95, 0x11,
-	0x7a, 0x40, 0xd3, 0x2e, 0x5b, 0xa6, 0xd1, 0xb0, 0xb2, 0x00, 0xa7, 0x38, 0xee, 0xef, 0x3a, 0x30,
-	0xc8, 0x1b, 0xa1, 0xc2, 0x8c, 0x9d, 0x1e, 0xb4, 0x1b, 0x50, 0x64, 0xf5, 0xc4, 0xac, 0x5e, 0xb0,
-	0xa0, 0xfe, 0x50, 0x72, 0x7c, 0x0d, 0xb2, 0x7f, 0x31, 0x67, 0xc0, 0x8e, 0x2d, 0xde, 0xcd, 0x69,
-	0x15, 0x2e, 0x9c, 0x1e, 0x5b, 0x18, 0x14, 0x8b, 0x52, 0xf7, 0x1b, 0x7d, 0x30, 0xa4, 0x32, 0x93,
-	0xb1, 0xbc, 0x11, 0x41, 0x10, 0x26, 0x1e, 0x0f, 0xc3, 0xe0, 0xb2, 0xfa, 0xc3, 0xf6, 0x32, 0xa3,
-	0x4d, 0x4d, 0xa7, 0xd4, 0xb9, 0x6b, 0x51, 0x1d, 0x42, 0xb5, 0x12, 0xac, 0x37, 0x02, 0x7d, 0x1c,
-	0x06, 0x9a, 0x54, 0xfa, 0x48, 0xd1, 0x7d, 0xcd, 0x62, 0x73, 0x98, 0x58, 0x13, 0x2d, 0x51, 0x23,
-	0xc4, 0x81, 0x58, 0x70, 0x9d, 0xf8, 0x00, 0x8c, 0x67, 0x5b, 0x7d, 0xa7, 0xbb, 0xa0, 0xc3, 0xfa,
-	0x4d, 0xd2, 0xbf, 0x2d, 0xa4, 0xe7, 0xc1, 0xab, 0xba, 0xcf, 0x41, 0x69, 0x85, 0x24, 0x91, 0x5f,
-	0x65, 0x04, 0xee, 0x34, 0xb9, 0xf6, 0xa5, 0x3f, 0x7c, 0x8e, 0x4d, 0x56, 0x4a, 0x33, 0x46, 0xaf,
-	0x01, 0xb4, 0xa3, 0x90, 0x9e, 0x5f, 0x49, 0x47, 0x7e, 0x6c, 0x0b, 0xfa, 0xf0, 0x9a, 0xa2, 0xc9,
-	0xbd, 0xe1, 0xe9, 0x6f, 0xac, 0xf1, 0x73, 0xdf, 0x74, 0xa0, 0xb8, 0xd2, 0x49, 0xc8, 0xcd, 0x7d,
-	0x88, 0xac, 0x03, 0x67, 0x47, 0x78, 0x12, 0x86, 0xe8, 0x07, 0xde, 0xf0, 0x62, 0x69, 0x47, 0x4b,
-	0xa3, 0xc9, 0x05, 0x1c, 0x2b, 0x0c, 0xf7, 0xc3, 0x30, 0xc2, 0x5a, 0x72, 0x39, 0x6c, 0xd2, 0x5d,
-	0x98, 0x8e, 0x64, 0x8b, 0xfe, 0xce, 0xba, 0x37, 0x18, 0x12, 0xe6, 0x65, 0x74, 0x85, 0x35, 0xc2,
-	0x66, 0x4d, 0xdd, 0x2b, 0x53, 0xf3, 0xe7, 0x32, 0x83, 0x62, 0x51, 0xea, 0x7e, 0xaa, 0x00, 0x25,
-	0x56, 0x51, 0x48, 0xa7, 0x1d, 0x18, 0x6c, 0x70, 0x3e, 0x62, 0xc8, 0x2d, 0x84, 0xa3, 0xe9, 0xad,
-	0xd7, 0x8e, 0x7e, 0x1c, 0x80, 0x25, 0x3f, 0xca, 0xfa, 0x86, 0xe7, 0x27, 0x94, 0x75, 0xe1, 0x68,
-	0x59, 0x5f, 0xe7, 0x6c, 0xb0, 0xe4, 0xe7, 0xfe, 0x22, 0xb0, 0xfb, 0xda, 0xf3, 0x4d, 0xaf, 0xce,
-	0x47, 0x2e, 0xdc, 0x22, 0x35, 0x21, 0xa2, 0xb5, 0x91, 0xa3, 0x50, 0x2c, 0x4a, 0xf9, 0x1d, 0xd8,
-	0x24, 0xf2, 0x55, 0x20, 0xb7, 0x76, 0x07, 0x96, 0x81, 0x65, 0xd8, 0x7e, 0xcd, 0xfd, 0x4a, 0x01,
-	0x80, 0xa5, 0xbd, 0xe3, 0xd7, 0xac, 0xdf, 0x2d, 0x63, 0xae, 0x4c, 0x97, 0xa8, 0x8a, 0xb9, 0x62,
-	0x17, 0xc9, 0xf5, 0x58, 0x2b, 0xfd, 0x7e, 0x45, 0x61, 0xef, 0xfb, 0x15, 0xa8, 0x0d, 0x83, 0x61,
-	0x27, 0xa1, 0xaa, 0xad, 0xd0, 0x0d, 0x2c, 0x44, 0x04, 0xac, 0x72, 0x82, 0xfc, 0x52, 0x82, 0xf8,
-	0x81, 0x25, 0x1b, 0xf4, 0x0c, 0x0c, 0xb5, 0xa3, 0xb0, 0x4e, 0xb7, 0x7a, 0xa1, 0x0d, 0x3c, 0x28,
-	0x67, 0xf3, 0x9a, 0x80, 0xdf, 0xd6, 0xfe, 0xc7, 0x0a, 0xdb, 0xfd, 0xe3, 0x71, 0x3e, 0x2e, 0x62,
-	0xee, 0x4d, 0x40, 0xc1, 0x97, 0x86, 0x2c, 0x10, 0x24, 0x0a, 0x8b, 0x73, 0xb8, 0xe0, 0xd7, 0xd4,
-	0x2a, 0x2c, 0xf4, 0x5c, 0x85, 0xef, 0x85, 0x52, 0xcd, 0x8f, 0xdb, 0x4d, 0x6f, 0xe7, 0x4a, 0x8e,
-	0x15, 0x71, 0x2e, 0x2d, 0xc2, 0x3a, 0x1e, 0x7a, 0x52, 0xdc, 0xa6, 0xe9, 0x37, 0x2c, 0x47, 0xf2,
-	0x36, 0x4d, 0x7a, 0x8d, 0x9f, 0x5f, 0xa4, 0xc9, 0xa6, 0x3b, 0x28, 0xee, 0x3b, 0xdd, 0x41, 0x56,
-	0x71, 0x1b, 0x38, 0x7e, 0xc5, 0xed, 0xfd, 0x30, 0x2a, 0x7f, 0x32, 0x6d, 0xaa, 0x7c, 0x9a, 0xb5,
-	0x5e, 0x59, 0xcd, 0xd7, 0xf5, 0x42, 0x6c, 0xe2, 0xa6, 0x93, 0x76, 0x70, 0xbf, 0x93, 0xf6, 0x22,
-	0xc0, 0x46, 0xd8, 0x09, 0x6a, 0x5e, 0xb4, 0xb3, 0x38, 0x27, 0x62, 0x6f, 0x95, 0x9e, 0x38, 0xa3,
-	0x4a, 0xb0, 0x86, 0xa5, 0x4f, 0xf4, 0xe1, 0x3b, 0x4c, 0xf4, 0x0f, 0xc3, 0x30, 0x8b, 0x53, 0x26,
-	0xb5, 0xe9, 0x44, 0x04, 0x4b, 0x1d, 0x24, 0xf8, 0x33, 0x0d, 0x9f, 0x94, 0x44, 0x70, 0x4a, 0x0f,
-	0x7d, 0x04, 0x60, 0xd3, 0x0f, 0xfc, 0xb8, 0xc1, 0xa8, 0x97, 0x0e, 0x4c, 0x5d, 0xf5, 0x73, 0x5e,
-	0x51, 0xc1, 0x1a, 0x45, 0xf4, 0x22, 0x9c, 0x24, 0x71, 0xe2, 0xb7, 0xbc, 0x84, 0xd4, 0xd4, 0xf5,
-	0xd4, 0x32, 0x33, 0x7d, 0xaa, 0x48, 0xf1, 0x4b, 0x59, 0x84, 0xdb, 0x79, 0x40, 0xdc, 0x4d, 0xc8,
-	0x58, 0x91, 0x13, 0x07, 0x59, 0x91, 0xe8, 0x7f, 0x3b, 0x70, 0x32, 0x22, 0x3c, 0x82, 0x26, 0x56,
-	0x0d, 0x3b, 0xc3, 0xc4, 0x71, 0xd5, 0x46, 0x46, 0x79, 0x95, 0x3a, 0x06, 0x67, 0xb9, 0x70, 0x3d,
-	0x87, 0xc8, 0xde, 0x77, 0x95, 0xdf, 0xce, 0x03, 0xbe, 0xf1, 0xd6, 0xe4, 0x64, 0xf7, 0xcb, 0x06,
-	0x8a, 0x38, 0x5d, 0x79, 0x7f, 0xff, 0xad, 0xc9, 0x71, 0xf9, 0x3b, 0x1d, 0xb4, 0xae, 0x4e, 0xd2,
-	0x6d, 0xb5, 0x1d, 0xd6, 0x16, 0xd7, 0x44, 0x54, 0x9b, 0xda, 0x56, 0xd7, 0x28, 0x10, 0xf3, 0x32,
-	0xf4, 0x38, 0xdd, 0xb9, 0x49, 0x2b, 0x0c, 0x54, 0x6e, 0xe0, 0x11, 0xbe, 0x6b, 0x73, 0x18, 0x56,
-	0xa5, 0xf4, 0xc8, 0x11, 0x88, 0x2d, 0xa5, 0xfc, 0x80, 0xad, 0x23, 0x87, 0xdc, 0xa4, 0x38, 0x57,
-	0xf9, 0x0b, 0x2b, 0x4e, 0xa8, 0x09, 0x03, 0x3e, 0xb3, 0x6b, 0x88, 0xc0, 0x59, 0x0b, 0xc6, 0x14,
-	0x6e, 0x27, 0x91, 0x61, 0xb3, 0x4c, 0xf4, 0x0b, 0x1e, 0xfa, 0x5e, 0x73, 0xe2, 0x78, 0xf6, 0x9a,
-	0xc7, 0x61, 0xa8, 0xda, 0xf0, 0x9b, 0xb5, 0x88, 0x04, 0xe5, 0x71, 0x76, 0xc0, 0x67, 0x23, 0x31,
-	0x2b, 0x60, 0x58, 0x95, 0xa2, 0xbf, 0x05, 0xa3, 0x61, 0x27, 0x61, 0xa2, 0x85, 0x8e, 0x53, 0x5c,
-	0x3e, 0xc9, 0xd0, 0x59, 0x18, 0xd4, 0xaa, 0x5e, 0x80, 0x4d, 0x3c, 0x2a, 0xe2, 0x1b, 0x61, 0xcc,
-	0xb2, 0x1c, 0x31, 0x11, 0x7f, 0xd6, 0x14, 0xf1, 0x97, 0xb5, 0x32, 0x6c, 0x60, 0xa2, 0xaf, 0x39,
-	0x70, 0xb2, 0x95, 0x3d, 0xef, 0x95, 0xcf, 0xb1, 0x91, 0xa9, 0xd8, 0x38, 0x17, 0x64, 0x48, 0xf3,
-	0x00, 0xf6, 0x2e, 0x30, 0xee, 0x6e, 0x04, 0xcb, 0x37, 0x16, 0xef, 0x04, 0xd5, 0x46, 0x14, 0x06,
-	0x66, 0xf3, 0xee, 0xb7, 0x75, 0x8d, 0x8e, 0xad, 0xed, 0x3c, 0x16, 0x33, 0xf7, 0xdf, 0xda, 0x9d,
-	0x3c, 0x93, 0x5b, 0x84, 0xf3, 0x1b, 0x35, 0x31, 0x07, 0x67, 0xf3, 0xe5, 0xc3, 0x9d, 0x0e, 0x28,
-	0x7d, 0xfa, 0x01, 0x65, 0x1e, 0xee, 0xef, 0xd9, 0x28, 0xba, 0xd3, 0x48, 0x6d, 0xd3, 0x31, 0x77,
-	0x9a, 0x2e, 0xed, 0x70, 0x0c, 0x46, 0xf4, 0xa7, 0x30, 0xdc, 0xff, 0xd7, 0x07, 0x90, 0x9a, 0xd5,
-	0x91, 0x07, 0x63, 0xdc, 0x84, 0xbf, 0x38, 0x77, 0xe8, 0x04, 0x00, 0xb3, 0x06, 0x01, 0x9c, 0x21,
-	0x88, 0x5a, 0x80, 0x38, 0x84, 0xff, 0x3e, 0x8c, 0x2b, 0x96, 0x79, 0x2e, 0x67, 0xbb, 0x88, 0xe0,
-	0x1c, 0xc2, 0xb4, 0x47, 0x49, 0xb8, 0x45, 0x82, 0xab, 0x78, 0xf9, 0x30, 0x49, 0x26, 0xb8, 0xf3,
-	0xce, 0x20, 0x80, 0x33, 0x04, 0x91, 0x0b, 0x03, 0xcc, 0x94, 0x23, 0x43, 0xcd, 0x99, 0x78, 0x61,
-	0x9a, 0x46, 0x8c, 0x45, 0x09, 0xfa, 0x8a, 0x03, 0x63, 0x32, 0x57, 0x06, 0x33, 0x9e, 0xca, 0x20,
-	0xf3, 0xab, 0xb6, 0xdc, 0x22, 0x97, 0x74, 0xea, 0x69, 0x08, 0xa7, 0x01, 0x8e, 0x71, 0xa6, 0x11,
-	0xee, 0xf3, 0x70, 0x2a, 0xa7, 0xba, 0x95, 0x03, 0xf0, 0xf7, 0x1c, 0x28, 0x69, 0x29, 0x1c, 0xd1,
-	0x6b, 0x30, 0x1c, 0x56, 0xac, 0xc7, 0x0d, 0xae, 0x56, 0xba, 0xe2, 0x06, 0x15, 0x08, 0xa7, 0x0c,
-	0xf7, 0x13, 0xee, 0x98, 0x9b, 0x6f, 0xf2, 0x1e, 0x37, 0xfb, 0xc0, 0xe1, 0x8e, 0xbf, 0x5c, 0x84,
-	0x94, 0xd2, 0x01, 0x73, 0xb8, 0xa4, 0xc1, 0x91, 0x85, 0x3d, 0x83, 0x23, 0x6b, 0x70, 0xc2, 0x63,
-	0xae, 0xe7, 0x43, 0x66, 0x6e, 0xe1, 0x19, 0x7c, 0x4d, 0x0a, 0x38, 0x4b, 0x92, 0x72, 0x89, 0xd3,
-	0xaa, 0x8c, 0x4b, 0xff, 0x81, 0xb9, 0x54, 0x4c, 0x0a, 0x38, 0x4b, 0x12, 0xbd, 0x08, 0xe5, 0x2a,
-	0xbb, 0x6a, 0xcc, 0xfb, 0xb8, 0xb8, 0x79, 0x25, 0x4c, 0xd6, 0x22, 0x12, 0x93, 0x20, 0x11, 0x39,
-	0xda, 0x1e, 0x16, 0xa3, 0x50, 0x9e, 0xed, 0x81, 0x87, 0x7b, 0x52, 0xa0, 0xc7, 0x14, 0xe6, 0xbb,
-	0xf6, 0x93, 0x1d, 0x26, 0x44, 0x84, 0x53, 0x5f, 0x1d, 0x53, 0x2a, 0x7a, 0x21, 0x36, 0x71, 0xd1,
-	0x2f, 0x39, 0x30, 0xda, 0x94, 0xd6, 0x7d, 0xdc, 0x69, 0xca, 0x84, 0xa3, 0xd8, 0xca, 0xf4, 0x5b,
-	0xd6, 0x29, 0x73, 0x5d, 0xc2, 0x00, 0x61, 0x93, 0x77, 0x36, 0x8d, 0xce, 0xd0, 0x3e, 0xd3, 0xe8,
-	0xfc, 0xd0, 0x81, 0xf1, 0x2c, 0x37, 0xb4, 0x05, 0x0f, 0xb5, 0xbc, 0x68, 0x6b, 0x31, 0xd8, 0x8c,
-	0xd8, 0x95, 0x92, 0x84, 0x4f, 0x86, 0xe9, 0xcd, 0x84, 0x44, 0x73, 0xde, 0x0e, 0xf7, 0x96, 0x16,
-	0xd5, 0x8b, 0x55, 0x0f, 0xad, 0xec, 0x85, 0x8c, 0xf7, 0xa6, 0x85, 0x2a, 0x70, 0x86, 0x22, 0xb0,
-	0x2c, 0x7b, 0x7e, 0x18, 0xa4, 0x4c, 0x0a, 0x8c, 0x89, 0x0a, 0x6b, 0x5c, 0xc9, 0x43, 0xc2, 0xf9,
-	0x75, 0xdd, 0x4b, 0x30, 0xc0, 0x6f, 0xf8, 0xdd, 0x95, 0xbb, 0xc9, 0xfd, 0x8f, 0x05, 0x90, 0x8a,
-	0xe1, 0x5f, 0x6f, 0xef, 0x1d, 0xdd, 0x44, 0x23, 0x66, 0x52, 0x12, 0xd6, 0x0e, 0xb6, 0x89, 0x8a,
-	0x7c, 0x96, 0xa2, 0x84, 0x6a, 0xcc, 0xe4, 0xa6, 0x9f, 0xcc, 0x86, 0x35, 0x69, 0xe3, 0x60, 0x1a,
-	0xf3, 0x25, 0x01, 0xc3, 0xaa, 0xd4, 0xfd, 0xb4, 0x03, 0xa3, 0xb4, 0x97, 0xcd, 0x26, 0x69, 0x56,
-	0x12, 0xd2, 0x8e, 0x51, 0x0c, 0xc5, 0x98, 0xfe, 0x63, 0xcf, 0x14, 0x98, 0xde, 0x0a, 0x25, 0x6d,
-	0xcd, 0xb7, 0x43, 0x99, 0x60, 0xce, 0xcb, 0xfd, 0x6e, 0x1f, 0x0c, 0xab, 0xc1, 0xde, 0x87, 0xf5,
-	0xf5, 0x62, 0x9a, 0x6a, 0x96, 0x4b, 0xe0, 0xb2, 0x96, 0x66, 0xf6, 0x36, 0x1d, 0xba, 0x60, 0x87,
-	0x27, 0xd5, 0x48, 0x73, 0xce, 0x3e, 0x69, 0x7a, 0xa6, 0xcf, 0xea, 0xf3, 0x4f, 0xc3, 0x17, 0x2e,
-	0xea, 0x9b, 0x7a, 0x60, 0x40, 0xbf, 0xad, 0xdd, 0x4c, 0x79, 0x3d, 0x7b, 0x47, 0x04, 0x64, 0x5e,
-	0x21, 0x2a, 0xee, 0xeb, 0x15, 0xa2, 0x27, 0xa0, 0x9f, 0x04, 0x9d, 0x16, 0x53, 0x95, 0x86, 0xd9,
-	0x11, 0xa1, 0xff, 0x52, 0xd0, 0x69, 0x99, 0x3d, 0x63, 0x28, 0xe8, 0x03, 0x50, 0xaa, 0x91, 0xb8,
-	0x1a, 0xf9, 0x2c, 0x53, 0x84, 0xb0, 0xec, 0x3c, 0xc8, 0xcc, 0x65, 0x29, 0xd8, 0xac, 0xa8, 0x57,
-	0x70, 0x5f, 0x81, 0x81, 0xb5, 0x66, 0xa7, 0xee, 0x07, 0xa8, 0x0d, 0x03, 0x3c, 0x6f, 0x84, 0xd8,
-	0xed, 0x2d, 0x9c, 0x3b, 0xb9, 0xa8, 0xd0, 0x82, 0x56, 0xf8, 0xe5, 0x60, 0xc1, 0xc7, 0xfd, 0x54,
-	0x01, 0xe8, 0xd1, 0x7c, 0x61, 0x16, 0xfd, 0xdd, 0xae, 0x47, 0x77, 0x7e, 0x2e, 0xe7, 0xd1, 0x9d,
-	0x51, 0x86, 0x9c, 0xf3, 0xde, 0x4e, 0x13, 0x46, 0x99, 0x2f, 0x45, 0xee, 0x81, 0x42, 0xad, 0x7e,
-	0x7a, 0x9f, 0xa9, 0x16, 0xf4, 0xaa, 0x62, 0x47, 0xd0, 0x41, 0xd8, 0x24, 0x8e, 0x56, 0xe0, 0x14,
-	0xcf, 0x58, 0x3a, 0x47, 0x9a, 0xde, 0x4e, 0x26, 0x33, 0xd9, 0x03, 0xf2, 0x1d, 0xb5, 0xb9, 0x6e,
-	0x14, 0x9c, 0x57, 0xcf, 0xfd, 0xbd, 0x7e, 0xd0, 0x3c, 0x18, 0xfb, 0x58, 0x2d, 0x2f, 0x67, 0xfc,
-	0x55, 0x2b, 0x56, 0xfc, 0x55, 0xd2, 0x09, 0xc4, 0x25, 0x90, 0xe9, 0xa2, 0xa2, 0x8d, 0x6a, 0x90,
-	0x66, 0x5b, 0xf4, 0x51, 0x35, 0xea, 0x32, 0x69, 0xb6, 0x31, 0x2b, 0x51, 0x57, 0x23, 0xfb, 0x7b,
-	0x5e, 0x8d, 0x6c, 0x40, 0xb1, 0xee, 0x75, 0xea, 0x44, 0x04, 0x6c, 0x5a, 0x70, 0x4d, 0xb2, 0xcb,
-	0x1a, 0xdc, 0x35, 0xc9, 0xfe, 0xc5, 0x9c, 0x01, 0x5d, 0xec, 0x0d, 0x19, 0xc1, 0x22, 0x8c, 0xb4,
-	0x16, 0x16, 0xbb, 0x0a, 0x8a, 0xe1, 0x8b, 0x5d, 0xfd, 0xc4, 0x29, 0x33, 0xd4, 0x86, 0xc1, 0x2a,
-	0x4f, 0xf8, 0x22, 0x74, 0x96, 0x45, 0x1b, 0x77, 0x3f, 0x19, 0x41, 0x6e, 0x4d, 0x11, 0x3f, 0xb0,
-	0x64, 0xe3, 0x5e, 0x80, 0x92, 0xf6, 0xf6, 0x07, 0xfd, 0x0c, 0x2a, 0xd7, 0x88, 0xf6, 0x19, 0xe6,
-	0xbc, 0xc4, 0xc3, 0xac, 0xc4, 0xfd, 0x56, 0x3f, 0x28, 0x5b, 0x9a, 0x7e, 0x53, 0xd1, 0xab, 0x6a,
-	0x99, 0x91, 0x8c, 0x5b, 0xfb, 0x61, 0x80, 0x45, 0x29, 0xd5, 0xeb, 0x5a, 0x24, 0xaa, 0xab, 0x73,
-	0xb4, 0x10, 0xd7, 0x4a, 0xaf, 0x5b, 0xd1, 0x0b, 0xb1, 0x89, 0x4b, 0x95, 0xf2, 0x96, 0xf0, 0xe8,
-	0x67, 0xe3, 0xb0, 0xa5, 0xa7, 0x1f, 0x2b, 0x0c, 0x96, 0x5a, 0xa1, 0xa5, 0x05, 0x00, 0x88, 0xb8,
-	0x4d, 0x1b, 0x0e, 0x25, 0x8d, 0x2a, 0x8f, 0xaf, 0xd2, 0x21, 0xd8, 0xe0, 0x8a, 0x16, 0xe0, 0x64,
-	0x4c, 0x92, 0xd5, 0x1b, 0x01, 0x89, 0x54, 0x52, 0x03, 0x91, 0xbb, 0x43, 0xdd, 0xe3, 0xa8, 0x64,
-	0x11, 0x70, 0x77, 0x9d, 0xdc, 0x50, 0xd7, 0xe2, 0x81, 0x43, 0x5d, 0xe7, 0x60, 0x7c, 0xd3, 0xf3,
-	0x9b, 0x9d, 0x88, 0xf4, 0x0c, 0x98, 0x9d, 0xcf, 0x94, 0xe3, 0xae, 0x1a, 0xec, 0x2a, 0x51, 0xd3,
-	0xab, 0xc7, 0xe5, 0x41, 0xed, 0x2a, 0x11, 0x05, 0x60, 0x0e, 0x77, 0x7f, 0xd3, 0x01, 0x9e, 0x34,
-	0x69, 0x7a, 0x73, 0xd3, 0x0f, 0xfc, 0x64, 0x07, 0x7d, 0xdd, 0x81, 0xf1, 0x20, 0xac, 0x91, 0xe9,
-	0x20, 0xf1, 0x25, 0xd0, 0x5e, 0xa2, 0x7b, 0xc6, 0xeb, 0x4a, 0x86, 0x3c, 0xcf, 0xc0, 0x91, 0x85,
-	0xe2, 0xae, 0x66, 0xb8, 0xe7, 0xe0, 0x4c, 0x2e, 0x01, 0xf7, 0x87, 0x7d, 0x60, 0xe6, 0x7e, 0x42,
-	0xcf, 0x41, 0xb1, 0xc9, 0xb2, 0x91, 0x38, 0x87, 0x4c, 0xea, 0xc5, 0xc6, 0x8a, 0xa7, 0x2b, 0xe1,
-	0x94, 0xd0, 0x1c, 0x94, 0x58, 0x42, 0x29, 0x91, 0x2b, 0xa6, 0x60, 0x24, 0x61, 0x28, 0xe1, 0xb4,
-	0xe8, 0xb6, 0xf9, 0x13, 0xeb, 0xd5, 0xd0, 0xab, 0x30, 0xb8, 0xc1, 0xb3, 0x6e, 0xda, 0xf3, 0xf9,
-	0x89, 0x34, 0x9e, 0x4c, 0x37, 0x92, 0x39, 0x3d, 0x6f, 0xa7, 0xff, 0x62, 0xc9, 0x11, 0xed, 0xc0,
-	0x90, 0x27, 0xbf, 0x69, 0xbf, 0xad, 0x7b, 0x1d, 0xc6, 0xfc, 0x11, 0x01, 0x36, 0xf2, 0x1b, 0x2a,
-	0x76, 0x99, 0x48, 0xa4, 0xe2, 0xbe, 0x22, 0x91, 0xbe, 0xe3, 0x00, 0xa4, 0x4f, 0x94, 0xa0, 0x9b,
-	0x30, 0x14, 0x3f, 0x6d, 0x18, 0x2a, 0x6c, 0xe4, 0x04, 0x10, 0x14, 0xb5, 0x7b, 0xb3, 0x02, 0x82,
-	0x15, 0xb7, 0x3b, 0x19, 0x57, 0x7e, 0xe6, 0xc0, 0xe9, 0xbc, 0xa7, 0x54, 0xee, 0x61, 0x8b, 0x0f,
-	0x6a, 0x57, 0x11, 0x15, 0xd6, 0x22, 0xb2, 0xe9, 0xdf, 0xcc, 0xc9, 0xfd, 0xcc, 0x0b, 0x70, 0x8a,
-	0xe3, 0xfe, 0xd9, 0x20, 0x28, 0xc6, 0x47, 0x64, 0x87, 0x79, 0x8c, 0x9e, 0x99, 0xea, 0xa9, 0xce,
-	0xa5, 0xf0, 0x30, 0x83, 0x62, 0x51, 0x4a, 0xcf, 0x4d, 0x32, 0x86, 0x5e, 0x88, 0x6c, 0x36, 0x0b,
-	0x65, 0xac, 0x3d, 0x56, 0xa5, 0x79, 0x96, 0x9d, 0xe2, 0xb1, 0x58, 0x76, 0x06, 0xec, 0x5b, 0x76,
-	0x5a, 0x80, 0x62, 0xbe, 0x50, 0x98, 0x39, 0x45, 0x30, 0x1a, 0x39, 0xb0, 0xa1, 0xb9, 0xd2, 0x45,
-	0x04, 0xe7, 0x10, 0x66, 0x31, 0x14, 0x61, 0x93, 0x4c, 0xe3, 0x2b, 0xe2, 0xf0, 0x91, 0xc6, 0x50,
-	0x70, 0x30, 0x96, 0xe5, 0x87, 0x34, 0xa5, 0xa0, 0xdf, 0x76, 0xf6, 0xb0, 0x55, 0x0d, 0xdb, 0xda,
-	0x82, 0x72, 0x13, 0xef, 0xb1, 0x93, 0xd4, 0x61, 0x0c, 0x60, 0xdf, 0x70, 0xe0, 0x24, 0x09, 0xaa,
-	0xd1, 0x0e, 0xa3, 0x23, 0xa8, 0x09, 0x17, 0xf7, 0x55, 0x1b, 0x6b, 0xfd, 0x52, 0x96, 0x38, 0xf7,
-	0x24, 0x75, 0x81, 0x71, 0x77, 0x33, 0xd0, 0x2a, 0x0c, 0x55, 0x3d, 0x31, 0x2f, 0x4a, 0x07, 0x99,
-	0x17, 0xdc, 0x51, 0x37, 0x2d, 0x66, 0x83, 0x22, 0xe2, 0xfe, 0xa4, 0x00, 0xa7, 0x72, 0x9a, 0xc4,
-	0xae, 0x77, 0xb5, 0xe8, 0x02, 0x58, 0xac, 0x65, 0x97, 0xff, 0x92, 0x80, 0x63, 0x85, 0x81, 0xd6,
-	0xe0, 0xf4, 0x56, 0x2b, 0x4e, 0xa9, 0xcc, 0x86, 0x41, 0x42, 0x6e, 0x4a, 0x61, 0x20, 0xdd, 0xdf,
-	0xa7, 0x97, 0x72, 0x70, 0x70, 0x6e, 0x4d, 0xaa, 0x2d, 0x91, 0xc0, 0xdb, 0x68, 0x92, 0xb4, 0x48,
-	0x04, 0x6b, 0x29, 0x6d, 0xe9, 0x52, 0xa6, 0x1c, 0x77, 0xd5, 0x40, 0x6f, 0x3a, 0xf0, 0x40, 0x4c,
-	0xa2, 0x6d, 0x12, 0x55, 0xfc, 0x1a, 0x99, 0xed, 0xc4, 0x49, 0xd8, 0x22, 0xd1, 0x21, 0xad, 0xb3,
-	0x93, 0xb7, 0x76, 0x27, 0x1f, 0xa8, 0xf4, 0xa6, 0x86, 0xf7, 0x62, 0xe5, 0xbe, 0xe9, 0xc0, 0x58,
-	0x85, 0x9d, 0xdd, 0x95, 0xea, 0x6e, 0x3b, 0xf5, 0xea, 0x63, 0x2a, 0xd3, 0x47, 0x46, 0x08, 0x9b,
-	0xb9, 0x39, 0xdc, 0x97, 0x60, 0xbc, 0x42, 0x5a, 0x5e, 0xbb, 0xc1, 0x2e, 0x3d, 0xf3, 0xf0, 0xaf,
-	0x0b, 0x30, 0x1c, 0x4b, 0x58, 0xf6, 0x31, 0x26, 0x85, 0x8c, 0x53, 0x1c, 0xf4, 0x28, 0x0f, 0x55,
-	0x93, 0xf7, 0x93, 0x86, 0xf9, 0x21, 0x87, 0xc7, 0xb7, 0xc5, 0x58, 0x96, 0xb9, 0xdf, 0x29, 0xc0,
-	0x48, 0x5a, 0x9f, 0x6c, 0xa2, 0x3a, 0x9c, 0xa8, 0x6a, 0x77, 0xfb, 0xd2, 0x5b, 0x15, 0xfb, 0xbf,
-	0x06, 0xc8, 0x33, 0x42, 0x9b, 0x44, 0x70, 0x96, 0xea, 0xc1, 0xe3, 0x02, 0x5f, 0xcd, 0xc4, 0x05,
-	0x5a, 0x79, 0xe5, 0xa1, 0xb2, 0x13, 0x54, 0x55, 0x54, 0x21, 0xd9, 0x94, 0x01, 0x0b, 0x5d, 0x61,
-	0x86, 0x5f, 0x2c, 0xc0, 0x09, 0x35, 0x4e, 0xc2, 0x49, 0xfa, 0x7a, 0x36, 0x1a, 0x10, 0xdb, 0x48,
-	0x98, 0x64, 0x7e, 0xf8, 0x3d, 0x22, 0x02, 0x5f, 0xcf, 0x46, 0x04, 0x1e, 0x29, 0xfb, 0x2e, 0xbf,
-	0xef, 0x77, 0x0a, 0x30, 0xa4, 0xd2, 0x37, 0x3d, 0x07, 0x45, 0x76, 0x6c, 0xbe, 0x3b, 0xe5, 0x9f,
-	0x1d, 0xc1, 0x31, 0xa7, 0x44, 0x49, 0xb2, 0x88, 0xa3, 0x43, 0x27, 0x09, 0x1e, 0xe6, 0xc6, 0x53,
-	0x2f, 0x4a, 0x30, 0xa7, 0x84, 0x96, 0xa0, 0x8f, 0x04, 0x35, 0x31, 0x79, 0x0e, 0x4e, 0x90, 0xbd,
-	0xd9, 0x76, 0x29, 0xa8, 0x61, 0x4a, 0x85, 0xe5, 0x90, 0xe3, 0xca, 0x5e, 0xe6, 0x85, 0x1e, 0xa1,
-	0xe9, 0x89, 0x52, 0x77, 0x06, 0x8c, 0xfc, 0x82, 0x87, 0xba, 0xc5, 0xf1, 0x4b, 0x7d, 0x30, 0x50,
-	0xe9, 0x6c, 0xd0, 0x33, 0xd1, 0xb7, 0x1d, 0x38, 0x75, 0x23, 0x93, 0x85, 0x3b, 0x5d, 0xa4, 0x57,
-	0xed, 0x19, 0xa1, 0xf5, 0xc8, 0x39, 0x65, 0x7a, 0xcb, 0x29, 0xc4, 0x79, 0xcd, 0x31, 0x12, 0xe1,
-	0xf6, 0x1d, 0x49, 0x22, 0xdc, 0x9b, 0x47, 0x7c, 0xd3, 0x64, 0xb4, 0xd7, 0x2d, 0x13, 0xf7, 0xf7,
-	0x8a, 0x00, 0xfc, 0x6b, 0xac, 0xb6, 0x93, 0xfd, 0x98, 0x15, 0x9f, 0x81, 0x91, 0x3a, 0x09, 0x48,
-	0x24, 0xe3, 0x22, 0x33, 0x0f, 0x48, 0x2d, 0x68, 0x65, 0xd8, 0xc0, 0x64, 0x93, 0x25, 0x48, 0xa2,
-	0x1d, 0xae, 0xe7, 0x67, 0x6f, 0x93, 0xa8, 0x12, 0xac, 0x61, 0xa1, 0x29, 0xc3, 0xeb, 0xc3, 0x03,
-	0x08, 0xc6, 0xf6, 0x70, 0xd2, 0x7c, 0x00, 0xc6, 0xcc, 0xac, 0x31, 0x42, 0xdb, 0x54, 0x0e, 0x7f,
-	0x33, 0xd9, 0x0c, 0xce, 0x60, 0xd3, 0x85, 0x50, 0x8b, 0x76, 0x70, 0x27, 0x10, 0x6a, 0xa7, 0x5a,
-	0x08, 0x73, 0x0c, 0x8a, 0x45, 0x29, 0x4b, 0xb7, 0xc1, 0x36, 0x60, 0x0e, 0x17, 0x29, 0x3b, 0xd2,
-	0x74, 0x1b, 0x5a, 0x19, 0x36, 0x30, 0x29, 0x07, 0x61, 0x96, 0x05, 0x73, 0xa9, 0x65, 0x6c, 0xa9,
-	0x6d, 0x18, 0x0b, 0x4d, 0x73, 0x12, 0xd7, 0xc1, 0xde, 0xb3, 0xcf, 0xa9, 0x67, 0xd4, 0xe5, 0x81,
-	0x1a, 0x19, 0xeb, 0x53, 0x86, 0x3e, 0xd5, 0xbb, 0xf5, 0x4b, 0x17, 0x23, 0x66, 0x58, 0x6d, 0xcf,
-	0x7b, 0x11, 0x6b, 0x70, 0xba, 0x1d, 0xd6, 0xd6, 0x22, 0x3f, 0x8c, 0xfc, 0x64, 0x67, 0xb6, 0xe9,
-	0xc5, 0x31, 0x9b, 0x18, 0xa3, 0xa6, 0x3e, 0xb6, 0x96, 0x83, 0x83, 0x73, 0x6b, 0xd2, 0x03, 0x59,
-	0x5b, 0x00, 0x59, 0x70, 0x5b, 0x91, 0xef, 0x64, 0x12, 0x11, 0xab, 0x52, 0xf7, 0x14, 0x9c, 0xac,
-	0x74, 0xda, 0xed, 0xa6, 0x4f, 0x6a, 0xca, 0xab, 0xe2, 0x7e, 0x10, 0x4e, 0x88, 0x34, 0xb9, 0x4a,
-	0xfb, 0x39, 0x50, 0x52, 0x77, 0xf7, 0xdd, 0x70, 0x22, 0xb3, 0x95, 0xde, 0x21, 0xe2, 0xc3, 0xfd,
-	0xaf, 0x7d, 0xbc, 0x8a, 0x16, 0x7c, 0x84, 0x5e, 0xcd, 0x6a, 0x39, 0x76, 0x12, 0xbe, 0x6a, 0xfa,
-	0x8d, 0xc8, 0xde, 0x9a, 0xa7, 0x31, 0x35, 0xe4, 0xcd, 0x01, 0x6b, 0x17, 0x7c, 0x58, 0x7c, 0x3d,
-	0xdf, 0x87, 0x8c, 0xeb, 0x07, 0x1f, 0x07, 0x50, 0x6c, 0x65, 0x4e, 0x01, 0xdb, 0xfd, 0x64, 0x2b,
-	0x5e, 0x41, 0x62, 0xac, 0x71, 0x44, 0x01, 0x0c, 0xb2, 0x86, 0x10, 0x79, 0xab, 0xd4, 0x5a, 0x5f,
-	0x99, 0x92, 0xb9, 0xc2, 0x69, 0x63, 0xc9, 0xc4, 0xfd, 0x5c, 0x01, 0xf2, 0x23, 0xdc, 0xd0, 0xc7,
-	0xbb, 0x3f, 0xf8, 0x73, 0x16, 0x07, 0x42, 0x84, 0xd8, 0xf5, 0xfe, 0xe6, 0x81, 0xf9, 0xcd, 0x57,
-	0x2c, 0x8d, 0x83, 0xe0, 0xdb, 0xf5, 0xe5, 0xdd, 0xff, 0xe5, 0x40, 0x69, 0x7d, 0x7d, 0x59, 0x29,
-	0x03, 0x18, 0xce, 0xc6, 0x3c, 0x61, 0x03, 0x0b, 0x04, 0x98, 0x0d, 0x5b, 0x6d, 0x1e, 0x17, 0x20,
-	0xe2, 0x15, 0x58, 0x4e, 0xe7, 0x4a, 0x2e, 0x06, 0xee, 0x51, 0x13, 0x2d, 0xc2, 0x29, 0xbd, 0xa4,
-	0xa2, 0xbd, 0xb0, 0x59, 0x14, 0xf9, 0x9b, 0xba, 0x8b, 0x71, 0x5e, 0x9d, 0x2c, 0x29, 0x61, 0xff,
-	0x66, 0x1b, 0x7a, 0x0e, 0x29, 0x51, 0x8c, 0xf3, 0xea, 0xb8, 0xab, 0x50, 0x5a, 0xf7, 0x22, 0xd5,
-	0xf1, 0x0f, 0xc1, 0x78, 0x35, 0x6c, 0x49, 0x05, 0x67, 0x99, 0x6c, 0x93, 0xa6, 0xe8, 0x32, 0x7f,
-	0xb7, 0x26, 0x53, 0x86, 0xbb, 0xb0, 0xdd, 0xdf, 0x78, 0x18, 0xd4, 0x05, 0xd4, 0x7d, 0xec, 0xc1,
-	0x6d, 0x15, 0xfb, 0x5b, 0xb4, 0x1c, 0xfb, 0xab, 0x76, 0xa3, 0x4c, 0xfc, 0x6f, 0x92, 0xc6, 0xff,
-	0x0e, 0xd8, 0x8e, 0xff, 0x55, 0x6a, 0x79, 0x57, 0x0c, 0xf0, 0x57, 0x1d, 0x18, 0x09, 0xc2, 0x1a,
-	0x51, 0x0e, 0xdb, 0x41, 0xb6, 0xc2, 0x5f, 0xb4, 0x77, 0x95, 0x82, 0xc7, 0xb2, 0x0a, 0xf2, 0x3c,
-	0x2e, 0x5d, 0x6d, 0xe2, 0x7a, 0x11, 0x36, 0xda, 0x81, 0xe6, 0x35, 0x4b, 0x38, 0x77, 0x38, 0x3d,
-	0x98, 0x77, 0xa2, 0xbc, 0xa3, 0x59, 0xfb, 0xa6, 0xa6, 0x59, 0x0e, 0xdb, 0xb2, 0xf0, 0xca, 0x5b,
-	0x85, 0x9a, 0xdf, 0x4c, 0xa6, 0x25, 0x4f, 0x35, 0x4e, 0x17, 0x06, 0x78, 0x00, 0xbb, 0xc8, 0x14,
-	0xc6, 0xdc, 0xb9, 0x3c, 0xb8, 0x1d, 0x8b, 0x12, 0x94, 0xc8, 0xa0, 0x90, 0x92, 0xad, 0x47, 0x46,
-	0x8c, 0xa0, 0x93, 0xfc, 0xa8, 0x10, 0xf4, 0xac, 0x6e, 0xa9, 0x18, 0xd9, 0x8f, 0xa5, 0x62, 0xb4,
-	0xa7, 0x95, 0xe2, 0x0b, 0x0e, 0x8c, 0x54, 0xb5, 0x47, 0x3f, 0xca, 0x8f, 0xdb, 0x7a, 0xfb, 0x3c,
-	0xef, 0x6d, 0x16, 0xee, 0x25, 0x34, 0x1e, 0x19, 0x31, 0xb8, 0xb3, 0xf4, 0xa8, 0xcc, 0x2c, 0xc3,
-	0x94, 0x23, 0x2b, 0x69, 0x47, 0x4c, 0x33, 0x8f, 0x0c, 0xae, 0xa5, 0x30, 0x2c, 0x78, 0xa1, 0xd7,
-	0x60, 0x48, 0xde, 0x81, 0x10, 0x77, 0x05, 0xb0, 0x0d, 0xb7, 0x8d, 0xe9, 0x1b, 0x96, 0x39, 0x15,
-	0x39, 0x14, 0x2b, 0x8e, 0xa8, 0x01, 0x7d, 0x35, 0xaf, 0x2e, 0x6e, 0x0d, 0xac, 0xd8, 0xc9, 0x59,
-	0x2b, 0x79, 0xb2, 0x43, 0xec, 0xdc, 0xf4, 0x02, 0xa6, 0x2c, 0xd0, 0xcd, 0xf4, 0xd5, 0x84, 0x71,
-	0x6b, 0xbb, 0xaf, 0xa9, 0x48, 0x72, 0x9d, 0xa0, 0xeb, 0x11, 0x86, 0x9a, 0x70, 0xa7, 0xff, 0x0d,
-	0xc6, 0x76, 0xde, 0x4e, 0xd2, 0x5b, 0x9e, 0xc6, 0x26, 0x75, 0xc9, 0x53, 0x2e, 0x8d, 0x24, 0x69,
-	0x97, 0x7f, 0xde, 0x16, 0x17, 0x96, 0x8c, 0x85, 0x3f, 0x53, 0xbf, 0xbe, 0xbe, 0x86, 0x19, 0x75,
-	0xd4, 0x84, 0x81, 0x36, 0x8b, 0xf4, 0x29, 0xbf, 0xd3, 0xd6, 0xde, 0xc2, 0x23, 0x87, 0xf8, 0xdc,
-	0xe4, 0xff, 0x63, 0xc1, 0x03, 0x5d, 0x82, 0x41, 0xfe, 0xf8, 0x0f, 0xbf, 0xb5, 0x51, 0xba, 0x38,
-	0xd1, 0xfb, 0x09, 0xa1, 0x74, 0xa3, 0xe0, 0xbf, 0x63, 0x2c, 0xeb, 0xa2, 0x2f, 0x3a, 0x30, 0x46,
-	0x25, 0x6a, 0xfa, 0x5a, 0x51, 0x19, 0xd9, 0x92, 0x59, 0x57, 0x63, 0xaa, 0x91, 0x48, 0x59, 0xa3,
-	0x0e, 0x92, 0x8b, 0x06, 0x3b, 0x9c, 0x61, 0x8f, 0x5e, 0x87, 0xa1, 0xd8, 0xaf, 0x91, 0xaa, 0x17,
-	0xc5, 0xe5, 0x53, 0x47, 0xd3, 0x94, 0xd4, 0x81, 0x27, 0x18, 0x61, 0xc5, 0x12, 0xfd, 0x2a, 0x7b,
-	0x4d, 0xb6, 0xda, 0xf0, 0xb7, 0xc9, 0x72, 0x58, 0xe5, 0x07, 0x9f, 0xd3, 0xb6, 0xd6, 0xbe, 0x74,
-	0x55, 0x4a, 0xca, 0xc2, 0xaf, 0x65, 0xb2, 0xc3, 0x59, 0xfe, 0xe8, 0xef, 0x39, 0x70, 0x86, 0x3f,
-	0xeb, 0x90, 0x7d, 0xa9, 0xe4, 0xcc, 0x21, 0x8d, 0x58, 0xec, 0xba, 0xc9, 0x74, 0x1e, 0x49, 0x9c,
-	0xcf, 0x89, 0x25, 0x61, 0x36, 0x1f, 0x97, 0x3a, 0x6b, 0xd5, 0x91, 0xbd, 0xff, 0x07, 0xa5, 0xd0,
-	0x53, 0x50, 0x6a, 0x8b, 0xed, 0xd0, 0x8f, 0x5b, 0xec, 0xf2, 0x50, 0x1f, 0xbf, 0xd6, 0xb9, 0x96,
-	0x82, 0xb1, 0x8e, 0x63, 0x64, 0xe4, 0x7e, 0x62, 0xaf, 0x8c, 0xdc, 0xe8, 0x2a, 0x94, 0x92, 0xb0,
-	0x29, 0x92, 0xd2, 0xc6, 0xe5, 0x32, 0x9b, 0x81, 0xe7, 0xf3, 0xd6, 0xd6, 0xba, 0x42, 0x4b, 0xcf,
-	0xfa, 0x29, 0x2c, 0xc6, 0x3a, 0x1d, 0x16, 0xb0, 0x2d, 0x9e, 0xcb, 0x88, 0xd8, 0x21, 0xff, 0xfe,
-	0x4c, 0xc0, 0xb6, 0x5e, 0x88, 0x4d, 0x5c, 0xb4, 0x00, 0x27, 0xdb, 0x5d, 0x56, 0x02, 0x7e, 0x69,
-	0x51, 0xc5, 0xc8, 0x74, 0x9b, 0x08, 0xba, 0xeb, 0xf4, 0xc8, 0x3a, 0xfd, 0xe0, 0x61, 0xb2, 0x4e,
-	0xa3, 0x1a, 0x3c, 0xe8, 0x75, 0x92, 0x90, 0xa5, 0x11, 0x32, 0xab, 0xf0, 0x88, 0xf4, 0x87, 0x79,
-	0x90, 0xfb, 0xad, 0xdd, 0xc9, 0x07, 0xa7, 0xf7, 0xc0, 0xc3, 0x7b, 0x52, 0x41, 0xaf, 0xc0, 0x10,
-	0x11, 0x99, 0xb3, 0xcb, 0x3f, 0x67, 0x6b, 0xeb, 0x37, 0x73, 0x71, 0xcb, 0x60, 0x5f, 0x0e, 0xc3,
-	0x8a, 0x1f, 0x5a, 0x87, 0x52, 0x23, 0x8c, 0x93, 0xe9, 0xa6, 0xef, 0xc5, 0x24, 0x2e, 0x3f, 0xc4,
-	0xa6, 0x42, 0xae, 0x46, 0x75, 0x59, 0xa2, 0xa5, 0x33, 0xe1, 0x72, 0x5a, 0x13, 0xeb, 0x64, 0x10,
-	0x61, 0x4e, 0x6a, 0x16, 0x8e, 0x2f, 0x1d, 0x70, 0xe7, 0x59, 0xc7, 0x1e, 0xcb, 0xa3, 0xbc, 0x16,
-	0xd6, 0x2a, 0x26, 0xb6, 0xf2, 0x52, 0xeb, 0x40, 0x9c, 0xa5, 0x89, 0x9e, 0x81, 0x91, 0x76, 0x58,
-	0xab, 0xb4, 0x49, 0x75, 0xcd, 0x4b, 0xaa, 0x8d, 0xf2, 0xa4, 0x69, 0x6d, 0x5c, 0xd3, 0xca, 0xb0,
-	0x81, 0x89, 0xda, 0x30, 0xd8, 0xe2, 0xf9, 0x25, 0xca, 0x8f, 0xd8, 0x3a, 0xb1, 0x88, 0x84, 0x15,
-	0xc2, 0x32, 0xc0, 0x7f, 0x60, 0xc9, 0x06, 0xfd, 0x13, 0x07, 0x4e, 0x64, 0x2e, 0xb9, 0x95, 0xdf,
-	0x61, 0xd3, 0xb7, 0xa3, 0x11, 0x9e, 0x79, 0x8c, 0x0d, 0x9f, 0x09, 0xbc, 0xdd, 0x0d, 0xc2, 0xd9,
-	0x16, 0xf1, 0x71, 0x61, 0x49, 0x62, 0xca, 0x8f, 0xda, 0x1b, 0x17, 0x46, 0x50, 0x8e, 0x0b, 0xfb,
-	0x81, 0x25, 0x1b, 0xf4, 0x04, 0x0c, 0x8a, 0x7c, 0x8e, 0xe5, 0xc7, 0x4c, 0xd7, 0xbf, 0x48, 0xfb,
-	0x88, 0x65, 0x79, 0x57, 0xe2, 0x97, 0x27, 0x6d, 0x25, 0x7e, 0x51, 0xe7, 0xbd, 0x83, 0x27, 0x7e,
-	0x99, 0xf8, 0x20, 0x9c, 0xec, 0x3a, 0x25, 0x1e, 0x28, 0xf3, 0xca, 0x5d, 0x66, 0x6e, 0x71, 0x7f,
-	0xdd, 0x01, 0xfd, 0xaa, 0xbf, 0xf5, 0x37, 0x78, 0x9e, 0x81, 0x91, 0x2a, 0x7f, 0x12, 0x95, 0x27,
-	0x0b, 0xe8, 0x37, 0x8d, 0xd9, 0xb3, 0x5a, 0x19, 0x36, 0x30, 0xdd, 0xcb, 0x80, 0xba, 0x1f, 0x48,
-	0x38, 0x94, 0x57, 0xe8, 0x9f, 0x39, 0x30, 0x6a, 0xa8, 0x37, 0xd6, 0x3d, 0xd6, 0xf3, 0x80, 0x5a,
-	0x7e, 0x14, 0x85, 0x91, 0xfe, 0xf6, 0xa4, 0x48, 0xe8, 0xc1, 0x22, 0x59, 0x56, 0xba, 0x4a, 0x71,
-	0x4e, 0x0d, 0xf7, 0x5f, 0xf4, 0x43, 0x1a, 0xc2, 0xaf, 0xd2, 0x47, 0x3b, 0x3d, 0xd3, 0x47, 0x3f,
-	0x09, 0x43, 0x2f, 0xc5, 0x61, 0xb0, 0x96, 0x26, 0x99, 0x56, 0xdf, 0xe2, 0xd9, 0xca, 0xea, 0x15,
-	0x86, 0xa9, 0x30, 0x18, 0xf6, 0xcb, 0xf3, 0x7e, 0x33, 0xe9, 0xce, 0x42, 0xfc, 0xec, 0x73, 0x1c,
-	0x8e, 0x15, 0x06, 0x7b, 0x86, 0x72, 0x9b, 0x28, 0x2f, 0x47, 0xfa, 0x0c, 0x25, 0x7f, 0xfb, 0x84,
-	0x95, 0xa1, 0x0b, 0x30, 0xac, 0x3c, 0x24, 0xc2, 0xed, 0xa2, 0x46, 0x4a, 0xb9, 0x51, 0x70, 0x8a,
-	0xc3, 0x74, 0x57, 0x61, 0x55, 0x17, 0xd6, 0x9e, 0x8a, 0x8d, 0x93, 0x54, 0xc6, 0x4e, 0xcf, 0x37,
-	0x2c, 0x09, 0xc6, 0x8a, 0x65, 0x9e, 0xd7, 0x7e, 0xf8, 0x48, 0xbc, 0xf6, 0xda, 0x7d, 0x92, 0xe2,
-	0x7e, 0xef, 0x93, 0x98, 0x73, 0x7b, 0x68, 0x5f, 0x73, 0xfb, 0x33, 0x7d, 0x30, 0x78, 0x8d, 0x44,
-	0x2c, 0x7f, 0xff, 0x13, 0x30, 0xb8, 0xcd, 0xff, 0xcd, 0x5e, 0x46, 0x16, 0x18, 0x58, 0x96, 0xd3,
-	0xef, 0xb6, 0xd1, 0xf1, 0x9b, 0xb5, 0xb9, 0x74, 0x15, 0xa7, 0xf9, 0x35, 0x65, 0x01, 0x4e, 0x71,
-	0x68, 0x85, 0x3a, 0x3d, 0x84, 0xb4, 0x5a, 0x7e, 0x92, 0x0d, 0xc2, 0x5b, 0x90, 0x05, 0x38, 0xc5,
-	0x41, 0x8f, 0xc1, 0x40, 0xdd, 0x4f, 0xd6, 0xbd, 0x7a, 0xd6, 0xed, 0xbb, 0xc0, 0xa0, 0x58, 0x94,
-	0x32, 0x9f, 0x9f, 0x9f, 0xac, 0x47, 0x84, 0x19, 0xa1, 0xbb, 0x72, 0xa1, 0x2c, 0x68, 0x65, 0xd8,
-	0xc0, 0x64, 0x4d, 0x0a, 0x45, 0xcf, 0x44, 0x04, 0x72, 0xda, 0x24, 0x59, 0x80, 0x53, 0x1c, 0x3a,
-	0xff, 0xab, 0x61, 0xab, 0xed, 0x37, 0x45, 0x6c, 0xbc, 0x36, 0xff, 0x67, 0x05, 0x1c, 0x2b, 0x0c,
-	0x8a, 0x4d, 0x45, 0x18, 0x15, 0x3f, 0xd9, 0x27, 0xff, 0xd6, 0x04, 0x1c, 0x2b, 0x0c, 0xf7, 0x1a,
-	0x8c, 0xf2, 0x95, 0x3c, 0xdb, 0xf4, 0xfc, 0xd6, 0xc2, 0x2c, 0xba, 0xd4, 0x75, 0x9f, 0xe4, 0x89,
-	0x9c, 0xfb, 0x24, 0x67, 0x8c, 0x4a, 0xdd, 0xf7, 0x4a, 0xdc, 0x1f, 0x15, 0x60, 0xe8, 0x18, 0x5f,
-	0x4d, 0x3d, 0xf6, 0x07, 0xc0, 0xd1, 0xcd, 0xcc, 0x8b, 0xa9, 0x6b, 0x36, 0xaf, 0x87, 0xed, 0xf9,
-	0x5a, 0xea, 0x7f, 0x2b, 0xc0, 0x59, 0x89, 0x2a, 0x8f, 0x9d, 0x0b, 0xb3, 0xec, 0x25, 0xba, 0xa3,
-	0x1f, 0xe8, 0xc8, 0x18, 0xe8, 0x35, 0x7b, 0x07, 0xe7, 0x85, 0xd9, 0x9e, 0x43, 0xfd, 0x4a, 0x66,
-	0xa8, 0xb1, 0x55, 0xae, 0x7b, 0x0f, 0xf6, 0x5f, 0x38, 0x30, 0x91, 0x3f, 0xd8, 0xc7, 0xf0, 0x48,
-	0xed, 0xeb, 0xe6, 0x23, 0xb5, 0xbf, 0x60, 0x6f, 0x8a, 0x99, 0x5d, 0xe9, 0xf1, 0x5c, 0xed, 0xff,
-	0x74, 0xe0, 0xb4, 0xac, 0xc0, 0x76, 0xcf, 0x19, 0x3f, 0x60, 0x91, 0x49, 0x47, 0x3f, 0xcd, 0x5e,
-	0x33, 0xa6, 0xd9, 0x0b, 0xf6, 0x3a, 0xae, 0xf7, 0xa3, 0xe7, 0xe3, 0xfe, 0x7f, 0xee, 0x40, 0x39,
-	0xaf, 0xc2, 0x31, 0x7c, 0xf2, 0x57, 0xcd, 0x4f, 0x7e, 0xed, 0x68, 0x7a, 0xde, 0xfb, 0x83, 0x97,
-	0x7b, 0x0d, 0x14, 0x6a, 0x4a, 0xbd, 0xca, 0xb1, 0xe5, 0x3e, 0xe7, 0x2c, 0xf2, 0x15, 0xb4, 0x26,
-	0x0c, 0xc4, 0x2c, 0x04, 0x47, 0x4c, 0x81, 0xcb, 0x36, 0xb4, 0x2d, 0x4a, 0x4f, 0xb8, 0x03, 0xd8,
-	0xff, 0x58, 0xf0, 0x70, 0x7f, 0xb3, 0x00, 0xe7, 0xd4, 0xe3, 0xd3, 0x64, 0x9b, 0x34, 0xd3, 0xf5,
-	0xc1, 0x9e, 0x2a, 0xf1, 0xd4, 0x4f, 0x7b, 0x4f, 0x95, 0xa4, 0x2c, 0xd2, 0xb5, 0x90, 0xc2, 0xb0,
-	0xc6, 0x13, 0x55, 0xe0, 0x0c, 0x7b, 0x5a, 0x64, 0xde, 0x0f, 0xbc, 0xa6, 0xff, 0x0a, 0x89, 0x30,
-	0x69, 0x85, 0xdb, 0x5e, 0x53, 0x68, 0xea, 0xea, 0x3e, 0xfa, 0x7c, 0x1e, 0x12, 0xce, 0xaf, 0xdb,
-	0x65, 0x46, 0xe8, 0xdb, 0xaf, 0x19, 0xc1, 0xfd, 0x13, 0x07, 0x46, 0x8e, 0xf1, 0xa9, 0xee, 0xd0,
-	0x5c, 0x12, 0xcf, 0xda, 0x5b, 0x12, 0x3d, 0x96, 0xc1, 0x6e, 0x11, 0xba, 0x5e, 0x2f, 0x46, 0x9f,
-	0x75, 0x54, 0x90, 0x12, 0x0f, 0x06, 0xfd, 0x88, 0xbd, 0x76, 0x1c, 0x24, 0xe7, 0x29, 0xfa, 0x46,
-	0xc6, 0x1e, 0x50, 0xb0, 0x95, 0x9e, 0xac, 0xab, 0x35, 0x87, 0x48, 0x08, 0xfb, 0x55, 0x07, 0x80,
-	0xb7, 0x53, 0xe4, 0x91, 0xa7, 0x6d, 0xdb, 0x38, 0xb2, 0x91, 0xa2, 0x4c, 0x78, 0xd3, 0xd4, 0x12,
-	0x4a, 0x0b, 0xb0, 0xd6, 0x92, 0xbb, 0xc8, 0xf4, 0x7a, 0xd7, 0x49, 0x66, 0xbf, 0xe8, 0xc0, 0x89,
-	0x4c, 0x73, 0x73, 0xea, 0x6f, 0x9a, 0x8f, 0x6d, 0x5a, 0xd0, 0xac, 0xcc, 0xec, 0xe2, 0xba, 0xf1,
-	0xe4, 0x5f, 0xb9, 0x60, 0x3c, 0xfb, 0x8e, 0x5e, 0x85, 0x61, 0x69, 0xf9, 0x90, 0xd3, 0xdb, 0xe6,
-	0xa3, 0xc3, 0xea, 0x78, 0x23, 0x21, 0x31, 0x4e, 0xf9, 0x65, 0x62, 0x20, 0x0b, 0xfb, 0x8a, 0x81,
-	0xbc, 0xb7, 0x4f, 0x16, 0xe7, 0x1b, 0xdb, 0xfb, 0x8f, 0xc4, 0xd8, 0xfe, 0xa0, 0x75, 0x63, 0xfb,
-	0x43, 0xc7, 0x6c, 0x6c, 0xd7, 0xfc, 0x99, 0xc5, 0xbb, 0xf0, 0x67, 0xbe, 0x0a, 0xa7, 0xb7, 0xd3,
-	0x43, 0xa7, 0x9a, 0x49, 0x22, 0x29, 0xd6, 0x13, 0xb9, 0x26, 0x76, 0x7a, 0x80, 0x8e, 0x13, 0x12,
-	0x24, 0xda, 0x71, 0x35, 0x0d, 0xbf, 0xbc, 0x96, 0x43, 0x0e, 0xe7, 0x32, 0xc9, 0x3a, 0xa6, 0x06,
-	0xf7, 0xe1, 0x98, 0xfa, 0xae, 0x03, 0x67, 0xbc, 0xae, 0x0b, 0x8c, 0x98, 0x6c, 0x8a, 0xe8, 0x98,
-	0xeb, 0xf6, 0x54, 0x08, 0x83, 0xbc, 0xf0, 0x00, 0xe6, 0x15, 0xe1, 0xfc, 0x06, 0xa1, 0x47, 0xd3,
-	0x28, 0x01, 0x1e, 0xb4, 0x9b, 0xef, 0xd2, 0xff, 0x46, 0x36, 0xf4, 0x08, 0xd8, 0xd0, 0x7f, 0xcc,
-	0xee, 0x69, 0xdb, 0x42, 0xf8, 0x51, 0xe9, 0x2e, 0xc2, 0x8f, 0x32, 0x5e, 0xc2, 0x11, 0x4b, 0x5e,
-	0xc2, 0x00, 0xc6, 0xfd, 0x96, 0x57, 0x27, 0x6b, 0x9d, 0x66, 0x93, 0xdf, 0x48, 0x92, 0xcf, 0x42,
-	0xe7, 0x5a, 0xf0, 0x96, 0xc3, 0xaa, 0xd7, 0x14, 0x39, 0x3f, 0x54, 0xc0, 0xb2, 0xba, 0x79, 0xb5,
-	0x98, 0xa1, 0x84, 0xbb, 0x68, 0xd3, 0x09, 0xcb, 0xb2, 0x33, 0x92, 0x84, 0x8e, 0x36, 0x8b, 0x71,
-	0x19, 0xe2, 0x13, 0xf6, 0x72, 0x0a, 0xc6, 0x3a, 0x0e, 0x5a, 0x82, 0xe1, 0x5a, 0x10, 0x8b, 0xbb,
-	0xd8, 0x27, 0x98, 0x30, 0x7b, 0x17, 0x15, 0x81, 0x73, 0x57, 0x2a, 0xea, 0x16, 0xf6, 0x83, 0x39,
-	0xe9, 0x46, 0x55, 0x39, 0x4e, 0xeb, 0xa3, 0x15, 0x46, 0x4c, 0x3c, 0x78, 0xc7, 0x43, 0x4f, 0x1e,
-	0xee, 0xe1, 0x05, 0x9b, 0xbb, 0x22, 0x9f, 0xec, 0x1b, 0x15, 0xec, 0xc4, 0xcb, 0x75, 0x29, 0x05,
-	0xed, 0x79, 0xee, 0x93, 0x7b, 0x3e, 0xcf, 0xcd, 0xf2, 0x0c, 0x27, 0x4d, 0xe5, 0xc9, 0x3e, 0x6f,
-	0x2d, 0xcf, 0x70, 0x1a, 0xd4, 0x29, 0xf2, 0x0c, 0xa7, 0x00, 0xac, 0xb3, 0x44, 0xab, 0xbd, 0x3c,
-	0xfa, 0xa7, 0x98, 0xd0, 0x38, 0xb8, 0x7f, 0x5e, 0x0f, 0xfd, 0x3e, 0xbd, 0x57, 0xe8, 0x77, 0xb7,
-	0x2b, 0xfa, 0xcc, 0x01, 0x5c, 0xd1, 0x0d, 0x96, 0x01, 0x76, 0x61, 0x56, 0x78, 0xff, 0x2d, 0x9c,
-	0xef, 0x58, 0xce, 0x19, 0x1e, 0x24, 0xcb, 0xfe, 0xc5, 0x9c, 0x41, 0xcf, 0xe8, 0xf8, 0x73, 0x87,
-	0x8e, 0x8e, 0xcf, 0xf8, 0x73, 0xef, 0x3f, 0x32, 0x7f, 0xee, 0xc4, 0x31, 0xf8, 0x73, 0x1f, 0xd8,
-	0xb7, 0x3f, 0xf7, 0x26, 0x9c, 0x6a, 0x87, 0xb5, 0x39, 0x3f, 0x8e, 0x3a, 0xec, 0xbe, 0xe5, 0x4c,
-	0xa7, 0x56, 0x27, 0x09, 0x73, 0x08, 0x97, 0x2e, 0xbe, 0x4b, 0x6f, 0x64, 0x9b, 0xad, 0x4a, 0xb9,
-	0xe0, 0x32, 0x15, 0x98, 0x1d, 0x84, 0x45, 0xfb, 0xe6, 0x14, 0xe2, 0x3c, 0x16, 0xba, 0x27, 0xf9,
-	0xe1, 0xe3, 0xf1, 0x24, 0x7f, 0x08, 0x86, 0xe2, 0x46, 0x27, 0xa9, 0x85, 0x37, 0x02, 0x16, 0x2e,
-	0x30, 0x3c, 0xf3, 0x0e, 0x65, 0x97, 0x16, 0xf0, 0xdb, 0xbb, 0x93, 0xe3, 0xf2, 0x7f, 0xcd, 0x24,
-	0x2d, 0x20, 0xe8, 0x9b, 0x3d, 0x6e, 0x56, 0xb9, 0x47, 0x79, 0xb3, 0xea, 0xdc, 0x81, 0x6e, 0x55,
-	0xe5, 0xb9, 0xcb, 0x1f, 0x79, 0xdb, 0xb9, 0xcb, 0xbf, 0xee, 0xc0, 0xe8, 0xb6, 0x6e, 0xff, 0x17,
-	0x2e, 0x7d, 0x0b, 0x01, 0x43, 0x86, 0x5b, 0x61, 0xc6, 0xa5, 0x42, 0xcb, 0x00, 0xdd, 0xce, 0x02,
-	0xb0, 0xd9, 0x92, 0x9c, 0x60, 0xa6, 0x47, 0xef, 0x55, 0x30, 0xd3, 0xeb, 0x50, 0x6a, 0x87, 0x35,
-	0x79, 0x62, 0x65, 0x7e, 0x7e, 0xbb, 0xb1, 0xcc, 0x5c, 0xff, 0x4c, 0x59, 0x60, 0x9d, 0x1f, 0xfa,
-	0x82, 0x03, 0xe3, 0xf2, 0x90, 0x25, 0xfc, 0x77, 0xb1, 0x88, 0xc6, 0xb4, 0x79, 0xb6, 0x63, 0xe1,
-	0xfc, 0xeb, 0x19, 0x3e, 0xb8, 0x8b, 0x33, 0x55, 0x48, 0x54, 0xf0, 0x5b, 0x3d, 0x66, 0x41, 0xc7,
-	0x42, 0x21, 0x99, 0x4e, 0xc1, 0x58, 0xc7, 0x41, 0xdf, 0x72, 0xa0, 0xd8, 0x08, 0xc3, 0xad, 0xb8,
-	0xfc, 0x04, 0x13, 0xe8, 0xcf, 0x5b, 0x56, 0x34, 0x2f, 0x53, 0xda, 0x5c, 0xc3, 0x7c, 0x4a, 0x1a,
-	0x82, 0x18, 0xec, 0xf6, 0xee, 0xe4, 0x98, 0xf1, 0x48, 0x56, 0xfc, 0xc6, 0x5b, 0x1a, 0x44, 0x18,
-	0x2a, 0x59, 0xd3, 0xd0, 0x97, 0x1d, 0x18, 0xbf, 0x91, 0xb1, 0x4e, 0x88, 0x70, 0x54, 0x6c, 0xdf,
-	0xee, 0xc1, 0x87, 0x3b, 0x0b, 0xc5, 0x5d, 0x2d, 0x40, 0x9f, 0x37, 0xad, 0x96, 0x3c, 0x6e, 0xd5,
-	0xe2, 0x00, 0x66, 0xac, 0xa4, 0xfc, 0x3a, 0x52, 0xbe, 0xf9, 0xf2, 0xee, 0x83, 0x45, 0x68, 0x67,
-	0xd2, 0x8f, 0x95, 0x53, 0x95, 0x98, 0xc6, 0x13, 0x0b, 0x8b, 0xdd, 0xf8, 0xfc, 0xba, 0xed, 0xe4,
-	0xcb, 0x67, 0x61, 0xcc, 0x74, 0xd4, 0xa1, 0xf7, 0x98, 0x2f, 0x9a, 0x9c, 0xcf, 0x3e, 0x0e, 0x31,
-	0x2a, 0xf1, 0x8d, 0x07, 0x22, 0x8c, 0x17, 0x1c, 0x0a, 0x47, 0xfa, 0x82, 0x43, 0xdf, 0xf1, 0xbc,
-	0xe0, 0x30, 0x7e, 0x14, 0x2f, 0x38, 0x9c, 0x3c, 0xd0, 0x0b, 0x0e, 0xda, 0x0b, 0x1a, 0xfd, 0x77,
-	0x78, 0x41, 0x63, 0x1a, 0x4e, 0xc8, 0x3b, 0x47, 0x44, 0x24, 0xc9, 0xe7, 0x3e, 0x7c, 0xf5, 0x76,
-	0xfb, 0xac, 0x59, 0x8c, 0xb3, 0xf8, 0x74, 0x91, 0x15, 0x03, 0x56, 0x73, 0xc0, 0x56, 0x50, 0x96,
-	0x39, 0xb5, 0xd8, 0x59, 0x58, 0x88, 0x28, 0x19, 0x65, 0x5d, 0x64, 0xb0, 0xdb, 0xf2, 0x1f, 0xcc,
-	0x5b, 0x80, 0x5e, 0x84, 0x72, 0xb8, 0xb9, 0xd9, 0x0c, 0xbd, 0x5a, 0xfa, 0xcc, 0x84, 0x0c, 0x32,
-	0xe0, 0xb7, 0x6a, 0x55, 0x56, 0xe2, 0xd5, 0x1e, 0x78, 0xb8, 0x27, 0x05, 0xf4, 0x5d, 0xaa, 0x98,
-	0x24, 0x61, 0x44, 0x6a, 0xa9, 0xe1, 0x65, 0x98, 0xf5, 0x99, 0x58, 0xef, 0x73, 0xc5, 0xe4, 0xc3,
-	0x7b, 0xaf, 0x3e, 0x4a, 0xa6, 0x14, 0x67, 0x9b, 0x85, 0x22, 0x38, 0xdb, 0xce, 0xb3, 0xfb, 0xc4,
-	0xe2, 0xa6, 0xd4, 0x5e, 0xd6, 0x27, 0xf5, 0x42, 0x79, 0xae, 0xe5, 0x28, 0xc6, 0x3d, 0x28, 0xeb,
-	0x4f, 0x41, 0x0c, 0x1d, 0xcf, 0x53, 0x10, 0x9f, 0x00, 0xa8, 0xca, 0xa4, 0x74, 0xd2, 0x92, 0xb0,
-	0x64, 0xe5, 0x0a, 0x0f, 0xa7, 0xa9, 0x3d, 0xd6, 0xab, 0xd8, 0x60, 0x8d, 0x25, 0xfa, 0xbf, 0xb9,
-	0x6f, 0xa5, 0x70, 0x73, 0x49, 0xdd, 0xfa, 0x9c, 0x78, 0xdb, 0xbd, 0x97, 0xf2, 0x4f, 0x1d, 0x98,
-	0xe0, 0x33, 0x2f, 0xab, 0xdc, 0x53, 0xd5, 0x42, 0xdc, 0x29, 0xb2, 0x1d, 0x87, 0xc2, 0x93, 0x4b,
-	0x19, 0x5c, 0x99, 0xd7, 0x7a, 0x8f, 0x96, 0xa0, 0xaf, 0xe6, 0x1c, 0x29, 0x4e, 0xd8, 0x32, 0x40,
-	0xe6, 0xbf, 0x78, 0x71, 0xea, 0xd6, 0x7e, 0x4e, 0x11, 0xff, 0xbc, 0xa7, 0x7d, 0x14, 0xb1, 0xe6,
-	0xfd, 0xe2, 0x11, 0xd9, 0x47, 0xf5, 0x67, 0x39, 0x0e, 0x64, 0x25, 0xfd, 0xa2, 0x03, 0xe3, 0x5e,
-	0x26, 0x6e, 0x84, 0x19, 0x75, 0xac, 0x18, 0x98, 0xa6, 0xa3, 0x34, 0x18, 0x85, 0x29, 0x79, 0xd9,
-	0x10, 0x15, 0xdc, 0xc5, 0x1c, 0xfd, 0xc8, 0x81, 0x07, 0x12, 0x2f, 0xde, 0xe2, 0x49, 0xaf, 0xe3,
-	0xf4, 0x8e, 0xb0, 0x68, 0xdc, 0x69, 0xb6, 0x1a, 0x5f, 0xb6, 0xbe, 0x1a, 0xd7, 0x7b, 0xf3, 0xe4,
-	0xeb, 0xf2, 0x11, 0xb1, 0x2e, 0x1f, 0xd8, 0x03, 0x13, 0xef, 0xd5, 0xf4, 0x89, 0xcf, 0x3a, 0xfc,
-	0x71, 0xb4, 0x9e, 0x2a, 0xdf, 0x86, 0xa9, 0xf2, 0x2d, 0xdb, 0x7c, 0x9e, 0x49, 0xd7, 0x3d, 0x7f,
-	0xc5, 0x81, 0xd3, 0x79, 0x3b, 0x52, 0x4e, 0x93, 0x3e, 0x66, 0x36, 0xc9, 0xe2, 0x29, 0x4b, 0x6f,
-	0x90, 0x95, 0xd7, 0x61, 0x26, 0xae, 0xc0, 0xc3, 0x77, 0xfa, 0x8a, 0x77, 0xa2, 0x37, 0xa4, 0xab,
-	0xc5, 0x7f, 0x3e, 0xac, 0xb9, 0x14, 0x13, 0xd2, 0xb6, 0x1e, 0x90, 0x1d, 0xc0, 0x80, 0x1f, 0x34,
-	0xfd, 0x80, 0x88, 0x7b, 0xa2, 0x36, 0xcf, 0xb0, 0xe2, 0x75, 0x27, 0x4a, 0x1d, 0x0b, 0x2e, 0xf7,
-	0xd8, 0xc3, 0x98, 0x7d, 0x2f, 0xaf, 0xff, 0xf8, 0xdf, 0xcb, 0xbb, 0x01, 0xc3, 0x37, 0xfc, 0xa4,
-	0xc1, 0x22, 0x23, 0x84, 0xe3, 0xce, 0xc2, 0xfd, 0x4a, 0x4a, 0x2e, 0xed, 0xfb, 0x75, 0xc9, 0x00,
-	0xa7, 0xbc, 0xd0, 0x05, 0xce, 0x98, 0x85, 0x61, 0x67, 0xe3, 0x63, 0xaf, 0xcb, 0x02, 0x9c, 0xe2,
-	0xd0, 0xc1, 0x1a, 0xa1, 0xbf, 0x64, 0xb6, 0x2a, 0x91, 0x40, 0xda, 0x46, 0x62, 0x50, 0x41, 0x91,
-	0xdf, 0x62, 0xbe, 0xae, 0xf1, 0xc0, 0x06, 0x47, 0x95, 0xc3, 0x7b, 0xa8, 0x67, 0x0e, 0xef, 0xd7,
-	0x98, 0xc2, 0x96, 0xf8, 0x41, 0x87, 0xac, 0x06, 0x22, 0x78, 0x7b, 0xd9, 0xce, 0x9d, 0x6b, 0x4e,
-	0x93, 0x1f, 0xc1, 0xd3, 0xdf, 0x58, 0xe3, 0xa7, 0xf9, 0x4f, 0x4a, 0x7b, 0xfa, 0x4f, 0x52, 0x93,
-	0xcb, 0x88, 0x75, 0x93, 0x4b, 0x42, 0xda, 0x56, 0x4c, 0x2e, 0x6f, 0x2b, 0x73, 0xc0, 0x5f, 0x38,
-	0x80, 0x94, 0xde, 0xa5, 0x04, 0xea, 0x31, 0x44, 0x48, 0x7e, 0xd2, 0x01, 0x08, 0xd4, 0xab, 0xaa,
-	0x76, 0x77, 0x41, 0x4e, 0x33, 0x6d, 0x40, 0x0a, 0xc3, 0x1a, 0x4f, 0xf7, 0xcf, 0x9c, 0x34, 0x10,
-	0x39, 0xed, 0xfb, 0x31, 0x44, 0x84, 0xed, 0x98, 0x11, 0x61, 0xeb, 0x16, 0x4d, 0xf7, 0xaa, 0x1b,
-	0x3d, 0x62, 0xc3, 0x7e, 0x5a, 0x80, 0x13, 0x3a, 0x72, 0x85, 0x1c, 0xc7, 0xc7, 0xbe, 0x61, 0x84,
-	0xc3, 0x5e, 0xb5, 0xdb, 0xdf, 0x8a, 0xf0, 0x00, 0xe5, 0x85, 0x5e, 0x7f, 0x22, 0x13, 0x7a, 0x7d,
-	0xdd, 0x3e, 0xeb, 0xbd, 0xe3, 0xaf, 0xff, 0xbb, 0x03, 0xa7, 0x32, 0x35, 0x8e, 0x61, 0x82, 0x6d,
-	0x9b, 0x13, 0xec, 0x39, 0xeb, 0xbd, 0xee, 0x31, 0xbb, 0xbe, 0x5d, 0xe8, 0xea, 0x2d, 0x3b, 0xc4,
-	0x7d, 0xc6, 0x81, 0x22, 0xd5, 0x96, 0x65, 0x70, 0xd6, 0xc7, 0x8e, 0x64, 0x06, 0x30, 0xbd, 0x5e,
-	0x48, 0x67, 0xd5, 0x3e, 0x06, 0xc3, 0x9c, 0xfb, 0xc4, 0xa7, 0x1d, 0x80, 0x14, 0xe9, 0x5e, 0xa9,
-	0xc0, 0xee, 0xf7, 0x0a, 0x70, 0x26, 0x77, 0x1a, 0xa1, 0xcf, 0x29, 0x8b, 0x9c, 0x63, 0x3b, 0xf4,
-	0xd0, 0x60, 0xa4, 0x1b, 0xe6, 0x46, 0x0d, 0xc3, 0x9c, 0xb0, 0xc7, 0xdd, 0xab, 0x03, 0x8c, 0x10,
-	0xd3, 0xda, 0x60, 0xfd, 0xc4, 0x49, 0xa3, 0x59, 0x55, 0x3e, 0xa5, 0xbf, 0x82, 0x37, 0x72, 0xdc,
-	0x9f, 0x6a, 0xd7, 0x15, 0x64, 0x47, 0x8f, 0x41, 0x56, 0xdc, 0x30, 0x65, 0x05, 0xb6, 0xef, 0x47,
-	0xee, 0x21, 0x2c, 0x5e, 0x86, 0x3c, 0xc7, 0xf2, 0xfe, 0xd2, 0x55, 0x1a, 0x77, 0x5b, 0x0b, 0xfb,
-	0xbe, 0xdb, 0x3a, 0x0a, 0xa5, 0x17, 0x7c, 0x95, 0xea, 0x74, 0x66, 0xea, 0xfb, 0x3f, 0x3e, 0x7f,
-	0xdf, 0x0f, 0x7e, 0x7c, 0xfe, 0xbe, 0x1f, 0xfd, 0xf8, 0xfc, 0x7d, 0x9f, 0xbc, 0x75, 0xde, 0xf9,
-	0xfe, 0xad, 0xf3, 0xce, 0x0f, 0x6e, 0x9d, 0x77, 0x7e, 0x74, 0xeb, 0xbc, 0xf3, 0x9f, 0x6e, 0x9d,
-	0x77, 0xfe, 0xc1, 0x9f, 0x9e, 0xbf, 0xef, 0x85, 0x21, 0xd9, 0xb1, 0xbf, 0x0c, 0x00, 0x00, 0xff,
-	0xff, 0xb4, 0xf0, 0xf7, 0x20, 0x4a, 0xda, 0x00, 0x00,
+	// 11565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x69, 0x70, 0x24, 0xc9,
+	0x75, 0x18, 0xbc, 0xd5, 0xb8, 0x13, 0xe7, 0xd4, 0x5c, 0xb5, 0xd8, 0xdd, 0xc1, 0xa8, 0xf6, 0xd0,
+	0xae, 0xb4, 0xc4, 0x68, 0x67, 0xa9, 0xef, 0x5b, 0x93, 0x36, 0x45, 0x1c, 0x03, 0xcc, 0xec, 0x1c,
+	0xc0, 0xbe, 0xc6, 0xec, 0x68, 0x77, 0x29, 0x92, 0x85, 0xee, 0x04, 0xba, 0x88, 0xee, 0xaa, 0xde,
+	0xaa, 0x6a, 0x60, 0xb0, 0x07, 0x49, 0x2f, 0xc5, 0x4b, 0xa2, 0x44, 0x8b, 0x26, 0x69, 0x92, 0xb2,
+	0x23, 0x68, 0x9a, 0xb4, 0x19, 0x92, 0xc2, 0x0e, 0xe9, 0x97, 0x2d, 0xfd, 0xf3, 0x0f, 0x05, 0x1d,
+	0x76, 0xd8, 0x54, 0x98, 0x0e, 0xf1, 0x87, 0x34, 0x6b, 0x8e, 0x6c, 0x85, 0x43, 0x0e, 0xfe, 0x10,
+	0xc3, 0xb2, 0xad, 0xf1, 0x11, 0x8e, 0x97, 0x57, 0x65, 0x56, 0x57, 0x63, 0x00, 0x4c, 0x62, 0x96,
+	0x21, 0xfd, 0x02, 0xfa, 0xe5, 0xcb, 0xf7, 0x32, 0xb3, 0xf2, 0x78, 0xf9, 0xae, 0x24, 0xab, 0x9b,
+	0x61, 0xd6, 0xe8, 0xac, 0xcf, 0xd6, 0xe2, 0xd6, 0xb9, 0x20, 0xd9, 0x8c, 0xdb, 0x49, 0xfc, 0x11,
+	0xf6, 0xcf, 0xbb, 0x76, 0xe2, 0x64, 0x6b, 0xa3, 0x19, 0xef, 0xa4, 0xe7, 0xb6, 0x9f, 0x3d, 0xd7,
+	0xde, 0xda, 0x3c, 0x17, 0xb4, 0xc3, 0xf4, 0x9c, 0x84, 0x9e, 0xdb, 0x7e, 0x26, 0x68, 0xb6, 0x1b,
+	0xc1, 0x33, 0xe7, 0x36, 0x69, 0x44, 0x93, 0x20, 0xa3, 0xf5, 0xd9, 0x76, 0x12, 0x67, 0xb1, 0xfb,
+	0xfe, 0x9c, 0xe2, 0xac, 0xa4, 0xc8, 0xfe, 0xf9, 0x90, 0xa2, 0x38, 0xbb, 0xfd, 0xec, 0x6c, 0x7b,
+	0x6b, 0x73, 0x16, 0x29, 0xce, 0x4a, 0xe8, 0xac, 0xa4, 0x38, 0xfd, 0x2e, 0xad, 0x4d, 0x9b, 0xf1,
+	0x66, 0x7c, 0x8e, 0x11, 0x5e, 0xef, 0x6c, 0xb0, 0x5f, 0xec, 0x07, 0xfb, 0x8f, 0x33, 0x9c, 0xf6,
+	0xb7, 0x9e, 0x4b, 0x67, 0xc3, 0x18, 0xdb, 0x77, 0xae, 0x16, 0x27, 0xf4, 0xdc, 0x76, 0x57, 0xa3,
+	0xa6, 0x1f, 0xd3, 0x70, 0xda, 0x71, 0x33, 0xac, 0xed, 0x96, 0x61, 0xbd, 0x3b, 0xc7, 0x6a, 0x05,
+	0xb5, 0x46, 0x18, 0xd1, 0x64, 0x37, 0xef, 0x7a, 0x8b, 0x66, 0x41, 0x59, 0xad, 0x73, 0xbd, 0x6a,
+	0x25, 0x9d, 0x28, 0x0b, 0x5b, 0xb4, 0xab, 0xc2, 0xff, 0x77, 0xb7, 0x0a, 0x69, 0xad, 0x41, 0x5b,
+	0x41, 0x57, 0xbd, 0x67, 0x7b, 0xd5, 0xeb, 0x64, 0x61, 0xf3, 0x5c, 0x18, 0x65, 0x69, 0x96, 0x14,
+	0x2b, 0xf9, 0x17, 0xc8, 0xe0, 0x5c, 0x2b, 0xee, 0x44, 0x99, 0xfb, 0x5e, 0x32, 0xb0, 0x1d, 0x34,
+	0x3b, 0xd4, 0x73, 0xce, 0x3a, 0x4f, 0x8e, 0xcc, 0x3f, 0xfe, 0x9d, 0x5b, 0x33, 0x0f, 0xdc, 0xbe,
+	0x35, 0x33, 0xf0, 0x22, 0x02, 0xef, 0xdc, 0x9a, 0x39, 0x41, 0xa3, 0x5a, 0x5c, 0x0f, 0xa3, 0xcd,
+	0x73, 0x1f, 0x49, 0xe3, 0x68, 0xf6, 0x5a, 0xa7, 0xb5, 0x4e, 0x13, 0xe0, 0x75, 0xfc, 0x7f, 0x5f,
+	0x21, 0x93, 0x73, 0x49, 0xad, 0x11, 0x6e, 0xd3, 0x6a, 0x86, 0xf4, 0x37, 0x77, 0xdd, 0x06, 0xe9,
+	0xcb, 0x82, 0x84, 0x91, 0x1b, 0x3d, 0x7f, 0x75, 0xf6, 0x5e, 0xbf, 0xfb, 0xec, 0x5a, 0x90, 0x48,
+	0xda, 0xf3, 0x43, 0xb7, 0x6f, 0xcd, 0xf4, 0xad, 0x05, 0x09, 0x20, 0x0b, 0xb7, 0x49, 0xfa, 0xa3,
+	0x38, 0xa2, 0x5e, 0x85, 0xb1, 0xba, 0x76, 0xef, 0xac, 0xae, 0xc5, 0x91, 0xea, 0xc7, 0xfc, 0xf0,
+	0xed, 0x5b, 0x33, 0xfd, 0x08, 0x01, 0xc6, 0x05, 0xfb, 0xf5, 0x5a, 0xd8, 0xf6, 0xfa, 0x6c, 0xf5,
+	0xeb, 0xe5, 0xb0, 0x6d, 0xf6, 0xeb, 0xe5, 0xb0, 0x0d, 0xc8, 0xc2, 0xff, 0x6c, 0x85, 0x8c, 0xcc,
+	0x25, 0x9b, 0x9d, 0x16, 0x8d, 0xb2, 0xd4, 0xfd, 0x18, 0x21, 0xed, 0x20, 0x09, 0x5a, 0x34, 0xa3,
+	0x49, 0xea, 0x39, 0x67, 0xfb, 0x9e, 0x1c, 0x3d, 0x7f, 0xf9, 0xde, 0xd9, 0xaf, 0x4a, 0x9a, 0xf3,
+	0xae, 0xf8, 0xe4, 0x44, 0x81, 0x52, 0xd0, 0x58, 0xba, 0xaf, 0x93, 0x91, 0x20, 0xc9, 0xc2, 0x8d,
+	0xa0, 0x96, 0xa5, 0x5e, 0x85, 0xf1, 0x7f, 0xfe, 0xde, 0xf9, 0xcf, 0x09, 0x92, 0xf3, 0xc7, 0x04,
+	0xfb, 0x11, 0x09, 0x49, 0x21, 0xe7, 0xe7, 0xff, 0x6e, 0x3f, 0x19, 0x9d, 0x4b, 0xb2, 0xe5, 0x85,
+	0x6a, 0x16, 0x64, 0x9d, 0xd4, 0xfd, 0xd7, 0x0e, 0x39, 0x9e, 0xf2, 0x61, 0x0b, 0x69, 0xba, 0x9a,
+	0xc4, 0x35, 0x9a, 0xa6, 0xb4, 0x2e, 0xc6, 0x65, 0xc3, 0x4a, 0xbb, 0x24, 0xb3, 0xd9, 0x6a, 0x37,
+	0xa3, 0x0b, 0x51, 0x96, 0xec, 0xce, 0x3f, 0x23, 0xda, 0x7c, 0xbc, 0x04, 0xe3, 0xad, 0xb7, 0x67,
+	0x5c, 0xd9, 0x95, 0xe5, 0x05, 0x81, 0xb0, 0x0b, 0x65, 0xad, 0x76, 0xbf, 0xea, 0x90, 0xb1, 0x76,
+	0x5c, 0x4f, 0x81, 0xd6, 0xe2, 0x4e, 0x9b, 0xd6, 0xc5, 0xf0, 0x7e, 0xc8, 0x6e, 0x37, 0x56, 0x35,
+	0x0e, 0xbc, 0xfd, 0x27, 0x44, 0xfb, 0xc7, 0xf4, 0x22, 0x30, 0x9a, 0xe2, 0x3e, 0x47, 0xc6, 0xa2,
+	0x38, 0xab, 0xb6, 0x69, 0x2d, 0xdc, 0x08, 0x69, 0x9d, 0x4d, 0xfc, 0xe1, 0xbc, 0xe6, 0x35, 0xad,
+	0x0c, 0x0c, 0xcc, 0xe9, 0x25, 0xe2, 0xf5, 0x1a, 0x39, 0x77, 0x8a, 0xf4, 0x6d, 0xd1, 0x5d, 0xbe,
+	0xd9, 0x00, 0xfe, 0xeb, 0x9e, 0x90, 0x1b, 0x10, 0x2e, 0xe3, 0x61, 0xb1, 0xb3, 0xbc, 0xa7, 0xf2,
+	0x9c, 0x33, 0xfd, 0x73, 0xe4, 0x58, 0x57, 0xd3, 0x0f, 0x42, 0xc0, 0xff, 0xee, 0x20, 0x19, 0x96,
+	0x9f, 0xc2, 0x3d, 0x4b, 0xfa, 0xa3, 0xa0, 0x25, 0xf7, 0xb9, 0x31, 0xd1, 0x8f, 0xfe, 0x6b, 0x41,
+	0x0b, 0x57, 0x78, 0xd0, 0xa2, 0x88, 0xd1, 0x0e, 0xb2, 0x86, 0x57, 0x31, 0x31, 0x56, 0x83, 0xac,
+	0x01, 0xac, 0xc4, 0x7d, 0x98, 0xf4, 0xb7, 0xe2, 0x3a, 0x65, 0x63, 0x31, 0xc0, 0x77, 0x88, 0xab,
+	0x71, 0x9d, 0x02, 0x83, 0x62, 0xfd, 0x8d, 0x24, 0x6e, 0x79, 0xfd, 0x66, 0xfd, 0xa5, 0x24, 0x6e,
+	0x01, 0x2b, 0x71, 0xbf, 0xe2, 0x90, 0x29, 0x39, 0xb7, 0xaf, 0xc4, 0xb5, 0x20, 0x0b, 0xe3, 0xc8,
+	0x1b, 0x60, 0x3b, 0x0a, 0xd8, 0x5b, 0x52, 0x92, 0xf2, 0xbc, 0x27, 0x9a, 0x30, 0x55, 0x2c, 0x81,
+	0xae, 0x56, 0xb8, 0xe7, 0x09, 0xd9, 0x6c, 0xc6, 0xeb, 0x41, 0x13, 0x07, 0xc4, 0x1b, 0x64, 0x5d,
+	0x50, 0x3b, 0xc3, 0xb2, 0x2a, 0x01, 0x0d, 0xcb, 0xbd, 0x49, 0x86, 0x02, 0xbe, 0xfb, 0x7b, 0x43,
+	0xac, 0x13, 0x2f, 0xd8, 0xe8, 0x84, 0x71, 0x9c, 0xcc, 0x8f, 0xde, 0xbe, 0x35, 0x33, 0x24, 0x80,
+	0x20, 0xd9, 0xb9, 0x4f, 0x93, 0xe1, 0xb8, 0x8d, 0xed, 0x0e, 0x9a, 0xde, 0x30, 0x9b, 0x98, 0x53,
+	0xa2, 0xad, 0xc3, 0x2b, 0x02, 0x0e, 0x0a, 0xc3, 0x7d, 0x8a, 0x0c, 0xa5, 0x9d, 0x75, 0xfc, 0x8e,
+	0xde, 0x08, 0xeb, 0xd8, 0xa4, 0x40, 0x1e, 0xaa, 0x72, 0x30, 0xc8, 0x72, 0xf7, 0x67, 0xc9, 0x68,
+	0x42, 0x6b, 0x9d, 0x24, 0xa5, 0xf8, 0x61, 0x3d, 0xc2, 0x68, 0x1f, 0x17, 0xe8, 0xa3, 0x90, 0x17,
+	0x81, 0x8e, 0xe7, 0xbe, 0x8f, 0x4c, 0xe0, 0x07, 0xbe, 0x70, 0xb3, 0x9d, 0xd0, 0x34, 0xc5, 0xaf,
+	0x3a, 0xca, 0x18, 0x9d, 0x12, 0x35, 0x27, 0x96, 0x8c, 0x52, 0x28, 0x60, 0xbb, 0x6f, 0x10, 0x12,
+	0xa8, 0x3d, 0xc3, 0x1b, 0x63, 0x83, 0x79, 0xc5, 0xde, 0x8c, 0x58, 0x5e, 0x98, 0x9f, 0xc0, 0xef,
+	0x98, 0xff, 0x06, 0x8d, 0x1f, 0x8e, 0x4f, 0x9d, 0x36, 0x69, 0x46, 0xeb, 0xde, 0x38, 0xeb, 0xb0,
+	0x1a, 0x9f, 0x45, 0x0e, 0x06, 0x59, 0xee, 0xff, 0x7a, 0x85, 0x68, 0x54, 0xdc, 0x79, 0x32, 0x2c,
+	0xf6, 0x35, 0xb1, 0x24, 0xe7, 0x9f, 0x90, 0xdf, 0x41, 0x7e, 0xc1, 0x3b, 0xb7, 0x4a, 0xf7, 0x43,
+	0x55, 0xcf, 0x7d, 0x93, 0x8c, 0xb6, 0xe3, 0xfa, 0x55, 0x9a, 0x05, 0xf5, 0x20, 0x0b, 0xc4, 0x69,
+	0x6e, 0xe1, 0x84, 0x91, 0x14, 0xe7, 0x27, 0xf1, 0xd3, 0xad, 0xe6, 0x2c, 0x40, 0xe7, 0xe7, 0x3e,
+	0x4f, 0xdc, 0x94, 0x26, 0xdb, 0x61, 0x8d, 0xce, 0xd5, 0x6a, 0x28, 0x12, 0xb1, 0x05, 0xd0, 0xc7,
+	0x3a, 0x33, 0x2d, 0x3a, 0xe3, 0x56, 0xbb, 0x30, 0xa0, 0xa4, 0x96, 0xff, 0xbd, 0x0a, 0x99, 0xd0,
+	0xfa, 0xda, 0xa6, 0x35, 0xf7, 0xdb, 0x0e, 0x99, 0x54, 0xc7, 0xd9, 0xfc, 0xee, 0x35, 0x9c, 0x55,
+	0xfc, 0xb0, 0xa2, 0x36, 0xbf, 0x2f, 0xf2, 0x9a, 0x9d, 0x33, 0xf9, 0xf0, 0xbd, 0xfe, 0xb4, 0xe8,
+	0xc3, 0x64, 0xa1, 0x14, 0x8a, 0xcd, 0x9a, 0xfe, 0xb2, 0x43, 0x4e, 0x94, 0x91, 0x28, 0xd9, 0x73,
+	0x1b, 0xfa, 0x9e, 0x6b, 0x75, 0xf3, 0x42, 0xae, 0xd8, 0x19, 0x7d, 0x1f, 0xff, 0xbf, 0x15, 0x32,
+	0xa5, 0x4f, 0x21, 0x26, 0x09, 0xfc, 0x4b, 0x87, 0x9c, 0x94, 0x3d, 0x00, 0x9a, 0x76, 0x9a, 0x85,
+	0xe1, 0x6d, 0x59, 0x1d, 0x5e, 0x7e, 0x92, 0xce, 0x95, 0xf1, 0xe3, 0xc3, 0xfc, 0x88, 0x18, 0xe6,
+	0x93, 0xa5, 0x38, 0x50, 0xde, 0xd4, 0xe9, 0x6f, 0x3a, 0x64, 0xba, 0x37, 0xd1, 0x92, 0x81, 0x6f,
+	0x9b, 0x03, 0xff, 0xb2, 0xbd, 0x4e, 0x72, 0xf6, 0x6c, 0xf8, 0x59, 0x67, 0xf5, 0x0f, 0xf0, 0x5b,
+	0xc3, 0xa4, 0xeb, 0x0c, 0x71, 0x9f, 0x21, 0xa3, 0x62, 0x3b, 0xbe, 0x12, 0x6f, 0xa6, 0xac, 0x91,
+	0xc3, 0x7c, 0xad, 0xcd, 0xe5, 0x60, 0xd0, 0x71, 0xdc, 0x3a, 0xa9, 0xa4, 0xcf, 0x7a, 0x15, 0x5b,
+	0xdb, 0x5b, 0xf5, 0x59, 0x25, 0x45, 0x0e, 0xde, 0xbe, 0x35, 0x53, 0xa9, 0x3e, 0x0b, 0x95, 0xf4,
+	0x59, 0x94, 0xd4, 0x37, 0xc3, 0xcc, 0x9e, 0xa4, 0xbe, 0x1c, 0x66, 0x8a, 0x0f, 0x93, 0xd4, 0x97,
+	0xc3, 0x0c, 0x90, 0x05, 0xde, 0x40, 0x1a, 0x59, 0xd6, 0xf6, 0xfa, 0x6d, 0xdd, 0x40, 0x2e, 0xae,
+	0xad, 0xad, 0x2a, 0x5e, 0x4c, 0xbe, 0x40, 0x08, 0x30, 0x2e, 0xee, 0x67, 0x1c, 0x1c, 0x71, 0x5e,
+	0x18, 0x27, 0xbb, 0x42, 0x70, 0xb8, 0x6e, 0x6f, 0x0a, 0xc4, 0xc9, 0xae, 0x62, 0x2e, 0x3e, 0xa4,
+	0x2a, 0x00, 0x9d, 0x35, 0xeb, 0x78, 0x7d, 0x23, 0xf5, 0x06, 0xad, 0x75, 0x7c, 0x71, 0xa9, 0x5a,
+	0xe8, 0xf8, 0xe2, 0x52, 0x15, 0x18, 0x17, 0xfc, 0xa0, 0x49, 0xb0, 0xe3, 0x0d, 0xd9, 0xfa, 0xa0,
+	0x10, 0xec, 0x98, 0x1f, 0x14, 0x82, 0x1d, 0x40, 0x16, 0xc8, 0x29, 0x4e, 0x53, 0x6f, 0xd8, 0x16,
+	0xa7, 0x95, 0x6a, 0xd5, 0xe4, 0xb4, 0x52, 0xad, 0x02, 0xb2, 0x60, 0x93, 0xb4, 0x96, 0x7a, 0x23,
+	0xb6, 0x38, 0x2d, 0x2f, 0x14, 0x38, 0x2d, 0x2f, 0x54, 0x01, 0x59, 0xe0, 0x96, 0x11, 0xbc, 0xd6,
+	0x49, 0xb8, 0x30, 0x33, 0x7a, 0x7e, 0xc5, 0xc2, 0x7c, 0x41, 0x72, 0x8a, 0xdb, 0x08, 0xaa, 0x0b,
+	0x18, 0x08, 0x38, 0x23, 0xff, 0xf7, 0xfb, 0xf2, 0xed, 0x42, 0xee, 0xe7, 0xee, 0xaf, 0xb1, 0x83,
+	0x50, 0xec, 0x05, 0x42, 0xf4, 0x75, 0x8e, 0x4c, 0xf4, 0x3d, 0xce, 0x4f, 0x3c, 0x83, 0x1d, 0x14,
+	0xf9, 0xbb, 0x5f, 0x70, 0xba, 0xef, 0xb6, 0x81, 0xfd, 0xb3, 0x4c, 0x01, 0x52, 0x7e, 0x56, 0xec,
+	0x79, 0xe5, 0x9d, 0xfe, 0x8c, 0x43, 0x26, 0xcc, 0x0a, 0x25, 0xe7, 0xc0, 0x87, 0xcd, 0x73, 0xc0,
+	0xe2, 0x85, 0x5c, 0xdf, 0xf7, 0x3f, 0xeb, 0x90, 0x71, 0x09, 0x47, 0xf1, 0x38, 0x75, 0x6f, 0x92,
+	0x61, 0xd9, 0x52, 0xcf, 0xb1, 0xcd, 0x3a, 0x17, 0xe2, 0x55, 0x63, 0x14, 0x37, 0xff, 0xdb, 0x83,
+	0x44, 0xc9, 0x91, 0x40, 0xdb, 0x71, 0x1a, 0xb2, 0x9d, 0xe8, 0x10, 0xa7, 0x50, 0xa4, 0x9d, 0x42,
+	0x2f, 0xda, 0x3c, 0x85, 0xf2, 0x66, 0x19, 0xe7, 0xd1, 0x17, 0x0a, 0xfb, 0x36, 0x3f, 0x98, 0x3e,
+	0x74, 0x24, 0xfb, 0xb6, 0xd6, 0x84, 0xbd, 0x77, 0xf0, 0x6d, 0xb1, 0x83, 0xf3, 0xa3, 0xeb, 0xe7,
+	0xed, 0xee, 0xe0, 0x5a, 0x2b, 0x8a, 0x7b, 0x79, 0xc2, 0x77, 0x58, 0x7e, 0x76, 0xdd, 0xb0, 0xba,
+	0xc3, 0x6a, 0x5c, 0xcd, 0xbd, 0x36, 0xe1, 0x7b, 0xed, 0xa0, 0x2d, 0x9e, 0xcb, 0x0b, 0x3d, 0x79,
+	0xaa, 0x5d, 0xf7, 0x35, 0xb9, 0xeb, 0xf2, 0x53, 0xeb, 0x25, 0xcb, 0xbb, 0xae, 0xc6, 0xb7, 0x7b,
+	0xff, 0x7d, 0x95, 0x9c, 0xec, 0xc6, 0x03, 0xba, 0xe1, 0x9e, 0x23, 0x23, 0xb5, 0x38, 0xda, 0x08,
+	0x37, 0xaf, 0x06, 0x6d, 0x71, 0x5f, 0x53, 0x7b, 0xd1, 0x82, 0x2c, 0x80, 0x1c, 0xc7, 0x7d, 0x84,
+	0x6f, 0x3c, 0x5c, 0x23, 0x32, 0x2a, 0x50, 0xfb, 0x2e, 0xd3, 0x5d, 0xb6, 0x0b, 0xbd, 0x67, 0xf8,
+	0x2b, 0x5f, 0x9f, 0x79, 0xe0, 0xe3, 0x7f, 0x74, 0xf6, 0x01, 0xff, 0x0f, 0xfa, 0xc8, 0x43, 0xa5,
+	0x3c, 0x85, 0xb4, 0xfe, 0x5b, 0x86, 0xb4, 0xae, 0x95, 0x7b, 0x8e, 0xad, 0xaf, 0x52, 0xca, 0xbe,
+	0x4c, 0x2e, 0xd7, 0x8a, 0xe1, 0x64, 0xd0, 0x6b, 0xa0, 0x50, 0x25, 0x94, 0xb6, 0x83, 0x1a, 0xf5,
+	0x2a, 0xe6, 0x40, 0x5d, 0x93, 0x05, 0x90, 0xe3, 0xf0, 0x2b, 0xf4, 0x46, 0xd0, 0x69, 0x66, 0x5e,
+	0x5f, 0xf1, 0x0a, 0xcd, 0xc0, 0x20, 0xcb, 0xdd, 0xbf, 0xef, 0x10, 0xb7, 0x9b, 0xab, 0x58, 0x88,
+	0x6b, 0x47, 0x31, 0x0e, 0xf3, 0xa7, 0x6e, 0x6b, 0x97, 0x70, 0xad, 0xa7, 0x25, 0xed, 0xd0, 0xbe,
+	0xe9, 0x47, 0xc9, 0x84, 0x79, 0x39, 0xd8, 0x87, 0x0e, 0x8d, 0xa9, 0x5a, 0x6a, 0xa8, 0xf1, 0xf3,
+	0x2a, 0xe6, 0x38, 0x54, 0x39, 0x18, 0x64, 0xb9, 0x3b, 0x43, 0x06, 0x68, 0x92, 0xc4, 0x89, 0xb8,
+	0x6b, 0xb3, 0x69, 0x7c, 0x01, 0x01, 0xc0, 0xe1, 0xfe, 0x9f, 0x56, 0x88, 0xd7, 0xeb, 0x76, 0xe2,
+	0xfe, 0x8e, 0x76, 0xaf, 0xe6, 0x85, 0x52, 0x39, 0x1e, 0x1f, 0xdd, 0x9d, 0xa8, 0x50, 0x90, 0xf6,
+	0xb8, 0x61, 0x8b, 0x52, 0x28, 0x36, 0x70, 0xfa, 0x8b, 0xda, 0x0d, 0x5b, 0x27, 0x51, 0x72, 0xc0,
+	0x6f, 0x98, 0x07, 0xfc, 0xaa, 0xed, 0x4e, 0xe9, 0xc7, 0xfc, 0x1f, 0x0f, 0x90, 0xe3, 0xb2, 0xb4,
+	0x4a, 0xf1, 0xa8, 0x7c, 0xa1, 0x43, 0x93, 0x5d, 0xf7, 0x0f, 0x1d, 0x72, 0x22, 0x28, 0xaa, 0x6e,
+	0x42, 0x7a, 0x04, 0x03, 0xad, 0x71, 0x9d, 0x9d, 0x2b, 0xe1, 0xc8, 0x07, 0xfa, 0xbc, 0x18, 0xe8,
+	0x13, 0x65, 0x28, 0x3d, 0xf4, 0xee, 0xa5, 0x1d, 0x40, 0xe5, 0xb6, 0x84, 0x33, 0x75, 0x0f, 0x5f,
+	0xe2, 0x4a, 0xb9, 0x3d, 0xa7, 0x95, 0x81, 0x81, 0x89, 0x35, 0x33, 0xda, 0x6a, 0x37, 0x83, 0x8c,
+	0x6a, 0x8a, 0x22, 0x55, 0x73, 0x4d, 0x2b, 0x03, 0x03, 0xd3, 0x7d, 0x82, 0x0c, 0x46, 0x71, 0x9d,
+	0x5e, 0xaa, 0x0b, 0x05, 0xf1, 0x84, 0xa8, 0x33, 0x78, 0x8d, 0x41, 0x41, 0x94, 0xba, 0x8f, 0xe7,
+	0xda, 0xb8, 0x01, 0xb6, 0x84, 0x46, 0xcb, 0x34, 0x71, 0xee, 0x3f, 0x74, 0xc8, 0x08, 0xd6, 0x58,
+	0xdb, 0x6d, 0x53, 0x3c, 0xdb, 0xf0, 0x8b, 0xd4, 0x8f, 0xe6, 0x8b, 0x5c, 0x93, 0x6c, 0x4c, 0x55,
+	0xc7, 0x88, 0x82, 0xbf, 0xf5, 0xf6, 0xcc, 0xb0, 0xfc, 0x01, 0x79, 0xab, 0xa6, 0x97, 0xc9, 0x83,
+	0x3d, 0xbf, 0xe6, 0x81, 0x4c, 0x01, 0x7f, 0x93, 0x4c, 0x98, 0x8d, 0x38, 0x90, 0x1d, 0xe0, 0x9f,
+	0x6b, 0xcb, 0x8e, 0xf7, 0x4b, 0xec, 0x67, 0xef, 0x98, 0x34, 0xab, 0x26, 0xc3, 0xa2, 0x57, 0x29,
+	0x99, 0x0c, 0x8b, 0x62, 0x32, 0x2c, 0xfa, 0x68, 0xef, 0x2a, 0x11, 0xf3, 0xf0, 0x60, 0xee, 0x24,
+	0x4d, 0xcf, 0x31, 0x0f, 0xe6, 0xeb, 0x70, 0x05, 0x10, 0xee, 0x7e, 0x51, 0xdb, 0x1d, 0xb1, 0x5a,
+	0x47, 0x98, 0x35, 0x2c, 0xa9, 0xe8, 0x0d, 0xc2, 0xdd, 0xfb, 0x9f, 0x28, 0x80, 0x62, 0x13, 0xfc,
+	0x2f, 0x54, 0xc8, 0x23, 0x7b, 0x0a, 0xad, 0xa5, 0x0d, 0x77, 0xde, 0xf1, 0x86, 0xe3, 0xb1, 0x96,
+	0xd0, 0x76, 0x7c, 0x1d, 0xae, 0x88, 0xef, 0xa5, 0x8e, 0x35, 0xe0, 0x60, 0x90, 0xe5, 0x28, 0x3a,
+	0x6c, 0xd1, 0xdd, 0xa5, 0x38, 0x69, 0x05, 0x99, 0xd7, 0x67, 0x8a, 0x0e, 0x97, 0x65, 0x01, 0xe4,
+	0x38, 0xfe, 0x1f, 0x3a, 0xa4, 0xd8, 0x00, 0x37, 0x20, 0x13, 0x9d, 0x94, 0x26, 0x78, 0xa4, 0x56,
+	0x69, 0x2d, 0xa1, 0x72, 0x7a, 0x3e, 0x3e, 0xcb, 0xad, 0xfd, 0xd8, 0xc3, 0xd9, 0x5a, 0x9c, 0xd0,
+	0xd9, 0xed, 0x67, 0x66, 0x39, 0xc6, 0x65, 0xba, 0x5b, 0xa5, 0x4d, 0x8a, 0x34, 0xe6, 0x5d, 0x34,
+	0x39, 0x5c, 0x37, 0x08, 0x40, 0x81, 0x20, 0xb2, 0x68, 0x07, 0x69, 0xba, 0x13, 0x27, 0x75, 0xc1,
+	0xa2, 0x72, 0x60, 0x16, 0xab, 0x06, 0x01, 0x28, 0x10, 0xf4, 0xbf, 0x87, 0xd7, 0x47, 0x5d, 0x6a,
+	0x75, 0xbf, 0x8e, 0xb2, 0x0f, 0x42, 0xe6, 0x9b, 0xf1, 0xfa, 0x42, 0x1c, 0x65, 0x41, 0x18, 0x51,
+	0xe9, 0x2c, 0xb0, 0x66, 0x49, 0x46, 0x36, 0x68, 0xe7, 0x3a, 0xfc, 0xee, 0x32, 0x28, 0x69, 0x0b,
+	0xca, 0x38, 0xeb, 0xcd, 0x78, 0xbd, 0x68, 0x05, 0x44, 0x24, 0x60, 0x25, 0xfe, 0x8f, 0x1c, 0x72,
+	0xba, 0x87, 0x30, 0xee, 0x7e, 0xd9, 0x21, 0xe3, 0xeb, 0x3f, 0x16, 0x7d, 0x33, 0x9b, 0x81, 0x16,
+	0x2a, 0x04, 0xe0, 0x49, 0x24, 0xe6, 0x66, 0xc5, 0xb4, 0x50, 0xcd, 0x1b, 0xa5, 0x50, 0xc0, 0xf6,
+	0xff, 0x6e, 0x85, 0x94, 0x70, 0x41, 0x43, 0x1c, 0x8d, 0xea, 0xed, 0x38, 0x8c, 0x32, 0xb1, 0x19,
+	0xa9, 0x5d, 0xef, 0x82, 0x80, 0x83, 0xc2, 0x10, 0xf7, 0x0f, 0x31, 0x30, 0x95, 0xae, 0xfb, 0x87,
+	0x68, 0x79, 0x8e, 0xe3, 0x6e, 0x92, 0xa9, 0x80, 0xdb, 0x57, 0xd8, 0xdc, 0x63, 0xd3, 0xb4, 0xef,
+	0x20, 0xd3, 0xf4, 0x04, 0x33, 0x7f, 0x16, 0x48, 0x40, 0x17, 0x51, 0xb4, 0xfb, 0x75, 0x52, 0x5a,
+	0x5d, 0xbc, 0xbc, 0x90, 0xd0, 0x3a, 0xbf, 0x15, 0x6b, 0x76, 0xbf, 0xeb, 0x79, 0x11, 0xe8, 0x78,
+	0xfe, 0x9f, 0x38, 0x64, 0x68, 0x3e, 0xa8, 0x6d, 0xc5, 0x1b, 0x1b, 0x38, 0x14, 0xf5, 0x4e, 0x92,
+	0x2b, 0xb6, 0xb4, 0xa1, 0x58, 0x14, 0x70, 0x50, 0x18, 0xee, 0x1a, 0x19, 0xe4, 0x0b, 0x5e, 0x2c,
+	0xbb, 0x9f, 0xd1, 0xfa, 0xa3, 0xfc, 0x78, 0xd8, 0x74, 0x40, 0x3f, 0x9e, 0x59, 0xee, 0xc7, 0x33,
+	0x7b, 0x29, 0xca, 0x56, 0x92, 0x6a, 0x96, 0x84, 0xd1, 0xe6, 0x3c, 0xc1, 0xe3, 0x62, 0x89, 0xd1,
+	0x00, 0x41, 0x0b, 0xbb, 0xd1, 0x0a, 0x6e, 0x4a, 0x76, 0x62, 0xfb, 0x51, 0xdd, 0xb8, 0x9a, 0x17,
+	0x81, 0x8e, 0x87, 0xa7, 0x49, 0x2d, 0x68, 0x7b, 0xfd, 0xe6, 0x69, 0xb2, 0x10, 0xb4, 0x01, 0xe1,
+	0xfe, 0x1f, 0x38, 0x64, 0x64, 0x3e, 0x48, 0xc3, 0xda, 0x5f, 0xa1, 0xbd, 0xe9, 0x83, 0x64, 0x60,
+	0x21, 0xa8, 0x35, 0xa8, 0x7b, 0xbd, 0x78, 0x27, 0x1e, 0x3d, 0xff, 0x64, 0x19, 0x1b, 0x75, 0x3f,
+	0xd6, 0x39, 0x8d, 0xf7, 0xba, 0x39, 0xfb, 0x6f, 0x3b, 0x64, 0x62, 0xa1, 0x19, 0xd2, 0x28, 0x5b,
+	0xa0, 0x49, 0xc6, 0x06, 0x6e, 0x93, 0x4c, 0xd5, 0x14, 0xe4, 0x30, 0x43, 0xc7, 0x26, 0xf3, 0x42,
+	0x81, 0x04, 0x74, 0x11, 0x75, 0xeb, 0x64, 0x92, 0xc3, 0xf2, 0x45, 0x73, 0xa0, 0xf1, 0x63, 0xca,
+	0xd3, 0x05, 0x93, 0x02, 0x14, 0x49, 0xfa, 0x3f, 0x74, 0xc8, 0xe9, 0x85, 0x66, 0x27, 0xcd, 0x68,
+	0x72, 0x43, 0x6c, 0x56, 0x52, 0xfa, 0x75, 0x3f, 0x4c, 0x86, 0x5b, 0xd2, 0xa0, 0xeb, 0xdc, 0x65,
+	0x7e, 0xb3, 0xed, 0x0e, 0xb1, 0xb1, 0x31, 0x2b, 0xeb, 0x1f, 0xa1, 0xb5, 0x0c, 0x8d, 0xb3, 0xb9,
+	0xf7, 0x41, 0x0e, 0x03, 0x45, 0xd5, 0x6d, 0x93, 0xfe, 0xb4, 0x4d, 0x6b, 0xf6, 0x9c, 0xbf, 0x64,
+	0x1f, 0x50, 0x61, 0x9b, 0x6f, 0xfb, 0xf8, 0x0b, 0x18, 0x27, 0xff, 0x7f, 0x39, 0xe4, 0xa1, 0x1e,
+	0xfd, 0xbd, 0x12, 0xa6, 0x99, 0xfb, 0x81, 0xae, 0x3e, 0xcf, 0xee, 0xaf, 0xcf, 0x58, 0x9b, 0xf5,
+	0x58, 0xed, 0x17, 0x12, 0xa2, 0xf5, 0xf7, 0xa3, 0x64, 0x20, 0xcc, 0x68, 0x4b, 0x6a, 0xa9, 0x2d,
+	0xe8, 0x93, 0x7a, 0xf4, 0x65, 0x7e, 0x5c, 0xba, 0x00, 0x5e, 0x42, 0x7e, 0xc0, 0xd9, 0xfa, 0x5b,
+	0x64, 0x70, 0x21, 0x6e, 0x76, 0x5a, 0xd1, 0xfe, 0x1c, 0x69, 0xb2, 0xdd, 0x36, 0x2d, 0x1e, 0xa1,
+	0xec, 0x76, 0xc0, 0x4a, 0xa4, 0x5e, 0xa9, 0xaf, 0x5c, 0xaf, 0xe4, 0xff, 0x2b, 0x87, 0xe0, 0xaa,
+	0xaa, 0x87, 0xc2, 0xd0, 0xc8, 0xc9, 0x71, 0x86, 0x8f, 0xe8, 0xe4, 0xee, 0xdc, 0x9a, 0x19, 0x57,
+	0x88, 0x1a, 0xfd, 0x0f, 0x92, 0xc1, 0x94, 0xdd, 0xd8, 0x45, 0x1b, 0x96, 0xa4, 0x78, 0xcd, 0xef,
+	0xf1, 0x77, 0x6e, 0xcd, 0xec, 0xcb, 0xab, 0x73, 0x56, 0xd1, 0xe6, 0xf5, 0x40, 0x50, 0x45, 0x79,
+	0xb0, 0x45, 0xd3, 0x34, 0xd8, 0x94, 0x17, 0x40, 0x25, 0x0f, 0x5e, 0xe5, 0x60, 0x90, 0xe5, 0xfe,
+	0x97, 0x1c, 0x32, 0xae, 0xce, 0x36, 0x94, 0xee, 0xdd, 0x6b, 0xfa, 0x29, 0xc8, 0x67, 0xca, 0x23,
+	0x3d, 0x76, 0x1c, 0x71, 0xce, 0xef, 0x7d, 0x48, 0xbe, 0x9b, 0x8c, 0xd5, 0x69, 0x9b, 0x46, 0x75,
+	0x1a, 0xd5, 0x42, 0xca, 0x67, 0xc8, 0xc8, 0xfc, 0x14, 0x5e, 0x47, 0x17, 0x35, 0x38, 0x18, 0x58,
+	0xfe, 0x37, 0x1c, 0xf2, 0xa0, 0x22, 0x57, 0xa5, 0x19, 0xd0, 0x2c, 0xd9, 0x55, 0x5e, 0x9c, 0x07,
+	0x3b, 0xcc, 0x6e, 0xa0, 0x78, 0x9c, 0x25, 0x9c, 0xf9, 0xe1, 0x4e, 0xb3, 0x51, 0x2e, 0x4c, 0x33,
+	0x22, 0x20, 0xa9, 0xf9, 0xbf, 0xda, 0x47, 0x4e, 0xe8, 0x8d, 0x54, 0x1b, 0xcc, 0x27, 0x1c, 0x42,
+	0xd4, 0x08, 0xe0, 0x79, 0xdd, 0x67, 0xc7, 0xb4, 0x65, 0x7c, 0xa9, 0x7c, 0x0b, 0x52, 0xe0, 0x14,
+	0x34, 0xb6, 0xee, 0x4b, 0x64, 0x6c, 0x1b, 0x17, 0x05, 0xbd, 0x8a, 0xd2, 0x44, 0xea, 0xf5, 0xb1,
+	0x66, 0xcc, 0x94, 0x7d, 0xcc, 0x17, 0x73, 0xbc, 0x5c, 0x5b, 0xa0, 0x01, 0x53, 0x30, 0x48, 0xe1,
+	0x45, 0x68, 0x3c, 0xd1, 0x3f, 0x89, 0x50, 0x99, 0xbf, 0x62, 0xb1, 0x8f, 0xc5, 0xaf, 0x3e, 0x7f,
+	0xec, 0xf6, 0xad, 0x99, 0x71, 0x03, 0x04, 0x66, 0x23, 0xfc, 0x97, 0x08, 0x1b, 0x8b, 0x30, 0xea,
+	0xd0, 0x95, 0xc8, 0x7d, 0x54, 0xaa, 0xf0, 0xb8, 0xd9, 0x45, 0xed, 0x1c, 0xba, 0x1a, 0x0f, 0xaf,
+	0xba, 0x1b, 0x41, 0xd8, 0x64, 0xde, 0x8d, 0x88, 0xa5, 0xae, 0xba, 0x4b, 0x0c, 0x0a, 0xa2, 0xd4,
+	0x9f, 0x25, 0x43, 0x0b, 0xd8, 0x77, 0x9a, 0x20, 0x5d, 0xdd, 0x29, 0x79, 0xdc, 0x70, 0x4a, 0x96,
+	0xce, 0xc7, 0x6b, 0xe4, 0xe4, 0x42, 0x42, 0x83, 0x8c, 0x56, 0x9f, 0x9d, 0xef, 0xd4, 0xb6, 0x68,
+	0xc6, 0x3d, 0xbf, 0x52, 0xf7, 0xbd, 0x64, 0x3c, 0x66, 0x47, 0xc6, 0x95, 0xb8, 0xb6, 0x15, 0x46,
+	0x9b, 0x42, 0x23, 0x7b, 0x52, 0x50, 0x19, 0x5f, 0xd1, 0x0b, 0xc1, 0xc4, 0xf5, 0xff, 0x53, 0x85,
+	0x8c, 0x2d, 0x24, 0x71, 0x24, 0xb7, 0xc5, 0xfb, 0x70, 0x94, 0x65, 0xc6, 0x51, 0x66, 0xc1, 0x1a,
+	0xaa, 0xb7, 0xbf, 0xd7, 0x71, 0xe6, 0xbe, 0xa1, 0xb6, 0xc8, 0x3e, 0x5b, 0x37, 0x14, 0x83, 0x2f,
+	0xa3, 0x9d, 0x7f, 0x6c, 0x73, 0x03, 0xf5, 0xff, 0xb3, 0x43, 0xa6, 0x74, 0xf4, 0xfb, 0x70, 0x82,
+	0xa6, 0xe6, 0x09, 0x7a, 0xcd, 0x6e, 0x7f, 0x7b, 0x1c, 0x9b, 0x9f, 0x70, 0xcd, 0x7e, 0x32, 0x53,
+	0xf8, 0x57, 0x1c, 0x32, 0xb6, 0xa3, 0x01, 0x44, 0x67, 0x6d, 0x0b, 0x31, 0x8f, 0xc9, 0x6d, 0x46,
+	0x87, 0xde, 0x29, 0xfc, 0x06, 0xa3, 0x25, 0xb8, 0xef, 0x63, 0x9c, 0x41, 0xbd, 0xd3, 0x94, 0xc7,
+	0xb7, 0x1a, 0xd2, 0xaa, 0x80, 0x83, 0xc2, 0x70, 0x3f, 0x40, 0x8e, 0xd5, 0xe2, 0xa8, 0xd6, 0x49,
+	0x12, 0x1a, 0xd5, 0x76, 0x57, 0x59, 0x08, 0x85, 0x38, 0x10, 0x67, 0x45, 0xb5, 0x63, 0x0b, 0x45,
+	0x84, 0x3b, 0x65, 0x40, 0xe8, 0x26, 0xc4, 0x6d, 0x09, 0x29, 0x1e, 0x59, 0xe2, 0x3e, 0xa6, 0xd9,
+	0x12, 0x18, 0x18, 0x64, 0xb9, 0x7b, 0x9d, 0x9c, 0x4e, 0xb3, 0x20, 0xc9, 0xc2, 0x68, 0x73, 0x91,
+	0x06, 0xf5, 0x66, 0x18, 0xe1, 0x55, 0x22, 0x8e, 0xea, 0xdc, 0xd2, 0xd8, 0x37, 0xff, 0xd0, 0xed,
+	0x5b, 0x33, 0xa7, 0xab, 0xe5, 0x28, 0xd0, 0xab, 0xae, 0xfb, 0x41, 0x32, 0x2d, 0xac, 0x15, 0x1b,
+	0x9d, 0xe6, 0xf3, 0xf1, 0x7a, 0x7a, 0x31, 0x4c, 0xf1, 0x9a, 0x7f, 0x25, 0x6c, 0x85, 0x19, 0xb3,
+	0x27, 0x0e, 0xcc, 0x9f, 0xb9, 0x7d, 0x6b, 0x66, 0xba, 0xda, 0x13, 0x0b, 0xf6, 0xa0, 0xe0, 0x02,
+	0x39, 0xc5, 0x37, 0xbf, 0x2e, 0xda, 0x43, 0x8c, 0xf6, 0xf4, 0xed, 0x5b, 0x33, 0xa7, 0x96, 0x4a,
+	0x31, 0xa0, 0x47, 0x4d, 0xfc, 0x82, 0x59, 0xd8, 0xa2, 0xaf, 0x61, 0x64, 0xc4, 0xb0, 0xf9, 0x05,
+	0xd7, 0x04, 0x1c, 0x14, 0x86, 0xfb, 0x91, 0x7c, 0x26, 0xe2, 0x72, 0xf1, 0x46, 0x0e, 0xb9, 0xc3,
+	0xb1, 0xab, 0xc9, 0x0d, 0x8d, 0x12, 0x73, 0xb4, 0x34, 0x68, 0xbb, 0xbf, 0xe8, 0x90, 0xb1, 0x34,
+	0x8b, 0x55, 0xd8, 0x83, 0x47, 0x6c, 0x4d, 0xfb, 0xaa, 0x46, 0x95, 0x0b, 0x3e, 0x3a, 0x04, 0x0c,
+	0xae, 0xee, 0x4f, 0x93, 0x11, 0x39, 0x81, 0x53, 0x6f, 0x94, 0xc9, 0x4a, 0xec, 0x1a, 0x27, 0xe7,
+	0x77, 0x0a, 0x79, 0x39, 0x8a, 0xb2, 0x3b, 0x0d, 0x1a, 0x79, 0x63, 0xa6, 0x28, 0x7b, 0xa3, 0x41,
+	0x23, 0x60, 0x25, 0xee, 0x36, 0x71, 0xb9, 0x4a, 0x7e, 0x6e, 0x23, 0xa3, 0x09, 0xf2, 0x6d, 0x0b,
+	0x3f, 0xda, 0x7d, 0x6f, 0x5f, 0x52, 0xa2, 0xe2, 0x76, 0xba, 0xc5, 0x2e, 0x6a, 0x50, 0xc2, 0xc1,
+	0x05, 0x32, 0x8c, 0xfc, 0x17, 0x71, 0xb3, 0x9c, 0xe8, 0x7d, 0x6d, 0x45, 0x5f, 0x97, 0x26, 0xff,
+	0x50, 0x40, 0x37, 0x28, 0xae, 0x2f, 0x3a, 0x3f, 0x86, 0xb3, 0xe1, 0x86, 0xa8, 0x0d, 0x8a, 0x8e,
+	0xfb, 0x67, 0x0e, 0x99, 0x91, 0x7d, 0xef, 0xb1, 0x58, 0xbc, 0x49, 0xb6, 0x7b, 0xee, 0xd8, 0x3f,
+	0xa5, 0x66, 0xab, 0x7b, 0x73, 0xe6, 0xc6, 0x87, 0x9f, 0x14, 0x9f, 0x60, 0xe6, 0x2e, 0xd8, 0x70,
+	0xb7, 0x8e, 0xb8, 0x2b, 0xe4, 0x64, 0x50, 0xcb, 0xc2, 0x6d, 0x5a, 0xec, 0xe1, 0x14, 0xdb, 0x31,
+	0x1e, 0x64, 0x46, 0xe3, 0x32, 0x04, 0x28, 0xaf, 0x87, 0x86, 0x9b, 0x56, 0x70, 0x13, 0x3a, 0x51,
+	0xea, 0x1d, 0x63, 0x24, 0x98, 0x4c, 0x7b, 0x95, 0x83, 0x40, 0x96, 0xb9, 0x9f, 0x73, 0xc8, 0x84,
+	0x6c, 0xdb, 0x8d, 0x30, 0xaa, 0xc7, 0x3b, 0x9e, 0x6b, 0xcb, 0xc6, 0x57, 0x35, 0xe8, 0x72, 0x45,
+	0x88, 0x09, 0x83, 0x02, 0x6f, 0xf7, 0x0a, 0x19, 0x17, 0xbb, 0xa8, 0xd8, 0xbf, 0x8f, 0x1b, 0x7e,
+	0xdc, 0xe3, 0x55, 0xbd, 0xf0, 0x4e, 0x11, 0x00, 0x66, 0x65, 0xf7, 0x0a, 0x19, 0xdc, 0x48, 0x28,
+	0x7d, 0x8d, 0x7a, 0x27, 0x0e, 0xa8, 0x4a, 0xe1, 0xea, 0x2c, 0x56, 0x17, 0x04, 0x0d, 0xf7, 0x1b,
+	0x0e, 0xf1, 0x8a, 0x7b, 0xf3, 0x52, 0x82, 0xa3, 0x1f, 0x47, 0xde, 0x49, 0xc6, 0xe0, 0xa2, 0x05,
+	0xc5, 0x2a, 0x8b, 0x84, 0x9b, 0x7f, 0xf8, 0xf6, 0xad, 0x19, 0xaf, 0xda, 0x83, 0x1b, 0xf4, 0x6c,
+	0x07, 0x9e, 0x3d, 0x4c, 0xd0, 0x2d, 0xd9, 0xc5, 0x4f, 0xb1, 0x5d, 0x9c, 0x9d, 0x3d, 0x17, 0xca,
+	0x51, 0xa0, 0x57, 0x5d, 0xdc, 0x57, 0x12, 0xda, 0x6e, 0x06, 0x35, 0xba, 0x9c, 0x04, 0x35, 0xba,
+	0x4a, 0x93, 0x30, 0xae, 0x7b, 0xa7, 0x0f, 0xbf, 0xaf, 0x40, 0x17, 0x35, 0x28, 0xe1, 0x30, 0x0d,
+	0xe4, 0xb1, 0xfd, 0x2c, 0xc4, 0xbb, 0x19, 0xe0, 0xfa, 0x74, 0x03, 0xdc, 0x0f, 0x86, 0x89, 0xdb,
+	0x2d, 0x1c, 0xba, 0x97, 0xc9, 0x20, 0x5f, 0x49, 0xc2, 0xa0, 0xfc, 0x68, 0xd9, 0x64, 0x29, 0xee,
+	0x5d, 0x4a, 0xa2, 0xe4, 0x6b, 0x13, 0x04, 0x09, 0x37, 0x26, 0xc7, 0x9a, 0x41, 0x9a, 0xc9, 0xb6,
+	0xd7, 0xf1, 0xb0, 0x13, 0x22, 0xf5, 0x4f, 0xed, 0x6f, 0xb8, 0xb0, 0xc6, 0xfc, 0x49, 0x94, 0x59,
+	0xae, 0x14, 0x09, 0x41, 0x37, 0x6d, 0x0c, 0xcc, 0xab, 0x49, 0xf5, 0x80, 0xbc, 0xfa, 0x5d, 0xb6,
+	0x72, 0x3b, 0xe3, 0x34, 0x8d, 0xdb, 0xa7, 0x60, 0x03, 0x1a, 0x4b, 0xd4, 0xa6, 0x33, 0xd9, 0x82,
+	0xd6, 0x29, 0x97, 0x90, 0xfa, 0x72, 0x45, 0x41, 0x55, 0x16, 0x40, 0x8e, 0xa3, 0xdd, 0xc4, 0xb8,
+	0x50, 0xd4, 0xe3, 0x26, 0xe6, 0x3e, 0x47, 0x06, 0xda, 0x8d, 0x20, 0x95, 0x61, 0x40, 0xbe, 0x94,
+	0x6c, 0x57, 0x11, 0xc8, 0xc4, 0x37, 0xed, 0x5b, 0x32, 0x20, 0xf0, 0x0a, 0xee, 0x0d, 0x32, 0x92,
+	0xf2, 0xf3, 0x69, 0x2e, 0xf3, 0x86, 0x0e, 0x3c, 0xf8, 0xfc, 0x1c, 0x96, 0x04, 0x20, 0xa7, 0x85,
+	0x77, 0x3a, 0xf1, 0x03, 0x68, 0x90, 0xc6, 0x91, 0x10, 0x6d, 0xd4, 0x9d, 0xae, 0xaa, 0x17, 0x82,
+	0x89, 0xeb, 0x5e, 0x25, 0xc3, 0x09, 0x6d, 0xd2, 0x00, 0x03, 0x05, 0x47, 0xd8, 0x81, 0xff, 0x0c,
+	0x1e, 0x80, 0x20, 0x60, 0x77, 0x6e, 0xcd, 0xfc, 0x44, 0x2f, 0x6d, 0x10, 0x6a, 0x93, 0xd2, 0xd9,
+	0xeb, 0x97, 0x16, 0x41, 0x91, 0x70, 0x7f, 0xdb, 0x21, 0x63, 0x7c, 0xd2, 0xb1, 0xbe, 0xa7, 0x1e,
+	0xb1, 0x15, 0x7c, 0xd8, 0xbd, 0x46, 0x66, 0xe7, 0x34, 0x46, 0xfc, 0x04, 0x7c, 0x5c, 0x79, 0x29,
+	0x68, 0x45, 0xbf, 0xf4, 0xf6, 0xcc, 0xb8, 0xf9, 0x45, 0x8c, 0x26, 0xba, 0x73, 0x64, 0x12, 0x67,
+	0x30, 0x74, 0x22, 0x39, 0x89, 0x45, 0x84, 0x92, 0xb2, 0x81, 0x5e, 0x31, 0x8b, 0xa1, 0x88, 0xef,
+	0x7e, 0x80, 0x78, 0x69, 0x67, 0xbd, 0x15, 0xb2, 0x88, 0x25, 0x7e, 0xc3, 0x47, 0xd5, 0x40, 0xb0,
+	0xde, 0xa4, 0x4c, 0x3c, 0x1a, 0x9e, 0x3f, 0x2b, 0x68, 0x79, 0xd5, 0x1e, 0x78, 0xd0, 0x93, 0x02,
+	0x06, 0xfb, 0x75, 0x75, 0xf5, 0x6e, 0x7b, 0xcc, 0x88, 0xbe, 0xc7, 0xfc, 0x1b, 0x42, 0x86, 0x16,
+	0xe7, 0x96, 0xd7, 0x82, 0x74, 0x6b, 0x1f, 0x2a, 0x4a, 0x94, 0x92, 0x85, 0x2e, 0xa9, 0x78, 0xcf,
+	0x91, 0x3a, 0x26, 0x50, 0x18, 0x6e, 0x44, 0x06, 0xc3, 0x08, 0x77, 0x40, 0x6f, 0xc2, 0x96, 0x97,
+	0x80, 0xe4, 0xc2, 0xcf, 0xbd, 0x4b, 0x8c, 0x3a, 0x08, 0x2e, 0xee, 0x1b, 0xe8, 0x96, 0x2c, 0x02,
+	0x80, 0xc5, 0xf5, 0xfc, 0xb2, 0x0d, 0xf3, 0xb7, 0x20, 0xa9, 0x3b, 0x20, 0x0b, 0x10, 0xe4, 0x0c,
+	0xdd, 0x8f, 0x3b, 0x64, 0x54, 0x76, 0x1d, 0x3d, 0xf4, 0xfa, 0xad, 0x85, 0x72, 0xe7, 0x44, 0xb9,
+	0x77, 0xaa, 0x06, 0x00, 0x9d, 0x65, 0x97, 0x4a, 0x73, 0x60, 0x3f, 0x2a, 0x4d, 0x77, 0x87, 0x8c,
+	0xec, 0x84, 0x59, 0x83, 0x5d, 0xc0, 0x85, 0x47, 0xcc, 0xd2, 0xbd, 0xb7, 0x1a, 0xc9, 0xe5, 0x23,
+	0x76, 0x43, 0x32, 0x80, 0x9c, 0x17, 0xee, 0xc4, 0xf8, 0x83, 0x05, 0x50, 0x7b, 0x43, 0xa6, 0x5d,
+	0xf3, 0x86, 0x2c, 0x80, 0x1c, 0x07, 0x87, 0x78, 0x0c, 0x7f, 0x55, 0xe9, 0xab, 0x1d, 0x3c, 0xd5,
+	0xbc, 0x61, 0x5b, 0xf3, 0x4a, 0x52, 0xe4, 0x83, 0x75, 0x43, 0xe3, 0x01, 0x06, 0x47, 0x75, 0xb3,
+	0x19, 0xe9, 0x79, 0xb3, 0x79, 0x83, 0xab, 0x58, 0xb9, 0xae, 0xcf, 0x23, 0xb6, 0xa2, 0x76, 0x72,
+	0xfd, 0x21, 0x0f, 0x4a, 0xcc, 0x7f, 0x83, 0xc6, 0x0f, 0x0f, 0xab, 0x38, 0xba, 0x70, 0x33, 0xcc,
+	0xc4, 0x46, 0xa5, 0x0e, 0xab, 0x15, 0x06, 0x05, 0x51, 0xca, 0x3d, 0x2f, 0x71, 0x12, 0xa4, 0xe2,
+	0x92, 0xa6, 0x79, 0x5e, 0x32, 0x30, 0xc8, 0x72, 0xf7, 0x1f, 0x38, 0x64, 0xa0, 0x11, 0xc7, 0x5b,
+	0xa9, 0x37, 0x7e, 0xb6, 0xcf, 0x8e, 0xca, 0x4b, 0xec, 0x38, 0xb3, 0x17, 0x91, 0xac, 0x19, 0x1c,
+	0x3e, 0xc0, 0x60, 0x77, 0x6e, 0xcd, 0x4c, 0x5c, 0x09, 0x37, 0x68, 0x6d, 0xb7, 0xd6, 0xa4, 0x0c,
+	0xf2, 0xd6, 0xdb, 0x1a, 0xe4, 0xc2, 0x36, 0x8d, 0x32, 0xe0, 0xad, 0x9a, 0xfe, 0xac, 0x43, 0x48,
+	0x4e, 0xa8, 0x64, 0xf7, 0xa3, 0xa6, 0x53, 0xa0, 0x05, 0x7d, 0xb7, 0xd1, 0x34, 0x7d, 0x3b, 0xfd,
+	0x77, 0x0e, 0x19, 0xc5, 0xce, 0xc9, 0x2d, 0xf0, 0x09, 0x32, 0x98, 0x05, 0xc9, 0x26, 0x95, 0x66,
+	0x7e, 0xf5, 0x39, 0xd6, 0x18, 0x14, 0x44, 0xa9, 0x1b, 0x91, 0x81, 0x2c, 0x48, 0xb7, 0xa4, 0x96,
+	0xed, 0x92, 0xb5, 0x21, 0xce, 0x15, 0x6c, 0xf8, 0x2b, 0x05, 0xce, 0xc6, 0x7d, 0x92, 0x0c, 0xa3,
+	0xd4, 0xb2, 0x14, 0xa4, 0xd2, 0xf3, 0x96, 0x5d, 0x6e, 0x97, 0x04, 0x0c, 0x54, 0x29, 0x7a, 0x30,
+	0xf4, 0x2f, 0x72, 0x7d, 0xeb, 0x60, 0x1a, 0x77, 0x92, 0x1a, 0xf5, 0x1c, 0x5b, 0x73, 0x1a, 0xe9,
+	0x56, 0x19, 0x4d, 0x4d, 0xe3, 0xc9, 0x7e, 0x83, 0xe0, 0x85, 0x0a, 0xfd, 0x89, 0x2c, 0x09, 0xa2,
+	0x74, 0x83, 0x39, 0x54, 0xe0, 0x0d, 0xa6, 0x62, 0x6b, 0x16, 0xae, 0x19, 0x74, 0xab, 0x19, 0x6d,
+	0xe7, 0x7e, 0x1d, 0x66, 0x19, 0x14, 0xda, 0xe0, 0xff, 0x3d, 0x87, 0x90, 0xbc, 0xf5, 0x18, 0x63,
+	0x36, 0x1e, 0xe8, 0x11, 0x1f, 0x9e, 0x63, 0x6b, 0xaa, 0x19, 0x81, 0x24, 0xdc, 0xd4, 0x60, 0x80,
+	0xc0, 0x64, 0xec, 0xff, 0x2c, 0x19, 0x60, 0xab, 0x83, 0xe9, 0x24, 0xc5, 0x2d, 0xb1, 0x68, 0x8b,
+	0x92, 0xb7, 0x47, 0x50, 0x18, 0xfe, 0x07, 0xc8, 0xc4, 0x85, 0x9b, 0xb4, 0xd6, 0xc9, 0xe2, 0x84,
+	0xdf, 0x33, 0x7b, 0x44, 0xf8, 0x3a, 0x87, 0x8a, 0xf0, 0xfd, 0x0d, 0x87, 0x8c, 0x6a, 0xee, 0xff,
+	0x78, 0x52, 0x6f, 0x2e, 0x54, 0xb9, 0xfd, 0xc1, 0x73, 0x6c, 0x9d, 0xd4, 0xcb, 0x92, 0x64, 0x7e,
+	0x8c, 0x28, 0x10, 0xe4, 0x0c, 0xef, 0xe2, 0x9e, 0xef, 0xff, 0xbe, 0x43, 0x4e, 0x96, 0xc6, 0x2a,
+	0xbc, 0xc3, 0xcd, 0x36, 0x5c, 0xe4, 0x2a, 0xfb, 0x70, 0x91, 0xfb, 0x6d, 0x87, 0xe4, 0x94, 0x70,
+	0x2b, 0x5a, 0xcf, 0x5b, 0xae, 0x6d, 0x45, 0x82, 0x93, 0x28, 0x75, 0xdf, 0x20, 0xa7, 0xcd, 0x2f,
+	0x78, 0x48, 0x77, 0x08, 0xae, 0x3b, 0x2e, 0xa7, 0x04, 0xbd, 0x58, 0xf8, 0x5f, 0x75, 0xc8, 0xc0,
+	0x72, 0xd0, 0xd9, 0xa4, 0xfb, 0xb2, 0x66, 0xe1, 0x3e, 0x96, 0xd0, 0xa0, 0x99, 0xc9, 0x5b, 0xab,
+	0xd8, 0xc7, 0x40, 0xc0, 0x40, 0x95, 0xba, 0x73, 0x64, 0x24, 0x6e, 0x53, 0xc3, 0xc3, 0xe7, 0x51,
+	0x39, 0x7a, 0x2b, 0xb2, 0x00, 0x8f, 0x1d, 0xc6, 0x5d, 0x41, 0x20, 0xaf, 0xe5, 0x7f, 0x6d, 0x90,
+	0x8c, 0x6a, 0x51, 0xad, 0x28, 0x0b, 0x24, 0xb4, 0x1d, 0x17, 0xe5, 0x65, 0x9c, 0x30, 0xc0, 0x4a,
+	0x70, 0x0d, 0x26, 0x74, 0x3b, 0x4c, 0xf9, 0xb6, 0x65, 0xac, 0x41, 0x10, 0x70, 0x50, 0x18, 0xe8,
+	0xda, 0x5f, 0xa7, 0xed, 0xac, 0xc1, 0x9a, 0xd7, 0xcf, 0x5d, 0xfb, 0x17, 0x11, 0x00, 0x1c, 0x8e,
+	0x08, 0x1b, 0x34, 0xab, 0x35, 0x98, 0xe1, 0x56, 0xf8, 0xfe, 0x2f, 0x21, 0x00, 0x38, 0xbc, 0xc4,
+	0xc9, 0x68, 0xe0, 0xe8, 0x9d, 0x8c, 0x06, 0x2d, 0x3b, 0x19, 0xb9, 0x6d, 0x72, 0x3c, 0x4d, 0x1b,
+	0xab, 0x49, 0xb8, 0x1d, 0x64, 0x34, 0x9f, 0x7d, 0x43, 0x07, 0xe1, 0x73, 0x9a, 0xe5, 0x99, 0xa9,
+	0x5e, 0x2c, 0x52, 0x81, 0x32, 0xd2, 0x6e, 0x95, 0x9c, 0x0c, 0xa3, 0x94, 0xd6, 0x3a, 0x09, 0xbd,
+	0xb4, 0x19, 0xc5, 0x09, 0xbd, 0x18, 0xa7, 0x48, 0x4e, 0x64, 0xc9, 0x50, 0xd1, 0x30, 0x97, 0xca,
+	0x90, 0xa0, 0xbc, 0xae, 0xbb, 0x4c, 0x8e, 0xd5, 0xc3, 0x14, 0xaf, 0x69, 0x78, 0xb1, 0x8b, 0xb9,
+	0xe6, 0x7c, 0x84, 0x11, 0x7c, 0x50, 0x9a, 0x79, 0x16, 0x8b, 0x08, 0xd0, 0x5d, 0x07, 0x9d, 0xe7,
+	0xd3, 0x30, 0xda, 0x6c, 0xd2, 0xf9, 0x24, 0x88, 0x6a, 0x0d, 0x91, 0x5e, 0x43, 0x99, 0xc3, 0xab,
+	0x5a, 0x19, 0x18, 0x98, 0x6c, 0xcd, 0xf3, 0x3a, 0x05, 0x69, 0x50, 0x60, 0x8b, 0x52, 0xbc, 0xe7,
+	0xca, 0x3e, 0x54, 0xb7, 0xc2, 0xf6, 0xda, 0x95, 0xaa, 0xb8, 0x9b, 0xaa, 0x7b, 0xee, 0x25, 0xb3,
+	0x18, 0x8a, 0xf8, 0xfe, 0xf7, 0x1d, 0x32, 0xa6, 0x07, 0xb3, 0xa1, 0xb0, 0x4e, 0x1a, 0x8b, 0x4b,
+	0x55, 0x7e, 0x9c, 0xd8, 0x13, 0x1a, 0x2e, 0x2a, 0x9a, 0xb9, 0xaa, 0x27, 0x87, 0x81, 0xc6, 0x73,
+	0x1f, 0xa9, 0x69, 0x1e, 0x25, 0x03, 0x1b, 0x31, 0xca, 0x34, 0x7d, 0xa6, 0x29, 0x7e, 0x09, 0x81,
+	0xc0, 0xcb, 0xfc, 0xff, 0xe6, 0x90, 0x53, 0xe5, 0x71, 0x7a, 0x3f, 0x0e, 0x9d, 0x3c, 0x8f, 0x99,
+	0xae, 0xb2, 0x86, 0x71, 0x2e, 0x68, 0xc9, 0xa9, 0x64, 0x09, 0x68, 0x58, 0xfb, 0xeb, 0xf6, 0xbf,
+	0xad, 0x10, 0x8d, 0x27, 0x2a, 0xe0, 0xc7, 0x91, 0xed, 0xe5, 0x64, 0xdd, 0xe8, 0xed, 0x8a, 0x9d,
+	0xde, 0x2a, 0xb2, 0xb9, 0x76, 0xca, 0x00, 0x83, 0xc9, 0x1c, 0xed, 0x51, 0x41, 0xbd, 0x9e, 0xd0,
+	0x34, 0x55, 0xbe, 0x3b, 0x4c, 0x0f, 0x36, 0x27, 0x81, 0x90, 0x97, 0xe3, 0x3e, 0x8c, 0x61, 0x94,
+	0xb8, 0xb5, 0x79, 0x7d, 0xe6, 0x3e, 0x8c, 0x4c, 0x10, 0x0e, 0x0a, 0xc3, 0x7d, 0x91, 0x9c, 0xaa,
+	0x07, 0x59, 0xc0, 0x45, 0x40, 0x9a, 0xac, 0x26, 0x71, 0x46, 0xb9, 0xf2, 0x9c, 0xbb, 0x7a, 0x9e,
+	0x11, 0x75, 0x4f, 0x2d, 0x96, 0x62, 0x41, 0x8f, 0xda, 0xfe, 0xaf, 0xf4, 0x13, 0xb3, 0x4f, 0xe8,
+	0x72, 0xb8, 0x95, 0xac, 0x2f, 0x30, 0x97, 0xca, 0xc3, 0xb8, 0x36, 0x32, 0x97, 0xc3, 0xcb, 0x26,
+	0x05, 0x28, 0x92, 0x14, 0x5c, 0x2e, 0xd3, 0xdd, 0x2c, 0x58, 0x3f, 0xb4, 0x63, 0xe3, 0x65, 0x93,
+	0x02, 0x14, 0x49, 0xa2, 0x13, 0xed, 0x56, 0xb2, 0x2e, 0x4f, 0x8f, 0xa2, 0x13, 0xed, 0xe5, 0xbc,
+	0x08, 0x74, 0x3c, 0xfc, 0x34, 0x5b, 0xc9, 0x3a, 0x1e, 0xd8, 0x32, 0x05, 0x94, 0xfa, 0x34, 0x97,
+	0x05, 0x1c, 0x14, 0x86, 0xdb, 0x26, 0xee, 0x96, 0x1c, 0x3d, 0x65, 0x0f, 0xf1, 0x06, 0x0e, 0x68,
+	0x34, 0x61, 0x8a, 0xfd, 0xcb, 0x5d, 0x74, 0xa0, 0x84, 0xb6, 0xfb, 0x12, 0x39, 0xbd, 0x95, 0xac,
+	0x0b, 0x39, 0x66, 0x35, 0x09, 0xa3, 0x5a, 0xd8, 0x36, 0xd2, 0x3d, 0xcd, 0x88, 0xe6, 0x9e, 0xbe,
+	0x5c, 0x8e, 0x06, 0xbd, 0xea, 0xfb, 0xbf, 0xd3, 0x4f, 0x58, 0xa2, 0x0a, 0xdc, 0xa6, 0x5b, 0x34,
+	0x6b, 0xc4, 0xf5, 0xa2, 0x68, 0x76, 0x95, 0x41, 0x41, 0x94, 0xca, 0xf0, 0x95, 0x4a, 0x8f, 0xf0,
+	0x95, 0x1d, 0x32, 0xd4, 0xa0, 0x41, 0x9d, 0x26, 0x52, 0xaf, 0x7e, 0xc5, 0x4e, 0x6a, 0x8d, 0x8b,
+	0x8c, 0x68, 0xae, 0x21, 0xe0, 0xbf, 0x53, 0x90, 0xdc, 0xdc, 0xf7, 0x90, 0x09, 0x94, 0xb1, 0xe2,
+	0x4e, 0x26, 0x8d, 0x81, 0x5c, 0xaf, 0xce, 0x0e, 0xfb, 0x35, 0xa3, 0x04, 0x0a, 0x98, 0xee, 0x22,
+	0x99, 0x12, 0xa6, 0x7e, 0xa5, 0xaf, 0x17, 0x03, 0xab, 0xf2, 0x70, 0x55, 0x0b, 0xe5, 0xd0, 0x55,
+	0x83, 0x85, 0x1f, 0xc4, 0x75, 0xee, 0xed, 0xa5, 0x87, 0x1f, 0xc4, 0xf5, 0x5d, 0x60, 0x25, 0xee,
+	0x6b, 0x64, 0x18, 0xff, 0x62, 0x46, 0x29, 0x6f, 0xd8, 0x96, 0xe1, 0x10, 0x47, 0x07, 0x79, 0x88,
+	0x4b, 0x2c, 0x93, 0x3d, 0xe7, 0x05, 0x17, 0x50, 0xfc, 0xf0, 0x2a, 0xa5, 0x1f, 0x97, 0x2f, 0xd2,
+	0x24, 0xdc, 0xd8, 0x65, 0xf2, 0xcc, 0x70, 0x7e, 0x95, 0xba, 0xd4, 0x85, 0x01, 0x25, 0xb5, 0xfc,
+	0xcf, 0x55, 0xc8, 0x98, 0x9e, 0xef, 0xe4, 0x6e, 0x31, 0x4d, 0x69, 0x3e, 0x29, 0xf8, 0xc5, 0xd9,
+	0x82, 0xe9, 0xef, 0xae, 0x13, 0xa2, 0x41, 0xfa, 0x83, 0x8e, 0x10, 0x64, 0xad, 0xe8, 0xe7, 0x58,
+	0x8f, 0x31, 0xf8, 0x88, 0x05, 0xc6, 0xe3, 0x7f, 0xc0, 0x38, 0xf8, 0x9f, 0xec, 0x23, 0xc3, 0xb2,
+	0x10, 0x5d, 0x25, 0x48, 0xee, 0xd6, 0xed, 0x39, 0xb6, 0x3e, 0xb3, 0xe9, 0x91, 0xae, 0x59, 0x98,
+	0x14, 0x1c, 0x34, 0xbe, 0xa8, 0x29, 0x89, 0xb1, 0x71, 0xe7, 0xed, 0xe5, 0xec, 0x59, 0x41, 0xc6,
+	0xe7, 0x19, 0xf7, 0x5c, 0xa3, 0xc7, 0x60, 0x20, 0x78, 0xe1, 0xe5, 0x74, 0x5d, 0x46, 0x1b, 0xd8,
+	0xd3, 0x7e, 0xab, 0x00, 0x86, 0xfc, 0xae, 0xa9, 0x40, 0x90, 0x33, 0xf4, 0x9f, 0x21, 0x13, 0xe6,
+	0x62, 0xc0, 0xcb, 0xca, 0xfa, 0x6e, 0x46, 0xb9, 0x2a, 0x64, 0x8c, 0x5f, 0x56, 0xe6, 0x11, 0x00,
+	0x1c, 0x8e, 0x71, 0x4e, 0x24, 0xdf, 0x5e, 0xf6, 0x61, 0x7d, 0x78, 0xd4, 0xb0, 0x62, 0xf4, 0xb8,
+	0x11, 0x7e, 0x8c, 0x8c, 0xb0, 0x7f, 0xd8, 0x42, 0xef, 0xb3, 0xe5, 0x1b, 0x98, 0xb7, 0x53, 0x2c,
+	0x75, 0x26, 0x6b, 0xbc, 0x28, 0x19, 0x41, 0xce, 0xd3, 0x8f, 0xc9, 0x54, 0x11, 0xdb, 0x7d, 0x85,
+	0x8c, 0xa5, 0xf2, 0x58, 0xcd, 0xa3, 0xf7, 0xf7, 0x79, 0xfc, 0x72, 0xcf, 0x1c, 0xad, 0x3a, 0x18,
+	0xc4, 0xfc, 0x15, 0x32, 0x68, 0x75, 0x08, 0xfd, 0x6f, 0x39, 0x64, 0x84, 0x19, 0xd5, 0x37, 0x51,
+	0xe9, 0xae, 0xaa, 0xf4, 0xed, 0x31, 0xea, 0x29, 0x19, 0xe2, 0xea, 0x03, 0xe9, 0x54, 0x6c, 0xcf,
+	0xc1, 0x40, 0xed, 0x32, 0x5c, 0x4f, 0x91, 0x82, 0xe4, 0xe4, 0x7f, 0xaa, 0x42, 0x06, 0x2f, 0x45,
+	0xed, 0xce, 0x5f, 0xfb, 0x74, 0xaf, 0x57, 0x49, 0x3f, 0x5a, 0x54, 0xcc, 0xac, 0xc4, 0x63, 0xf3,
+	0x8f, 0xeb, 0x19, 0x89, 0x3d, 0x33, 0x23, 0x31, 0x04, 0x3b, 0xd2, 0xe7, 0x5e, 0xa8, 0xaf, 0xf3,
+	0x0c, 0x06, 0x4f, 0x93, 0x91, 0x2b, 0xc1, 0x3a, 0x6d, 0x5e, 0xa6, 0xbb, 0x2c, 0xdf, 0x00, 0xf7,
+	0xff, 0x74, 0x72, 0x9d, 0x83, 0xe1, 0xab, 0xb9, 0x48, 0x26, 0x18, 0xb6, 0x5a, 0x0c, 0x78, 0x23,
+	0xa1, 0x79, 0x4a, 0x47, 0xc7, 0xbc, 0x91, 0x68, 0xe9, 0x1c, 0x35, 0x2c, 0x7f, 0x96, 0x8c, 0xe6,
+	0x54, 0xf6, 0xc1, 0xf5, 0x47, 0x15, 0x32, 0x6e, 0x68, 0xe1, 0x0d, 0xdb, 0xa4, 0x73, 0x57, 0xdb,
+	0xa4, 0x61, 0x2b, 0xac, 0xbc, 0xd3, 0xb6, 0xc2, 0xbe, 0xfb, 0x6f, 0x2b, 0x34, 0x3f, 0x52, 0xff,
+	0xbe, 0x3e, 0x52, 0x93, 0xf4, 0x5f, 0x09, 0xa3, 0xad, 0xfd, 0xed, 0x33, 0x69, 0x2d, 0x6e, 0x77,
+	0xed, 0x33, 0x55, 0x04, 0x02, 0x2f, 0x93, 0x92, 0x4b, 0x5f, 0xb9, 0xe4, 0xe2, 0xa3, 0xe3, 0xe3,
+	0xd5, 0x20, 0x0a, 0x37, 0x68, 0x9a, 0xb1, 0x79, 0x95, 0x1d, 0x69, 0xdc, 0xf9, 0x58, 0x8f, 0x0c,
+	0x4a, 0x6f, 0x39, 0xe4, 0xd8, 0x55, 0xda, 0x8a, 0xc3, 0xd7, 0x82, 0x3c, 0xa4, 0x05, 0xdb, 0xde,
+	0x08, 0x33, 0xe1, 0xc1, 0xaf, 0xda, 0x7e, 0x11, 0x53, 0xdc, 0x35, 0xc2, 0xbb, 0xa9, 0x98, 0x59,
+	0x44, 0x27, 0x5e, 0xd0, 0xb4, 0x5c, 0x08, 0x79, 0xb0, 0x8a, 0x2c, 0x80, 0x1c, 0xc7, 0xff, 0x5d,
+	0x87, 0x0c, 0xf1, 0x46, 0xa8, 0x28, 0x20, 0xa7, 0x07, 0xed, 0x06, 0x19, 0x60, 0xf5, 0xc4, 0xac,
+	0x5e, 0xb6, 0x20, 0xfe, 0x20, 0x39, 0xbe, 0x06, 0xd9, 0xbf, 0xc0, 0x19, 0xb0, 0x6b, 0x4b, 0x70,
+	0x73, 0x4e, 0x45, 0xf3, 0xe4, 0xd7, 0x16, 0x06, 0x05, 0x51, 0xea, 0x7f, 0xad, 0x8f, 0x0c, 0xab,
+	0xc4, 0xa1, 0x2c, 0xad, 0x53, 0x14, 0xc5, 0x59, 0xc0, 0x3d, 0x80, 0xf8, 0x5e, 0xfd, 0x8a, 0xbd,
+	0xc4, 0xa5, 0xb3, 0x73, 0x39, 0x75, 0x6e, 0x5a, 0x54, 0x97, 0x50, 0xad, 0x04, 0xf4, 0x46, 0xb8,
+	0x1f, 0x25, 0x83, 0x4d, 0xdc, 0x7d, 0xe4, 0xd6, 0xfd, 0xa2, 0xc5, 0xe6, 0xb0, 0x6d, 0x4d, 0xb4,
+	0x44, 0x8d, 0x10, 0x07, 0x82, 0xe0, 0x3a, 0xfd, 0x3e, 0x32, 0x55, 0x6c, 0xf5, 0x41, 0xbc, 0x38,
+	0xa6, 0xff, 0x86, 0xd8, 0x3d, 0x0f, 0x5e, 0xd5, 0x7f, 0x81, 0x8c, 0x5e, 0xa5, 0x59, 0x12, 0xd6,
+	0x18, 0x81, 0xbb, 0x4d, 0xae, 0x7d, 0xc9, 0x0f, 0x9f, 0x66, 0x93, 0x15, 0x69, 0xa6, 0x68, 0x0d,
+	0x6f, 0x27, 0x31, 0xde, 0x5f, 0x69, 0x47, 0x7e, 0x6c, 0x0b, 0xf2, 0xf0, 0xaa, 0xa2, 0xc9, 0xad,
+	0xe1, 0xf9, 0x6f, 0xd0, 0xf8, 0xf9, 0x9f, 0x71, 0xc8, 0xc0, 0xd5, 0x4e, 0x46, 0x6f, 0xee, 0x63,
+	0xcb, 0x3a, 0x70, 0xf2, 0x22, 0x0c, 0xf6, 0x0a, 0xb2, 0x60, 0x3d, 0x48, 0xa5, 0x1e, 0x2d, 0x0f,
+	0xf6, 0x12, 0x70, 0x50, 0x18, 0xfe, 0x2b, 0x64, 0x8c, 0xb5, 0xe4, 0x62, 0xdc, 0xc4, 0x53, 0x18,
+	0x47, 0xb2, 0x85, 0xbf, 0x8b, 0xe6, 0x0d, 0x86, 0x04, 0xbc, 0x0c, 0x57, 0x58, 0x23, 0x6e, 0xd6,
+	0x55, 0xd8, 0xb7, 0x9a, 0x3f, 0x17, 0x19, 0x14, 0x44, 0xa9, 0xff, 0x89, 0x0a, 0x19, 0x65, 0x15,
+	0xc5, 0xee, 0xb4, 0x4b, 0x86, 0x1a, 0x9c, 0x8f, 0x18, 0x72, 0x0b, 0xde, 0xe2, 0x7a, 0xeb, 0xb5,
+	0xab, 0x1f, 0x07, 0x80, 0xe4, 0x87, 0xac, 0x77, 0x82, 0x10, 0xfd, 0x1f, 0xbd, 0xca, 0xd1, 0xb2,
+	0xbe, 0xc1, 0xd9, 0x80, 0xe4, 0xe7, 0xff, 0x02, 0x61, 0xe9, 0x54, 0x96, 0x9a, 0xc1, 0x26, 0x1f,
+	0xb9, 0x78, 0x8b, 0xd6, 0xc5, 0x16, 0xad, 0x8d, 0x1c, 0x42, 0x41, 0x94, 0xf2, 0x14, 0x15, 0x59,
+	0x12, 0xaa, 0x38, 0x2b, 0x2d, 0x45, 0x05, 0x03, 0xcb, 0xa8, 0xba, 0xba, 0xff, 0xa5, 0x0a, 0x21,
+	0x48, 0x5f, 0x64, 0x41, 0xf9, 0x19, 0xe9, 0xee, 0x67, 0x9a, 0x44, 0x95, 0xbb, 0x1f, 0xcb, 0xf3,
+	0x62, 0xb8, 0xf9, 0x69, 0xe1, 0x8f, 0x95, 0xbd, 0xc3, 0x1f, 0xdd, 0x36, 0x19, 0x8a, 0x3b, 0x19,
+	0x8a, 0xb6, 0x42, 0x36, 0xb0, 0xe0, 0x11, 0xb0, 0xc2, 0x09, 0x72, 0xff, 0x6a, 0xf1, 0x03, 0x24,
+	0x1b, 0xf7, 0x39, 0x32, 0xdc, 0x4e, 0xe2, 0x4d, 0x3c, 0xea, 0x85, 0x34, 0xf0, 0xb0, 0x9c, 0xcd,
+	0xab, 0x02, 0x7e, 0x47, 0xfb, 0x1f, 0x14, 0xb6, 0xff, 0x47, 0x53, 0x7c, 0x5c, 0xc4, 0xdc, 0x9b,
+	0x26, 0x95, 0x50, 0x2a, 0xb2, 0x88, 0x20, 0x51, 0xb9, 0xb4, 0x08, 0x95, 0xb0, 0xae, 0x56, 0x61,
+	0xa5, 0xe7, 0x2a, 0xfc, 0x59, 0x32, 0x5a, 0x0f, 0xd3, 0x76, 0x33, 0xd8, 0xbd, 0x56, 0xa2, 0x45,
+	0x5c, 0xcc, 0x8b, 0x40, 0xc7, 0x73, 0x9f, 0x16, 0xc1, 0xae, 0xfd, 0x86, 0xe6, 0x48, 0x06, 0xbb,
+	0xe6, 0x59, 0x76, 0x18, 0x56, 0x57, 0x36, 0xa2, 0x81, 0x7d, 0x67, 0x23, 0x2a, 0x0a, 0x6e, 0x83,
+	0xf7, 0x5f, 0x70, 0x7b, 0x2f, 0x19, 0x97, 0x3f, 0x99, 0x34, 0xe5, 0x9d, 0x30, 0x7d, 0x3a, 0xd7,
+	0xf4, 0x42, 0x30, 0x71, 0xf3, 0x49, 0x3b, 0xb4, 0xdf, 0x49, 0x7b, 0x9e, 0x90, 0xf5, 0xb8, 0x13,
+	0xd5, 0x83, 0x64, 0xf7, 0xd2, 0xa2, 0x37, 0x6c, 0xca, 0x89, 0xf3, 0xaa, 0x04, 0x34, 0x2c, 0x7d,
+	0xa2, 0x8f, 0xdc, 0x65, 0xa2, 0xbf, 0x82, 0xae, 0xaf, 0x41, 0x92, 0x31, 0xd7, 0x57, 0x72, 0x60,
+	0xd7, 0xd7, 0xdc, 0x73, 0x57, 0x12, 0x81, 0x9c, 0x9e, 0xfb, 0x41, 0x42, 0x36, 0xc2, 0x28, 0x4c,
+	0x1b, 0x8c, 0xfa, 0xe8, 0x81, 0xa9, 0xab, 0x7e, 0x2e, 0x29, 0x2a, 0xa0, 0x51, 0xc4, 0x40, 0x2e,
+	0x9a, 0x66, 0x61, 0x2b, 0xc8, 0x68, 0x5d, 0x65, 0x8f, 0xf0, 0x98, 0xea, 0x53, 0x05, 0x72, 0x5d,
+	0x28, 0x22, 0xdc, 0x29, 0x03, 0x42, 0x37, 0x21, 0x63, 0x45, 0x4e, 0x1f, 0x64, 0x45, 0xba, 0xff,
+	0xd3, 0x21, 0xc7, 0x12, 0xca, 0x3d, 0x68, 0x52, 0xd5, 0xb0, 0x93, 0x6c, 0x3b, 0xae, 0xd9, 0x78,
+	0xf0, 0x45, 0x2e, 0xf6, 0x59, 0x28, 0x72, 0xe1, 0x72, 0x0e, 0x95, 0xbd, 0xef, 0x2a, 0xbf, 0x53,
+	0x06, 0x7c, 0xeb, 0xed, 0x99, 0x99, 0xee, 0x87, 0x87, 0x14, 0x71, 0x5c, 0x79, 0xbf, 0xf4, 0xf6,
+	0xcc, 0x94, 0xfc, 0x9d, 0x0f, 0x5a, 0x57, 0x27, 0xf1, 0x58, 0x6d, 0xc7, 0xf5, 0x4b, 0xab, 0xde,
+	0x98, 0x79, 0xac, 0xae, 0x22, 0x10, 0x78, 0x19, 0x7a, 0x0d, 0xd4, 0x03, 0xda, 0x8a, 0x23, 0x95,
+	0xba, 0x7f, 0x8c, 0x9f, 0xda, 0x1c, 0x06, 0xaa, 0x14, 0xaf, 0x1c, 0x91, 0x38, 0x52, 0xbc, 0x87,
+	0x6c, 0x5d, 0x39, 0xe4, 0x21, 0xc5, 0xb9, 0xca, 0x5f, 0xa0, 0x38, 0xb9, 0x4d, 0x74, 0x9c, 0x65,
+	0x9b, 0xff, 0x84, 0xad, 0x68, 0x0d, 0xae, 0x27, 0x91, 0x6e, 0xb3, 0xf8, 0x3f, 0x08, 0x1e, 0xfa,
+	0x59, 0x33, 0x79, 0x7f, 0xce, 0x9a, 0x27, 0xc9, 0x70, 0xad, 0x11, 0x36, 0xeb, 0x09, 0x8d, 0xbc,
+	0x29, 0x76, 0xc1, 0x67, 0x23, 0xb1, 0x20, 0x60, 0xa0, 0x4a, 0xdd, 0xff, 0x9f, 0x8c, 0xc7, 0x9d,
+	0x8c, 0x6d, 0x2d, 0x38, 0x4e, 0x18, 0x22, 0x84, 0xe8, 0xcc, 0x0d, 0x6a, 0x45, 0x2f, 0x00, 0x13,
+	0x0f, 0xb7, 0xf8, 0x46, 0x9c, 0xb2, 0x24, 0x84, 0x6c, 0x8b, 0x3f, 0x65, 0x6e, 0xf1, 0x17, 0xb5,
+	0x32, 0x30, 0x30, 0x31, 0xcc, 0xf4, 0x58, 0xab, 0x78, 0xdf, 0x13, 0x11, 0x24, 0x55, 0x1b, 0xf7,
+	0x82, 0x02, 0x69, 0x1e, 0x3b, 0xd1, 0x05, 0x86, 0xee, 0x46, 0xb0, 0x74, 0xa0, 0xe9, 0x6e, 0x54,
+	0x6b, 0x24, 0x71, 0x64, 0x36, 0xef, 0x41, 0x5b, 0x51, 0xee, 0x6c, 0x6d, 0x97, 0xb1, 0xe0, 0x91,
+	0x5d, 0xa5, 0x45, 0x50, 0xde, 0xa8, 0xe9, 0x45, 0x72, 0xaa, 0x7c, 0x7f, 0x38, 0x50, 0x14, 0xcc,
+	0x12, 0x79, 0xb0, 0x67, 0xa3, 0xf0, 0xa4, 0x91, 0xd2, 0xa6, 0x63, 0x9e, 0x34, 0x5d, 0xd2, 0xe1,
+	0x04, 0x19, 0xd3, 0x5f, 0xaa, 0xf2, 0xff, 0x4f, 0x1f, 0x21, 0xb9, 0x5a, 0x1d, 0xfd, 0x5a, 0xb8,
+	0x0a, 0xff, 0xd2, 0xe2, 0xa1, 0xf3, 0xf3, 0x2c, 0x18, 0x04, 0xa0, 0x40, 0xd0, 0x6d, 0x11, 0x97,
+	0x43, 0xf8, 0xef, 0xc3, 0x98, 0x62, 0x99, 0xe5, 0x72, 0xa1, 0x8b, 0x08, 0x94, 0x10, 0xc6, 0x1e,
+	0x65, 0xf1, 0x16, 0x8d, 0xae, 0xc3, 0x95, 0xc3, 0xe4, 0x80, 0xe2, 0xc6, 0x3b, 0x83, 0x00, 0x14,
+	0x08, 0xba, 0x3e, 0x19, 0x64, 0xaa, 0x1c, 0xe9, 0x6a, 0xce, 0xb6, 0x17, 0x26, 0x69, 0x60, 0xcc,
+	0x3a, 0xfb, 0xeb, 0x7e, 0xc9, 0x21, 0x13, 0x32, 0x95, 0x15, 0x53, 0x9e, 0x4a, 0x27, 0xf3, 0xeb,
+	0xb6, 0xcc, 0x22, 0x17, 0x74, 0xea, 0xb9, 0x0b, 0xa7, 0x01, 0x4e, 0xa1, 0xd0, 0x08, 0xff, 0x25,
+	0x72, 0xbc, 0xa4, 0xba, 0x95, 0x0b, 0x30, 0xba, 0x3b, 0x6a, 0x19, 0x96, 0x51, 0xd9, 0x18, 0x57,
+	0xad, 0xfb, 0x0d, 0xae, 0x54, 0xbb, 0xfc, 0x06, 0x15, 0x08, 0x72, 0x86, 0xfb, 0x71, 0x77, 0x2c,
+	0x4d, 0x07, 0xfd, 0x0e, 0x37, 0xfb, 0xc0, 0xee, 0x8e, 0xbf, 0x32, 0x40, 0x72, 0x4a, 0x07, 0x4c,
+	0xb1, 0x96, 0x3b, 0x47, 0x56, 0xf6, 0x74, 0x8e, 0xac, 0x93, 0xc9, 0x80, 0x99, 0x9e, 0x0f, 0x99,
+	0x58, 0x8d, 0x27, 0xd8, 0x37, 0x29, 0x40, 0x91, 0x24, 0x72, 0x49, 0xf3, 0xaa, 0x8c, 0x4b, 0xff,
+	0x81, 0xb9, 0x54, 0x4d, 0x0a, 0x50, 0x24, 0x89, 0x91, 0x49, 0xb5, 0x84, 0x06, 0x19, 0xe5, 0x7d,
+	0xbc, 0xb4, 0x71, 0x2d, 0xce, 0x56, 0x13, 0x9a, 0xd2, 0x28, 0xf3, 0x06, 0xcc, 0xc8, 0xa4, 0x85,
+	0x1e, 0x78, 0xd0, 0x93, 0x02, 0x0b, 0x3d, 0x43, 0xdb, 0x75, 0x98, 0xed, 0xb2, 0x4d, 0xc4, 0x1b,
+	0x34, 0xaf, 0x29, 0x55, 0xbd, 0x10, 0x4c, 0x5c, 0xf7, 0x97, 0x1d, 0x32, 0xde, 0x94, 0xda, 0x7d,
+	0xe8, 0x34, 0xf9, 0x7d, 0xc5, 0x8a, 0x25, 0x6f, 0xa5, 0x5a, 0xbd, 0xa2, 0x53, 0xe6, 0xb2, 0x84,
+	0x01, 0x02, 0x93, 0x77, 0x31, 0xcb, 0xdd, 0xf0, 0x3e, 0xb3, 0xdc, 0x7d, 0xcf, 0x21, 0x53, 0x45,
+	0x6e, 0xee, 0x16, 0x79, 0xa4, 0x15, 0x24, 0x5b, 0x97, 0xa2, 0x8d, 0x84, 0x85, 0x94, 0x64, 0x7c,
+	0x32, 0xb0, 0x18, 0xf5, 0xc5, 0x60, 0x97, 0x5b, 0x4b, 0x07, 0x54, 0xb4, 0xda, 0x23, 0x57, 0xf7,
+	0x42, 0x86, 0xbd, 0x69, 0xa1, 0x5b, 0x23, 0x22, 0xb0, 0xd0, 0xf8, 0x30, 0x8e, 0x72, 0x26, 0x15,
+	0xc6, 0x44, 0xb9, 0x35, 0x5e, 0x2d, 0x43, 0x82, 0xf2, 0xba, 0xf8, 0x08, 0x26, 0x0f, 0x2e, 0xbd,
+	0x27, 0x73, 0x93, 0xff, 0x1f, 0x2a, 0x44, 0x0a, 0x86, 0x7f, 0xbd, 0xad, 0x77, 0x78, 0x88, 0x26,
+	0x4c, 0xa5, 0x24, 0xb4, 0x1d, 0xec, 0x10, 0x15, 0xe9, 0xa6, 0x45, 0x09, 0x4a, 0xcc, 0xf4, 0x66,
+	0x98, 0x2d, 0xc4, 0x75, 0xa9, 0xe3, 0x60, 0x12, 0xf3, 0x05, 0x01, 0x03, 0x55, 0x8a, 0x56, 0x93,
+	0x71, 0xec, 0x65, 0xb3, 0x49, 0x9b, 0x18, 0xd2, 0x90, 0x62, 0x06, 0x97, 0x14, 0xff, 0xb1, 0xa7,
+	0x0a, 0xcc, 0x83, 0x2d, 0x69, 0x5b, 0xb3, 0xed, 0x20, 0x13, 0xe0, 0xbc, 0xfc, 0x6f, 0xf7, 0x91,
+	0x11, 0x35, 0xd8, 0xfb, 0xd0, 0xbe, 0x9e, 0xcf, 0x33, 0xc1, 0xf3, 0x1d, 0xd8, 0xd3, 0xb2, 0xc0,
+	0xa3, 0x62, 0x62, 0x2e, 0xda, 0xe5, 0x39, 0xaf, 0xf2, 0x94, 0xf0, 0x4f, 0x9b, 0x96, 0xe9, 0x53,
+	0xfa, 0xfc, 0xd3, 0xf0, 0x39, 0x92, 0x7b, 0x53, 0x77, 0x0c, 0xe8, 0xb7, 0x75, 0x9a, 0x29, 0xab,
+	0x67, 0x6f, 0x8f, 0x80, 0xc2, 0x23, 0x81, 0x03, 0xfb, 0x7a, 0x24, 0xf0, 0x29, 0xd2, 0x4f, 0xa3,
+	0x4e, 0x8b, 0x89, 0x4a, 0x23, 0xec, 0x8a, 0xd0, 0x7f, 0x21, 0xea, 0xb4, 0xcc, 0x9e, 0x31, 0x14,
+	0xf7, 0x7d, 0x64, 0xb4, 0x4e, 0xd3, 0x5a, 0x12, 0xb2, 0x44, 0x4e, 0x42, 0xb3, 0xf3, 0x30, 0x53,
+	0x97, 0xe5, 0x60, 0xb3, 0xa2, 0x5e, 0xc1, 0x7f, 0x8d, 0x0c, 0xae, 0x36, 0x3b, 0x9b, 0x61, 0xe4,
+	0xb6, 0xc9, 0x20, 0x4f, 0xeb, 0xe4, 0x39, 0xb6, 0xee, 0x9d, 0x7c, 0xab, 0xd0, 0x9c, 0x56, 0xd8,
+	0x6f, 0x10, 0x7c, 0x50, 0x71, 0x8d, 0x57, 0xf3, 0xe5, 0x05, 0xf7, 0x6f, 0x75, 0xbd, 0x89, 0xf7,
+	0x13, 0x25, 0x6f, 0xe2, 0x8d, 0x33, 0xe4, 0x92, 0xe7, 0xf0, 0x9a, 0x64, 0x9c, 0xd9, 0x52, 0xe4,
+	0x19, 0x28, 0xc4, 0xea, 0x67, 0xf7, 0x99, 0x09, 0x49, 0xaf, 0x2a, 0x4e, 0x04, 0x1d, 0x04, 0x26,
+	0x71, 0xf7, 0x2a, 0x39, 0xce, 0x73, 0x8b, 0x2c, 0xd2, 0x66, 0xb0, 0x5b, 0x48, 0x1c, 0xfa, 0x90,
+	0x7c, 0xe6, 0x74, 0xb1, 0x1b, 0x05, 0xca, 0xea, 0xf9, 0xbf, 0xd7, 0x4f, 0x34, 0x0b, 0xc6, 0x3e,
+	0x56, 0xcb, 0xab, 0x05, 0x7b, 0xd5, 0x55, 0x2b, 0xf6, 0x2a, 0x69, 0x04, 0xe2, 0x3b, 0x90, 0x69,
+	0xa2, 0xc2, 0x46, 0x35, 0x68, 0xb3, 0xed, 0xf5, 0x99, 0x8d, 0xba, 0x48, 0x9b, 0x6d, 0x60, 0x25,
+	0x2a, 0x34, 0xb2, 0xbf, 0x67, 0x68, 0x64, 0x83, 0x0c, 0x6c, 0x62, 0x74, 0x85, 0x37, 0x60, 0xcb,
+	0x34, 0xc9, 0x82, 0x35, 0xb8, 0x69, 0x92, 0xfd, 0x0b, 0x9c, 0x01, 0x2e, 0xf6, 0x86, 0xf4, 0x60,
+	0xf1, 0x06, 0x6d, 0x2d, 0x76, 0xe5, 0x14, 0xc3, 0x17, 0xbb, 0xfa, 0x09, 0x39, 0x33, 0xd4, 0xa6,
+	0xd4, 0x78, 0x3e, 0x36, 0x6f, 0xc8, 0x96, 0x36, 0x45, 0x24, 0x78, 0xe3, 0xda, 0x14, 0xf1, 0x03,
+	0x24, 0x1b, 0xff, 0x1c, 0x19, 0xd5, 0x9e, 0xe6, 0xc2, 0xcf, 0xa0, 0x52, 0x81, 0x69, 0x9f, 0x81,
+	0xe5, 0xac, 0x61, 0x25, 0xfe, 0x37, 0xfa, 0x89, 0xd2, 0xa5, 0xe9, 0x91, 0x8a, 0x22, 0x43, 0x48,
+	0xc1, 0x07, 0x75, 0x8e, 0x41, 0x41, 0x94, 0xa2, 0x5c, 0xd7, 0xa2, 0xc9, 0xa6, 0xba, 0x47, 0x7b,
+	0x15, 0x53, 0xae, 0xbb, 0xaa, 0x17, 0x82, 0x89, 0x8b, 0x42, 0x79, 0x4b, 0x58, 0xf4, 0x8b, 0x7e,
+	0xd8, 0xd2, 0xd2, 0x0f, 0x0a, 0x83, 0x65, 0x3e, 0x6a, 0x69, 0x0e, 0x00, 0xc2, 0x6f, 0xd3, 0x86,
+	0x41, 0x49, 0xa3, 0xca, 0xfd, 0xab, 0x74, 0x08, 0x18, 0x5c, 0x31, 0x8e, 0x23, 0xa5, 0xd9, 0xca,
+	0x4e, 0x44, 0x13, 0x95, 0x4f, 0xc3, 0xeb, 0x37, 0xe3, 0x38, 0xaa, 0x45, 0x04, 0xe8, 0xae, 0x53,
+	0xea, 0xea, 0x3a, 0x70, 0x60, 0x57, 0xd7, 0x45, 0x32, 0x85, 0xc1, 0x99, 0x9d, 0x84, 0xf6, 0x74,
+	0x98, 0x5d, 0x2a, 0x94, 0x43, 0x57, 0x0d, 0x16, 0x4a, 0xd4, 0x0c, 0x36, 0x53, 0x6f, 0x48, 0x0b,
+	0x25, 0x42, 0x00, 0x70, 0xb8, 0xff, 0x9b, 0x0e, 0xe1, 0x39, 0x0d, 0xe7, 0x36, 0x50, 0xe3, 0x9d,
+	0xed, 0xe2, 0xb3, 0xcb, 0x53, 0xa8, 0xa2, 0x9c, 0x8b, 0xb2, 0x50, 0x02, 0xed, 0xbd, 0x43, 0xc3,
+	0x78, 0x5d, 0x2b, 0x90, 0xe7, 0x09, 0xb2, 0x8a, 0x50, 0xe8, 0x6a, 0x86, 0x7f, 0x9a, 0x9c, 0x2c,
+	0x25, 0xe0, 0x7f, 0xaf, 0x8f, 0x98, 0xa9, 0x19, 0xdd, 0x17, 0xc8, 0x40, 0x93, 0xa5, 0x99, 0x71,
+	0x0e, 0x99, 0x73, 0x93, 0x8d, 0x15, 0x4f, 0x43, 0xc3, 0x29, 0xb9, 0x8b, 0xf8, 0xfc, 0x6d, 0x96,
+	0xc8, 0x54, 0x6e, 0x15, 0x23, 0xff, 0xc7, 0x28, 0xe4, 0x45, 0x77, 0xcc, 0x9f, 0xa0, 0x57, 0x73,
+	0x5f, 0x27, 0x43, 0xeb, 0x3c, 0x29, 0xb6, 0x3d, 0x9b, 0x9f, 0xc8, 0xb2, 0xcd, 0x64, 0x23, 0x99,
+	0x72, 0xfb, 0x4e, 0xfe, 0x2f, 0x48, 0x8e, 0xee, 0x2e, 0x19, 0x0e, 0xe4, 0x37, 0xed, 0xb7, 0x15,
+	0xd7, 0x61, 0xcc, 0x1f, 0xe1, 0x60, 0x23, 0xbf, 0xa1, 0x62, 0x57, 0xf0, 0x44, 0x1a, 0xd8, 0x97,
+	0x27, 0xd2, 0xb7, 0x1c, 0x42, 0xf2, 0x17, 0xc4, 0xf0, 0x45, 0x8a, 0xf4, 0x59, 0x43, 0x51, 0x61,
+	0x23, 0x27, 0x80, 0xa0, 0xa8, 0xc5, 0xcd, 0x0a, 0x08, 0x28, 0x6e, 0x77, 0x53, 0xae, 0xfc, 0xc8,
+	0x21, 0x27, 0xca, 0x5e, 0x3a, 0x7b, 0x07, 0x5b, 0x7c, 0x50, 0xbd, 0x8a, 0xa8, 0xb0, 0x9a, 0xd0,
+	0x8d, 0xf0, 0x66, 0xc9, 0xd3, 0x0c, 0xbc, 0x00, 0x72, 0x1c, 0xff, 0xcf, 0x87, 0x88, 0x62, 0x7c,
+	0x44, 0x7a, 0x98, 0x27, 0xf0, 0xce, 0xb4, 0x99, 0xcb, 0x5c, 0x0a, 0x0f, 0x18, 0x14, 0x44, 0x29,
+	0xde, 0x9b, 0xa4, 0x0f, 0xbd, 0xd8, 0xb2, 0xd9, 0x2c, 0x94, 0xbe, 0xf6, 0xa0, 0x4a, 0xcb, 0x34,
+	0x3b, 0x03, 0xf7, 0x45, 0xb3, 0x33, 0x68, 0x5f, 0xb3, 0xd3, 0xc2, 0xd0, 0x6d, 0xb6, 0x50, 0x98,
+	0x3a, 0x45, 0x30, 0x1a, 0x3b, 0xb0, 0xa2, 0xb9, 0xda, 0x45, 0x04, 0x4a, 0x08, 0x33, 0x1f, 0x8a,
+	0xb8, 0x49, 0xe7, 0xe0, 0x9a, 0x37, 0x64, 0x2a, 0xe1, 0x81, 0x83, 0x41, 0x96, 0x1f, 0x52, 0x95,
+	0x82, 0xb9, 0x83, 0x7a, 0xeb, 0xaa, 0x46, 0x6c, 0x1d, 0x41, 0xa5, 0x79, 0x71, 0x79, 0x82, 0xb3,
+	0x43, 0x28, 0xc0, 0xbe, 0xe6, 0x90, 0x63, 0x34, 0xaa, 0x25, 0xbb, 0x8c, 0x8e, 0xa0, 0x26, 0x4c,
+	0xdc, 0xd7, 0x6d, 0xac, 0xf5, 0x0b, 0x45, 0xe2, 0xdc, 0x92, 0xd4, 0x05, 0x86, 0xee, 0x66, 0xb8,
+	0x2b, 0x64, 0xb8, 0x16, 0x88, 0x79, 0x31, 0x7a, 0x90, 0x79, 0xc1, 0x0d, 0x75, 0x73, 0x62, 0x36,
+	0x28, 0x22, 0xf8, 0xea, 0xd8, 0xf1, 0x92, 0x26, 0xb1, 0xf0, 0xae, 0x16, 0x2e, 0x80, 0x4b, 0xf5,
+	0xe2, 0xf2, 0xbf, 0x2c, 0xe0, 0xa0, 0x30, 0xdc, 0x55, 0x72, 0x62, 0xab, 0x95, 0xe6, 0x54, 0x30,
+	0xc9, 0x09, 0xbd, 0x29, 0x37, 0x03, 0x69, 0xfe, 0x3e, 0x71, 0xb9, 0x04, 0x07, 0x4a, 0x6b, 0xa2,
+	0xb4, 0x44, 0x23, 0x8c, 0xa7, 0xcd, 0x8b, 0x84, 0xb3, 0x96, 0x92, 0x96, 0x2e, 0x14, 0xca, 0xa1,
+	0xab, 0x06, 0xe6, 0x77, 0x78, 0x08, 0x23, 0xd6, 0x69, 0x52, 0x0d, 0xeb, 0x74, 0xa1, 0x93, 0x66,
+	0x71, 0x8b, 0x26, 0x87, 0xd4, 0xce, 0xce, 0xdc, 0xbe, 0x35, 0xf3, 0x50, 0xb5, 0x37, 0x35, 0xd8,
+	0x8b, 0x95, 0xbf, 0x46, 0x0a, 0xa9, 0x09, 0x99, 0xaf, 0x6d, 0x16, 0x24, 0x59, 0xd1, 0x93, 0x8c,
+	0xb9, 0x44, 0x00, 0x2f, 0xc3, 0x73, 0x0a, 0x33, 0xc2, 0x16, 0xce, 0xa9, 0x0b, 0x51, 0x1d, 0x10,
+	0x8e, 0x8e, 0x72, 0x13, 0x55, 0xa6, 0x11, 0x50, 0x17, 0x02, 0xdb, 0xf9, 0xd6, 0x9f, 0x50, 0xf9,
+	0x43, 0x0a, 0x5b, 0xbb, 0x99, 0xf1, 0xc3, 0xff, 0x08, 0x99, 0xaa, 0xd2, 0x56, 0xd0, 0x6e, 0xb0,
+	0x50, 0x6a, 0xee, 0x54, 0x86, 0x39, 0xdb, 0x24, 0xac, 0xf8, 0x02, 0xa3, 0x42, 0x86, 0x1c, 0x07,
+	0x93, 0x4a, 0x72, 0xd7, 0x38, 0x19, 0x1b, 0x3a, 0x2a, 0x9d, 0xd5, 0x78, 0x9c, 0x12, 0xff, 0xc7,
+	0xff, 0x56, 0x85, 0x8c, 0xe5, 0xf5, 0xe9, 0x86, 0xbb, 0x49, 0x26, 0x6b, 0x5a, 0xc4, 0x60, 0x1e,
+	0xab, 0xb1, 0xff, 0xe0, 0x42, 0xfe, 0x0c, 0x84, 0x49, 0x04, 0x8a, 0x54, 0x0f, 0xee, 0x6d, 0xf8,
+	0x7a, 0xc1, 0xdb, 0xd0, 0xca, 0xd3, 0x4e, 0x68, 0x54, 0x55, 0xbe, 0x8a, 0x74, 0x43, 0xba, 0x41,
+	0x74, 0x39, 0x2f, 0x7e, 0xbe, 0x42, 0x26, 0xd5, 0x38, 0x09, 0xd3, 0xeb, 0x9b, 0x45, 0x1f, 0x43,
+	0x0b, 0xca, 0xf9, 0xe2, 0x87, 0xdf, 0xc3, 0xcf, 0xf0, 0xcd, 0xa2, 0x9f, 0xe1, 0x91, 0xb2, 0xef,
+	0xb2, 0x26, 0x7f, 0xab, 0x42, 0x86, 0x55, 0x52, 0xa8, 0x17, 0xc8, 0x00, 0xbb, 0x8c, 0xdf, 0xdb,
+	0x95, 0x82, 0x5d, 0xec, 0x81, 0x53, 0x42, 0x92, 0x7c, 0x51, 0x57, 0xee, 0x85, 0xa4, 0xb1, 0x05,
+	0x5c, 0xe6, 0x5b, 0x40, 0xdf, 0x21, 0x09, 0x0e, 0xe9, 0x1b, 0x06, 0x4b, 0x8a, 0xc8, 0x45, 0xc8,
+	0xc2, 0xb3, 0x7c, 0x42, 0x7e, 0x14, 0xa5, 0xfe, 0x3c, 0x31, 0x92, 0x0a, 0x1f, 0x2a, 0x36, 0xe4,
+	0x97, 0xfb, 0xc8, 0x20, 0xcb, 0x8d, 0x97, 0xb9, 0xdf, 0x74, 0xc8, 0xf1, 0x9d, 0xc2, 0xd3, 0x1b,
+	0xf9, 0x22, 0xbd, 0x6e, 0x4f, 0xb5, 0xad, 0x11, 0xcf, 0x15, 0x7a, 0x25, 0x85, 0x50, 0xd6, 0x1c,
+	0x23, 0xfb, 0x7d, 0xdf, 0x91, 0x64, 0xbf, 0xbf, 0x79, 0xc4, 0xf1, 0x2b, 0xe3, 0xbd, 0x62, 0x57,
+	0xfc, 0xdf, 0x1b, 0x20, 0x84, 0x7f, 0x8d, 0x95, 0x76, 0xb6, 0x1f, 0x65, 0xe5, 0x73, 0x64, 0x6c,
+	0x93, 0x46, 0x34, 0x91, 0xde, 0x96, 0x85, 0x57, 0x23, 0x97, 0xb5, 0x32, 0x30, 0x30, 0xd9, 0x64,
+	0x41, 0x7f, 0x11, 0x7e, 0x7b, 0x28, 0xc6, 0xa8, 0xa8, 0x12, 0xd0, 0xb0, 0xdc, 0x59, 0xc3, 0x96,
+	0xc4, 0xdd, 0x12, 0x26, 0xf6, 0x30, 0xfd, 0xbc, 0x8f, 0x4c, 0x98, 0xb9, 0x68, 0x84, 0x0c, 0xab,
+	0xdc, 0x08, 0xcc, 0x14, 0x36, 0x50, 0xc0, 0xc6, 0x85, 0x50, 0x4f, 0x76, 0xa1, 0x13, 0x09, 0x61,
+	0x56, 0x2d, 0x84, 0x45, 0x06, 0x05, 0x51, 0x8a, 0xa3, 0xc0, 0x8f, 0x75, 0x0e, 0x17, 0x89, 0x40,
+	0xf2, 0x24, 0x1e, 0x5a, 0x19, 0x18, 0x98, 0xc8, 0x41, 0x28, 0x7b, 0x89, 0xb9, 0xd4, 0x0a, 0x1a,
+	0xda, 0x36, 0x99, 0x88, 0x4d, 0x25, 0x15, 0x97, 0xec, 0xde, 0xbd, 0xcf, 0xa9, 0x67, 0xd4, 0xe5,
+	0xee, 0x1f, 0x26, 0x0c, 0x0a, 0xf4, 0x51, 0x9a, 0xd7, 0x43, 0x39, 0xc6, 0x4c, 0x67, 0xdd, 0x9e,
+	0xd1, 0x16, 0xab, 0xe4, 0x44, 0x3b, 0xae, 0xaf, 0x26, 0x61, 0x8c, 0x16, 0xdf, 0x85, 0x66, 0x90,
+	0xa6, 0x6c, 0x62, 0x8c, 0x9b, 0x52, 0xde, 0x6a, 0x09, 0x0e, 0x94, 0xd6, 0xc4, 0x6b, 0x5e, 0x5b,
+	0x00, 0x99, 0xcb, 0xdc, 0x00, 0x3f, 0xc9, 0x24, 0x22, 0xa8, 0x52, 0xff, 0x38, 0x39, 0x56, 0xed,
+	0xb4, 0xdb, 0xcd, 0x90, 0xd6, 0x95, 0xad, 0xc6, 0xff, 0x39, 0x32, 0x29, 0xd2, 0x33, 0x2b, 0xe9,
+	0xe7, 0x40, 0x2f, 0xb9, 0xf8, 0x3f, 0x43, 0x26, 0x0b, 0x47, 0xe9, 0x5d, 0xfc, 0x48, 0xfc, 0xff,
+	0xd2, 0x47, 0x26, 0x0b, 0x2e, 0x4d, 0x68, 0x85, 0x34, 0xa5, 0x1c, 0x3b, 0x59, 0xde, 0x35, 0xf9,
+	0x46, 0xa4, 0x8a, 0x2d, 0x93, 0x98, 0x1a, 0x32, 0x1e, 0xc1, 0x5a, 0xd8, 0x10, 0xf3, 0xda, 0xe7,
+	0xe7, 0x90, 0x11, 0xd4, 0xf0, 0x51, 0x42, 0x14, 0x5b, 0x99, 0xa9, 0xc0, 0x76, 0x3f, 0xd9, 0x8a,
+	0x57, 0x90, 0x14, 0x34, 0x8e, 0x6e, 0x44, 0x86, 0x58, 0x43, 0xa8, 0x8c, 0x55, 0xb5, 0xd6, 0x57,
+	0x9e, 0xb9, 0x9c, 0xd3, 0x06, 0xc9, 0xc4, 0xff, 0x74, 0x85, 0x94, 0xfb, 0xcd, 0xb9, 0x1f, 0xed,
+	0xfe, 0xe0, 0x2f, 0x58, 0x1c, 0x08, 0xce, 0x65, 0x8f, 0x6f, 0x1e, 0x99, 0xdf, 0xfc, 0xaa, 0xa5,
+	0x71, 0x10, 0x7c, 0xbb, 0xbe, 0xbc, 0xff, 0x3f, 0x1c, 0x32, 0xba, 0xb6, 0x76, 0x45, 0x09, 0x03,
+	0x40, 0x4e, 0xa5, 0x3c, 0x0d, 0x04, 0x73, 0x2f, 0x58, 0x88, 0x5b, 0x6d, 0xee, 0x6d, 0xe0, 0x39,
+	0xf9, 0x43, 0x0e, 0xd5, 0x52, 0x0c, 0xe8, 0x51, 0xd3, 0xbd, 0x44, 0x8e, 0xeb, 0x25, 0x55, 0xed,
+	0x59, 0xed, 0x01, 0x91, 0x15, 0xaa, 0xbb, 0x18, 0xca, 0xea, 0x14, 0x49, 0x09, 0xad, 0xba, 0xd7,
+	0x57, 0x4e, 0x4a, 0x14, 0x43, 0x59, 0x1d, 0x7f, 0x85, 0x8c, 0xae, 0x05, 0x89, 0xea, 0xf8, 0xfb,
+	0xc9, 0x54, 0x2d, 0x6e, 0x49, 0x01, 0xe7, 0x0a, 0xdd, 0xa6, 0x4d, 0xd1, 0x65, 0xfe, 0x58, 0x5d,
+	0xa1, 0x0c, 0xba, 0xb0, 0xfd, 0x5f, 0x3f, 0x4b, 0x54, 0x58, 0xeb, 0x3e, 0xce, 0xe0, 0xb6, 0xf2,
+	0x28, 0x1e, 0xb0, 0xec, 0x51, 0xac, 0x4e, 0xa3, 0x82, 0x57, 0x71, 0x96, 0x7b, 0x15, 0x0f, 0xda,
+	0xf6, 0x2a, 0x56, 0x62, 0x79, 0x97, 0x67, 0xf1, 0x97, 0x1d, 0x32, 0x86, 0xc6, 0x01, 0x65, 0x06,
+	0x1e, 0x62, 0x2b, 0xfc, 0x03, 0xf6, 0x02, 0x34, 0x66, 0xaf, 0x69, 0xe4, 0xb9, 0xb7, 0xbb, 0x3a,
+	0xc4, 0xf5, 0x22, 0x30, 0xda, 0xe1, 0x2e, 0x69, 0xfa, 0x75, 0x6e, 0xc6, 0x7a, 0xb8, 0xec, 0x46,
+	0x79, 0x57, 0x65, 0xf9, 0x4d, 0x4d, 0xb2, 0x1c, 0xb1, 0xa5, 0x37, 0x96, 0xb1, 0x8a, 0x9a, 0x35,
+	0x4e, 0x40, 0x34, 0x89, 0xd3, 0x27, 0x83, 0xdc, 0x2d, 0x5e, 0xe4, 0x1f, 0x63, 0x46, 0x62, 0xee,
+	0x32, 0x0f, 0xa2, 0xc4, 0xcd, 0xa4, 0xab, 0xc9, 0xa8, 0xad, 0x97, 0xc5, 0x0c, 0x57, 0x96, 0x72,
+	0x5f, 0x13, 0xf7, 0x79, 0x5d, 0x53, 0x31, 0xb6, 0x1f, 0x4d, 0xc5, 0x78, 0x4f, 0x2d, 0xc5, 0xe7,
+	0x1c, 0x32, 0x56, 0xd3, 0x5e, 0xfa, 0xf2, 0x9e, 0x3c, 0xeb, 0xd8, 0x09, 0x08, 0x2d, 0x7b, 0x90,
+	0x8d, 0xdb, 0x1e, 0xf5, 0x12, 0x30, 0xb8, 0xb3, 0xa4, 0xab, 0x4c, 0x2d, 0xe3, 0x8d, 0xdb, 0x4a,
+	0x66, 0x62, 0xaa, 0x79, 0xa4, 0xcb, 0x2e, 0xc2, 0x40, 0xf0, 0x72, 0xdf, 0xc0, 0xb4, 0x85, 0x42,
+	0x59, 0x33, 0x61, 0xcb, 0xf1, 0xae, 0x68, 0x71, 0x96, 0x99, 0x1a, 0x39, 0x14, 0x14, 0x47, 0xb7,
+	0x41, 0xfa, 0xea, 0xc1, 0xa6, 0x37, 0x69, 0xeb, 0x4c, 0xd2, 0xf2, 0xf1, 0xf2, 0x4b, 0xec, 0xe2,
+	0xdc, 0x32, 0x20, 0x0b, 0xf7, 0x66, 0xfe, 0x54, 0xd2, 0x94, 0xb5, 0xd3, 0xd7, 0x14, 0x24, 0xb9,
+	0x4c, 0xd0, 0xf5, 0xf2, 0x52, 0x5d, 0x18, 0xe9, 0x7f, 0xf2, 0xac, 0x63, 0x27, 0xdd, 0x36, 0x8a,
+	0x9e, 0x3c, 0x39, 0x4e, 0x6e, 0xe8, 0x47, 0x2e, 0x8d, 0x2c, 0x6b, 0x7b, 0x3f, 0x65, 0x8b, 0x0b,
+	0x4b, 0xf1, 0xc2, 0xb8, 0xe0, 0x7f, 0xc0, 0xa8, 0x63, 0xb4, 0x4a, 0x9b, 0xf9, 0x0f, 0x79, 0x3f,
+	0x6d, 0xeb, 0x6c, 0xe1, 0xfe, 0x48, 0x7c, 0x6e, 0xf2, 0xff, 0x41, 0xf0, 0x70, 0x2f, 0x90, 0x21,
+	0xfe, 0xe2, 0x1f, 0x8f, 0x05, 0x19, 0x3d, 0x3f, 0xdd, 0xfb, 0xdd, 0xc0, 0xfc, 0xa0, 0xe0, 0xbf,
+	0x53, 0x90, 0x75, 0xdd, 0xcf, 0x3b, 0x64, 0x02, 0x77, 0xd4, 0x85, 0xfc, 0x35, 0x44, 0xd7, 0xd6,
+	0x9e, 0x85, 0xb9, 0xcd, 0xf2, 0xbd, 0x46, 0x5d, 0x24, 0x2f, 0x19, 0xec, 0xa0, 0xc0, 0xde, 0x7d,
+	0x93, 0x0c, 0xa7, 0x61, 0x9d, 0xd6, 0x82, 0x24, 0xf5, 0x8e, 0x1f, 0x4d, 0x53, 0x72, 0xb3, 0xa0,
+	0x60, 0x04, 0x8a, 0xa5, 0xfb, 0x6b, 0xec, 0x09, 0xf9, 0x5a, 0x23, 0xdc, 0xa6, 0xf8, 0xf8, 0x11,
+	0x93, 0xc2, 0x4e, 0xd8, 0x5a, 0xfb, 0xd2, 0x00, 0x2a, 0x29, 0x0b, 0x6b, 0x99, 0xc9, 0x0e, 0x8a,
+	0xfc, 0xdd, 0xbf, 0xed, 0xf4, 0x7a, 0x6c, 0xe8, 0xe4, 0x21, 0x95, 0x58, 0x07, 0x7f, 0x9e, 0xe8,
+	0x33, 0x5d, 0x2f, 0x4a, 0x9e, 0xb2, 0x6a, 0x1e, 0xdf, 0xff, 0x2b, 0x92, 0xee, 0x33, 0x64, 0xb4,
+	0x2d, 0x8e, 0xc3, 0x30, 0x6d, 0xb1, 0x90, 0xa4, 0x3e, 0x1e, 0x2c, 0xba, 0x9a, 0x83, 0x41, 0xc7,
+	0x31, 0xf2, 0x7c, 0x3f, 0xb5, 0x57, 0x9e, 0x6f, 0xf7, 0x3a, 0x19, 0xcd, 0xe2, 0xa6, 0x48, 0x75,
+	0x9b, 0x7a, 0x1e, 0x9b, 0x81, 0x67, 0xca, 0xd6, 0xd6, 0x9a, 0x42, 0xcb, 0xef, 0xfa, 0x39, 0x2c,
+	0x05, 0x9d, 0x0e, 0x73, 0x03, 0x17, 0xe6, 0x8a, 0x84, 0x5d, 0xf2, 0x1f, 0x2c, 0xb8, 0x81, 0xeb,
+	0x85, 0x60, 0xe2, 0xa2, 0xe7, 0x4d, 0xbb, 0x4b, 0x4b, 0xc0, 0x43, 0x21, 0x95, 0xe7, 0x4d, 0xb7,
+	0x8a, 0xa0, 0xbb, 0x4e, 0x8f, 0x5c, 0xd6, 0x0f, 0x1f, 0x26, 0x97, 0xb5, 0x5b, 0x27, 0x0f, 0x07,
+	0x9d, 0x2c, 0x66, 0xc9, 0x89, 0xcc, 0x2a, 0xdc, 0xcf, 0xfd, 0x2c, 0x77, 0x9d, 0xbf, 0x7d, 0x6b,
+	0xe6, 0xe1, 0xb9, 0x3d, 0xf0, 0x60, 0x4f, 0x2a, 0x98, 0xae, 0x8e, 0x8a, 0x7c, 0xdc, 0xde, 0x4f,
+	0xd8, 0x3a, 0xfa, 0xcd, 0x0c, 0xdf, 0xd2, 0x85, 0x98, 0xc3, 0x40, 0xf1, 0x73, 0xd7, 0xc8, 0x68,
+	0x23, 0x4e, 0xb3, 0xb9, 0x66, 0xc8, 0xde, 0x69, 0x79, 0xe4, 0x6c, 0x5f, 0x2f, 0x89, 0xea, 0xa2,
+	0x44, 0xcb, 0x67, 0xc2, 0xc5, 0xbc, 0x26, 0xe8, 0x64, 0x5c, 0x4a, 0x26, 0xa5, 0x93, 0xbf, 0x34,
+	0xeb, 0x9d, 0x61, 0x1d, 0x7b, 0xa2, 0x8c, 0xf2, 0x6a, 0x5c, 0xaf, 0x9a, 0xd8, 0xca, 0xf6, 0xad,
+	0x03, 0xa1, 0x48, 0x13, 0xf5, 0x6c, 0xed, 0xb8, 0x8e, 0x2f, 0xa3, 0xad, 0x06, 0x98, 0x2a, 0x79,
+	0xc6, 0xd4, 0x36, 0xae, 0x6a, 0x65, 0x60, 0x60, 0xa2, 0xe7, 0x5e, 0x8b, 0x67, 0xad, 0xf0, 0x1e,
+	0xb5, 0x75, 0x63, 0x11, 0x69, 0x30, 0x84, 0x66, 0x80, 0xff, 0x00, 0xc9, 0xc6, 0xfd, 0x47, 0x0e,
+	0x99, 0x2c, 0x84, 0xce, 0x79, 0x8f, 0xd9, 0xb4, 0xed, 0x68, 0x84, 0xe7, 0x9f, 0x60, 0xc3, 0x67,
+	0x02, 0xef, 0x74, 0x83, 0xa0, 0xd8, 0x22, 0x3e, 0x2e, 0x2c, 0xf5, 0x8c, 0xf7, 0xb8, 0xbd, 0x71,
+	0x61, 0x04, 0xe5, 0xb8, 0xb0, 0x1f, 0x20, 0xd9, 0xa0, 0x43, 0x81, 0xc8, 0x12, 0xe9, 0x3d, 0x61,
+	0x3a, 0x14, 0x88, 0x64, 0x92, 0x20, 0xcb, 0xbb, 0xd2, 0xc9, 0x3c, 0x6d, 0x2b, 0x9d, 0x8c, 0xba,
+	0xef, 0x1d, 0x3c, 0x9d, 0x0c, 0xbe, 0xca, 0xd3, 0x75, 0x4b, 0x3c, 0x50, 0x3e, 0x97, 0x7b, 0xcc,
+	0x07, 0x83, 0xcf, 0x13, 0xe8, 0x09, 0x04, 0xac, 0xbf, 0xec, 0xf3, 0x1c, 0x19, 0xab, 0xf1, 0x77,
+	0xd0, 0x79, 0x0a, 0x82, 0x7e, 0x53, 0x99, 0xbd, 0xa0, 0x95, 0x81, 0x81, 0xe9, 0x5f, 0x24, 0x6e,
+	0xf7, 0xb3, 0x0b, 0x87, 0xb2, 0x0a, 0xfd, 0x13, 0x87, 0x8c, 0x1b, 0xe2, 0x8d, 0x75, 0x8b, 0xf5,
+	0x12, 0x71, 0x5b, 0x61, 0x92, 0xc4, 0x89, 0xfe, 0xe0, 0xb4, 0x48, 0x13, 0xc2, 0xfc, 0x63, 0xae,
+	0x76, 0x95, 0x42, 0x49, 0x0d, 0xff, 0x9f, 0xf5, 0x93, 0x3c, 0x30, 0x40, 0x25, 0xa5, 0x76, 0x7a,
+	0x26, 0xa5, 0x7e, 0x9a, 0x0c, 0x63, 0xd0, 0xcc, 0x6a, 0x9e, 0xba, 0x5a, 0x7d, 0x8b, 0xe7, 0xab,
+	0x2b, 0xd7, 0x18, 0xa6, 0xc2, 0x60, 0xd8, 0xaf, 0x2e, 0x85, 0xcd, 0xac, 0x3b, 0xb7, 0xf1, 0xf3,
+	0x2f, 0x70, 0x38, 0x28, 0x0c, 0xf6, 0xf6, 0xf4, 0x36, 0x55, 0x56, 0x8e, 0xfc, 0xed, 0x69, 0xfe,
+	0xa2, 0x0a, 0x2b, 0x43, 0xe3, 0xb4, 0xb2, 0x90, 0x08, 0xb3, 0x8b, 0x1a, 0x29, 0x65, 0x46, 0x81,
+	0x1c, 0x87, 0xc9, 0xae, 0x42, 0xab, 0xee, 0x0d, 0xda, 0x8a, 0x94, 0xee, 0xd2, 0xd3, 0xf3, 0x03,
+	0x4b, 0x82, 0x41, 0xb1, 0x2c, 0xb3, 0xda, 0x8f, 0x1c, 0x89, 0xd5, 0x5e, 0x8b, 0x52, 0x19, 0xd8,
+	0x6f, 0x94, 0x8a, 0x39, 0xb7, 0x87, 0xf7, 0x35, 0xb7, 0x3f, 0xd9, 0x47, 0x86, 0x5e, 0xa4, 0x09,
+	0xfe, 0x8f, 0x9b, 0xe1, 0x36, 0xff, 0xb7, 0x18, 0xe2, 0x2c, 0x30, 0x40, 0x96, 0xe3, 0x77, 0x5b,
+	0xef, 0x84, 0xcd, 0xfa, 0x62, 0xbe, 0x8a, 0xd5, 0x77, 0x9b, 0x97, 0x05, 0x90, 0xe3, 0x60, 0x85,
+	0x4d, 0xbc, 0x84, 0xb4, 0xd0, 0x1f, 0xb6, 0xe0, 0xda, 0xb7, 0x2c, 0x0b, 0x20, 0xc7, 0x41, 0x5b,
+	0xd4, 0x66, 0x98, 0xad, 0x05, 0x9b, 0x45, 0xb3, 0xef, 0x32, 0x83, 0x82, 0x28, 0x65, 0x36, 0xbf,
+	0x30, 0x5b, 0x4b, 0x28, 0x53, 0x42, 0x77, 0x65, 0x58, 0x59, 0xd6, 0xca, 0xc0, 0xc0, 0x64, 0x4d,
+	0x8a, 0x45, 0xcf, 0xbc, 0xc1, 0x42, 0x93, 0x64, 0x01, 0xe4, 0x38, 0x38, 0xff, 0x51, 0x3b, 0x1a,
+	0x36, 0x85, 0xc7, 0xbd, 0x36, 0xff, 0x17, 0x04, 0x1c, 0x14, 0x06, 0x62, 0xe3, 0x16, 0x86, 0xdb,
+	0x4f, 0xf1, 0x9d, 0xdf, 0x55, 0x01, 0x07, 0x85, 0xe1, 0xbf, 0x48, 0xc6, 0xf9, 0x4a, 0x5e, 0x68,
+	0x06, 0x61, 0x6b, 0x79, 0xc1, 0xbd, 0xd0, 0x15, 0xa5, 0xf2, 0x54, 0x49, 0x94, 0xca, 0x49, 0xa3,
+	0x52, 0x77, 0xb4, 0x8a, 0xff, 0xfd, 0x0a, 0x19, 0xbe, 0x8f, 0x4f, 0xa5, 0xb7, 0x8d, 0xa7, 0xd2,
+	0x6d, 0x3f, 0x98, 0x5d, 0xf6, 0x4c, 0xfa, 0xcd, 0xc2, 0x33, 0xe9, 0xab, 0x16, 0x79, 0xee, 0xfd,
+	0x44, 0xfa, 0x9f, 0x55, 0xc8, 0x29, 0x89, 0x2a, 0xaf, 0x9d, 0xcb, 0x0b, 0xec, 0x7d, 0xbb, 0xa3,
+	0x1f, 0xe8, 0xc4, 0x18, 0xe8, 0x55, 0x7b, 0x17, 0xe7, 0xe5, 0x85, 0x9e, 0x43, 0xfd, 0x5a, 0x61,
+	0xa8, 0xc1, 0x2a, 0xd7, 0xbd, 0x07, 0xfb, 0x2f, 0x1d, 0x32, 0x5d, 0x3e, 0xd8, 0xf7, 0xe1, 0x65,
+	0xfa, 0x37, 0xcd, 0x97, 0xe9, 0x7f, 0xde, 0xde, 0x14, 0x33, 0xbb, 0xd2, 0xe3, 0x8d, 0xfa, 0xff,
+	0xee, 0x90, 0x13, 0xb2, 0x02, 0x3b, 0x3d, 0xe7, 0xc3, 0x88, 0x79, 0x26, 0x1d, 0xfd, 0x34, 0x7b,
+	0xc3, 0x98, 0x66, 0x2f, 0xdb, 0xeb, 0xb8, 0xde, 0x8f, 0x5e, 0x13, 0xce, 0xff, 0x0b, 0x87, 0x78,
+	0x65, 0x15, 0xee, 0xc3, 0x27, 0x7f, 0xdd, 0xfc, 0xe4, 0x2f, 0x1e, 0x4d, 0xcf, 0x7b, 0x7f, 0x70,
+	0xaf, 0xd7, 0x40, 0xb9, 0x4d, 0x29, 0x57, 0x39, 0xb6, 0xcc, 0xe7, 0x9c, 0x45, 0xb9, 0x80, 0xd6,
+	0x24, 0x83, 0xec, 0x49, 0x50, 0xe9, 0x1e, 0x76, 0xd1, 0x86, 0xb4, 0x85, 0xf4, 0x84, 0x39, 0x80,
+	0xfd, 0x0f, 0x82, 0x87, 0xff, 0x9b, 0x15, 0x72, 0x5a, 0x76, 0x9c, 0x59, 0x1f, 0xf3, 0xf5, 0xc1,
+	0x1e, 0x40, 0x09, 0xd4, 0x4f, 0x7b, 0x0f, 0xa0, 0xe4, 0x2c, 0xf2, 0xb5, 0x90, 0xc3, 0x40, 0xe3,
+	0x89, 0x51, 0xee, 0xec, 0xc1, 0x92, 0xa5, 0x30, 0x0a, 0x9a, 0xe1, 0x6b, 0x34, 0x01, 0xda, 0x8a,
+	0xb7, 0x83, 0xa6, 0x90, 0xd4, 0x55, 0x94, 0xfb, 0x52, 0x19, 0x12, 0x94, 0xd7, 0xed, 0x52, 0x23,
+	0xf4, 0xed, 0x57, 0x8d, 0xe0, 0xff, 0xb1, 0x43, 0xc6, 0xd4, 0x68, 0x1d, 0xfd, 0x92, 0x88, 0xcd,
+	0x25, 0xf1, 0xbc, 0xbd, 0x25, 0xd1, 0x63, 0x19, 0xdc, 0x1a, 0x20, 0x53, 0x12, 0x45, 0x65, 0x75,
+	0xfd, 0x94, 0xa3, 0x9c, 0x94, 0xb8, 0x33, 0xe8, 0x07, 0xed, 0xb5, 0xe3, 0x20, 0x99, 0x54, 0xd1,
+	0xeb, 0xde, 0xd0, 0x07, 0x54, 0x6c, 0x25, 0x3d, 0xeb, 0x6a, 0xcd, 0x21, 0xd2, 0xcc, 0x7e, 0xd9,
+	0x21, 0x84, 0xb7, 0x53, 0x64, 0xa7, 0xc7, 0xb6, 0xad, 0x1f, 0xd9, 0x48, 0x21, 0x13, 0xde, 0x34,
+	0xb5, 0x84, 0xf2, 0x02, 0xd0, 0x5a, 0x72, 0x0f, 0xf9, 0x63, 0xef, 0x39, 0x75, 0xed, 0xe7, 0x1d,
+	0x32, 0x59, 0x68, 0x6e, 0x49, 0xfd, 0x0d, 0xf3, 0x09, 0x4f, 0x0b, 0x92, 0x95, 0x99, 0xb3, 0x5c,
+	0x57, 0x9e, 0xfc, 0x0b, 0x3f, 0x5f, 0xc0, 0x6c, 0x6f, 0x7f, 0x9d, 0x8c, 0x48, 0xcd, 0x87, 0x9c,
+	0xde, 0x36, 0x9f, 0x32, 0x56, 0xd7, 0x1b, 0x09, 0x49, 0x21, 0xe7, 0x57, 0xf0, 0x81, 0xac, 0xec,
+	0xcb, 0x07, 0xf2, 0x9d, 0x7d, 0x08, 0xb9, 0x5c, 0xd9, 0xde, 0x7f, 0x24, 0xca, 0xf6, 0x87, 0xad,
+	0x2b, 0xdb, 0x1f, 0xb9, 0xcf, 0xca, 0x76, 0xcd, 0x9e, 0x39, 0x70, 0x0f, 0xf6, 0xcc, 0xd7, 0xc9,
+	0x89, 0xed, 0xfc, 0xd2, 0xa9, 0x66, 0x92, 0x48, 0xb5, 0xf5, 0x54, 0xa9, 0x8a, 0x1d, 0x2f, 0xd0,
+	0x69, 0x46, 0xa3, 0x4c, 0xbb, 0xae, 0xe6, 0xee, 0x97, 0x2f, 0x96, 0x90, 0x83, 0x52, 0x26, 0x45,
+	0xc3, 0xd4, 0xd0, 0x3e, 0x0c, 0x53, 0xdf, 0x46, 0xd3, 0x5e, 0x57, 0x58, 0x24, 0x6a, 0x6e, 0x86,
+	0x6d, 0x85, 0x73, 0xcd, 0x95, 0x91, 0x17, 0x16, 0xc0, 0xb2, 0x22, 0x28, 0x6f, 0x10, 0xc6, 0x92,
+	0x48, 0x2f, 0x01, 0xee, 0xb4, 0x5b, 0x6e, 0xd2, 0xff, 0x5a, 0xd1, 0xf5, 0x88, 0xbf, 0x6f, 0xff,
+	0x61, 0xbb, 0xb7, 0x6d, 0x0b, 0xee, 0x47, 0xa3, 0xf7, 0xe0, 0x7e, 0x54, 0xb0, 0x12, 0x8e, 0x59,
+	0xb2, 0x12, 0x46, 0x64, 0x2a, 0x6c, 0x05, 0x9b, 0x74, 0xb5, 0xd3, 0x6c, 0xf2, 0x38, 0x27, 0xf9,
+	0xd8, 0x74, 0xa9, 0x06, 0x0f, 0x0d, 0xc4, 0x4d, 0x91, 0x49, 0x44, 0x39, 0x2c, 0xab, 0x78, 0xae,
+	0x4b, 0x05, 0x4a, 0xd0, 0x45, 0x1b, 0x27, 0x2c, 0xcb, 0xf9, 0x48, 0x33, 0x1c, 0x6d, 0xe6, 0xe3,
+	0x32, 0x3c, 0x3f, 0x29, 0xcd, 0x57, 0x02, 0x0c, 0x3a, 0x8e, 0x7b, 0x99, 0x8c, 0xd4, 0xa3, 0x54,
+	0x44, 0x78, 0x4f, 0xb2, 0xcd, 0xec, 0x5d, 0xb8, 0x05, 0x2e, 0x5e, 0xab, 0xaa, 0xd8, 0xee, 0x87,
+	0x4b, 0x92, 0x98, 0xaa, 0x72, 0xc8, 0xeb, 0xbb, 0x57, 0x19, 0x31, 0xf1, 0x8c, 0x1e, 0x77, 0x3d,
+	0x39, 0xdb, 0xc3, 0x0a, 0xb6, 0x78, 0x4d, 0x3e, 0x04, 0x38, 0x2e, 0xd8, 0xf1, 0x9f, 0x90, 0x53,
+	0xd0, 0x1e, 0xfd, 0x3e, 0xb6, 0xe7, 0xa3, 0xdf, 0x2c, 0x7b, 0x71, 0xd6, 0x54, 0x96, 0xec, 0x33,
+	0xd6, 0xb2, 0x17, 0xe7, 0x4e, 0x9d, 0x22, 0x7b, 0x71, 0x0e, 0x00, 0x9d, 0xa5, 0xbb, 0xd2, 0xcb,
+	0xa2, 0x7f, 0x9c, 0x6d, 0x1a, 0x07, 0xb7, 0xcf, 0xeb, 0xae, 0xdf, 0x27, 0xf6, 0x72, 0xfd, 0xee,
+	0x36, 0x45, 0x9f, 0x3c, 0x80, 0x29, 0xba, 0xc1, 0xf2, 0xca, 0x2e, 0x2f, 0x78, 0xa7, 0x6c, 0xdd,
+	0xef, 0x58, 0x26, 0x1b, 0xee, 0x24, 0xcb, 0xfe, 0x05, 0xce, 0xa0, 0xa7, 0x77, 0xfc, 0xe9, 0x43,
+	0x7b, 0xc7, 0x17, 0xec, 0xb9, 0x0f, 0x1e, 0x99, 0x3d, 0x77, 0xfa, 0x3e, 0xd8, 0x73, 0x1f, 0xda,
+	0xb7, 0x3d, 0xf7, 0x26, 0x39, 0xde, 0x8e, 0xeb, 0x8b, 0x61, 0x9a, 0x74, 0x58, 0x14, 0xe7, 0x7c,
+	0xa7, 0xbe, 0x49, 0x33, 0x66, 0x10, 0x1e, 0x3d, 0xff, 0x2e, 0xbd, 0x91, 0x6d, 0xb6, 0x2a, 0xe5,
+	0x82, 0x2b, 0x54, 0x40, 0x82, 0xdc, 0xdb, 0xb7, 0xa4, 0x10, 0xca, 0x58, 0xe8, 0x96, 0xe4, 0xb3,
+	0xf7, 0xc7, 0x92, 0xfc, 0x7e, 0x32, 0x9c, 0x36, 0x3a, 0x59, 0x3d, 0xde, 0x89, 0x98, 0xbb, 0xc0,
+	0xc8, 0xfc, 0x63, 0x4a, 0x2f, 0x2d, 0xe0, 0x77, 0x30, 0xbd, 0x88, 0xf8, 0x5f, 0x53, 0x49, 0x0b,
+	0x88, 0xfb, 0xf5, 0x1e, 0x91, 0x55, 0xfe, 0x51, 0x46, 0x56, 0x9d, 0x3e, 0x50, 0x54, 0x55, 0x99,
+	0xb9, 0xfc, 0xd1, 0x1f, 0x3b, 0x73, 0xf9, 0x57, 0x1d, 0x32, 0xbe, 0xad, 0xeb, 0xff, 0xbd, 0xc7,
+	0x6c, 0x39, 0x0c, 0x19, 0x66, 0x85, 0x79, 0x1f, 0x37, 0x2d, 0x03, 0x74, 0xa7, 0x08, 0x00, 0xb3,
+	0x25, 0x25, 0xce, 0x4c, 0x8f, 0xbf, 0x53, 0xce, 0x4c, 0x6f, 0x92, 0xd1, 0x76, 0x5c, 0x97, 0x37,
+	0x56, 0x66, 0xe7, 0xb7, 0xeb, 0xcb, 0xcc, 0xe5, 0xcf, 0x9c, 0x05, 0xe8, 0xfc, 0xd0, 0xcf, 0x77,
+	0x4a, 0x5e, 0xb2, 0x84, 0xfd, 0x2e, 0xf5, 0x7e, 0xd2, 0x56, 0x23, 0xd4, 0xdd, 0x8e, 0xb9, 0xf3,
+	0xaf, 0x15, 0xf8, 0x40, 0x17, 0x67, 0x14, 0x48, 0x94, 0xf3, 0xdb, 0x66, 0xea, 0x3d, 0x99, 0x0b,
+	0x24, 0x73, 0x39, 0x18, 0x74, 0x1c, 0xf7, 0x1b, 0x0e, 0x19, 0x68, 0xc4, 0xf1, 0x56, 0xea, 0x3d,
+	0xc5, 0x36, 0xf4, 0x97, 0x2c, 0x0b, 0x9a, 0xf8, 0x50, 0x86, 0xd0, 0x6c, 0x3c, 0x23, 0x15, 0x41,
+	0x0c, 0x86, 0x8f, 0xa4, 0x1b, 0x4f, 0x6f, 0xa5, 0x6f, 0xbd, 0xad, 0x41, 0x84, 0xa2, 0x92, 0x35,
+	0xcd, 0xfd, 0xa2, 0x43, 0xa6, 0x76, 0x0a, 0xda, 0x09, 0xef, 0xa7, 0x6c, 0xd9, 0x29, 0x8a, 0x7a,
+	0x0f, 0x3e, 0xdc, 0x45, 0x28, 0x74, 0xb5, 0xc0, 0xfd, 0xac, 0xa9, 0xb5, 0xe4, 0x7e, 0xab, 0x16,
+	0x07, 0xb0, 0xa0, 0x25, 0xe5, 0xe1, 0x48, 0xe5, 0xea, 0xcb, 0x7b, 0x77, 0x16, 0xc1, 0xce, 0xe4,
+	0x1f, 0xab, 0xa4, 0x2a, 0x35, 0x95, 0x27, 0x16, 0x16, 0xbb, 0xf1, 0xf9, 0x75, 0xdd, 0xc9, 0x17,
+	0x4f, 0x91, 0x09, 0xd3, 0x50, 0xe7, 0xbe, 0xdb, 0x7c, 0x27, 0xe5, 0x4c, 0xf1, 0xc9, 0x89, 0x71,
+	0x89, 0x6f, 0x3c, 0x3b, 0x61, 0xbc, 0x0b, 0x51, 0x39, 0xd2, 0x77, 0x21, 0xfa, 0xee, 0xcf, 0xbb,
+	0x10, 0x53, 0x47, 0xf1, 0x2e, 0xc4, 0xb1, 0x03, 0xbd, 0x0b, 0xa1, 0xbd, 0xcb, 0xd1, 0x7f, 0x97,
+	0x77, 0x39, 0xe6, 0xc8, 0xa4, 0x8c, 0x39, 0xa2, 0x22, 0xf5, 0x3e, 0xb7, 0xe1, 0xab, 0x17, 0xe1,
+	0x17, 0xcc, 0x62, 0x28, 0xe2, 0xe3, 0x22, 0x1b, 0x88, 0xe2, 0xba, 0x52, 0x42, 0xbc, 0x62, 0xdb,
+	0x06, 0xcc, 0xee, 0xc2, 0x62, 0x8b, 0x92, 0x5e, 0xd6, 0x03, 0x0c, 0x76, 0x47, 0xfe, 0x03, 0xbc,
+	0x05, 0x98, 0xeb, 0x38, 0xde, 0xd8, 0x68, 0xc6, 0x41, 0x3d, 0x7f, 0xbc, 0x42, 0x3a, 0x19, 0xf0,
+	0xa8, 0x5a, 0x95, 0xeb, 0x78, 0xa5, 0x07, 0x1e, 0xf4, 0xa4, 0x80, 0xca, 0x8c, 0xc9, 0x34, 0x8b,
+	0x13, 0x5a, 0xcf, 0x15, 0x2f, 0x23, 0xac, 0xcf, 0xd4, 0x7a, 0x9f, 0xab, 0x26, 0x1f, 0xde, 0x7b,
+	0xf5, 0x51, 0x0a, 0xa5, 0x50, 0x6c, 0x96, 0x9b, 0x90, 0x53, 0xed, 0x32, 0xbd, 0x4f, 0xea, 0x0d,
+	0xdd, 0x55, 0xfb, 0xa4, 0xde, 0x3d, 0x2f, 0xd5, 0x1c, 0xa5, 0xd0, 0x83, 0xb2, 0xfe, 0xc0, 0xc4,
+	0xf0, 0xfd, 0x79, 0x60, 0xe2, 0x63, 0x84, 0xd4, 0x64, 0xaa, 0x3b, 0xa9, 0x49, 0xb8, 0x6c, 0x25,
+	0x84, 0x87, 0xd3, 0xd4, 0x9e, 0x00, 0x56, 0x6c, 0x40, 0x63, 0xe9, 0xfe, 0xef, 0xd2, 0x17, 0x58,
+	0xb8, 0xba, 0x64, 0xd3, 0xfa, 0x9c, 0xf8, 0xb1, 0x7b, 0x85, 0xe5, 0x1f, 0x3b, 0x64, 0x9a, 0xcf,
+	0xbc, 0xa2, 0x70, 0x8f, 0xa2, 0x85, 0x37, 0x71, 0x24, 0x7e, 0x28, 0x3c, 0x65, 0x95, 0xc1, 0x15,
+	0xe1, 0xb0, 0x47, 0x4b, 0xd0, 0x22, 0xd3, 0x75, 0xa5, 0x98, 0xb4, 0xa5, 0x80, 0x2c, 0x7f, 0x47,
+	0xe3, 0xf8, 0xed, 0xfd, 0xdc, 0x22, 0xfe, 0x69, 0x4f, 0xfd, 0xa8, 0xcb, 0x9a, 0xf7, 0x0b, 0x47,
+	0xa4, 0x1f, 0xd5, 0x1f, 0xfb, 0x38, 0x90, 0x96, 0xf4, 0xf3, 0x0e, 0x99, 0x0a, 0x0a, 0x7e, 0x23,
+	0xde, 0x71, 0x5b, 0x0a, 0xa6, 0xb9, 0x44, 0x11, 0xe5, 0x42, 0x5e, 0xd1, 0x45, 0x05, 0xba, 0x98,
+	0xbb, 0xdf, 0x77, 0xc8, 0x43, 0x59, 0x90, 0x6e, 0xf1, 0x54, 0xda, 0x69, 0x1e, 0x23, 0x2c, 0x1a,
+	0x77, 0x82, 0xad, 0xc6, 0x57, 0xad, 0xaf, 0xc6, 0xb5, 0xde, 0x3c, 0xf9, 0xba, 0x7c, 0x54, 0xac,
+	0xcb, 0x87, 0xf6, 0xc0, 0x84, 0xbd, 0x9a, 0x3e, 0xfd, 0x29, 0x87, 0x3f, 0xb9, 0xd6, 0x53, 0xe4,
+	0x5b, 0x37, 0x45, 0xbe, 0x2b, 0x36, 0x1f, 0x7d, 0xd2, 0x65, 0xcf, 0x5f, 0xc5, 0xfc, 0x86, 0x25,
+	0x27, 0x52, 0x49, 0x93, 0x3e, 0x6c, 0x36, 0xc9, 0xe2, 0x2d, 0x4b, 0x6f, 0x90, 0x95, 0x37, 0x67,
+	0xa6, 0xaf, 0x91, 0xb3, 0x77, 0xfb, 0x8a, 0x77, 0xa3, 0x37, 0xac, 0x8b, 0xc5, 0x7f, 0x31, 0xa2,
+	0x99, 0x14, 0x33, 0xda, 0xb6, 0xee, 0x90, 0x1d, 0x61, 0x7c, 0x37, 0xaa, 0x45, 0xbd, 0x71, 0xdb,
+	0xa3, 0x2b, 0xdf, 0x8c, 0x42, 0xea, 0x20, 0xb8, 0xbc, 0xc3, 0x16, 0xc6, 0xe2, 0x2b, 0x7c, 0xfd,
+	0xf7, 0xff, 0x15, 0xbe, 0x1d, 0x32, 0xb2, 0x13, 0x66, 0x0d, 0xe6, 0x19, 0x21, 0x0c, 0x77, 0x16,
+	0xe2, 0x2b, 0x91, 0x5c, 0xde, 0xf7, 0x1b, 0x92, 0x01, 0xe4, 0xbc, 0xd0, 0x3f, 0x16, 0x7f, 0x30,
+	0x37, 0xec, 0xa2, 0x7f, 0xec, 0x0d, 0x59, 0x00, 0x39, 0x0e, 0x0e, 0xd6, 0x18, 0xfe, 0x92, 0xd9,
+	0xaa, 0xbc, 0x21, 0x5b, 0x33, 0x44, 0x52, 0xe4, 0x51, 0xcc, 0x37, 0x34, 0x1e, 0x60, 0x70, 0x54,
+	0x99, 0xc1, 0x87, 0x7b, 0x66, 0x06, 0x7f, 0x83, 0x09, 0x6c, 0x59, 0x18, 0x75, 0xe8, 0x4a, 0xe4,
+	0x8d, 0xd8, 0xda, 0xb4, 0x16, 0x14, 0x4d, 0x7e, 0x05, 0xcf, 0x7f, 0x83, 0xc6, 0x4f, 0xb3, 0x9f,
+	0x8c, 0xee, 0x69, 0x3f, 0xc9, 0x55, 0x2e, 0x63, 0xd6, 0x55, 0x2e, 0x19, 0x6d, 0x5b, 0x51, 0xb9,
+	0xfc, 0x58, 0xa9, 0x03, 0xfe, 0xd2, 0x21, 0xae, 0x92, 0xbb, 0xd4, 0x86, 0x7a, 0x1f, 0x3c, 0x24,
+	0xd1, 0x2d, 0x2d, 0x52, 0x6f, 0xb5, 0xda, 0x3d, 0x05, 0x39, 0xcd, 0xbc, 0x01, 0x39, 0x0c, 0x34,
+	0x9e, 0xfe, 0x9f, 0x3b, 0xe4, 0x54, 0x77, 0xdf, 0xef, 0x83, 0x47, 0xd8, 0xae, 0xe9, 0x11, 0xb6,
+	0x66, 0x51, 0x75, 0xaf, 0xba, 0xd1, 0xc3, 0x37, 0xec, 0x87, 0x15, 0x32, 0xa9, 0x23, 0x57, 0xe9,
+	0xfd, 0xf8, 0xd8, 0x3b, 0x86, 0x3b, 0xec, 0x75, 0xbb, 0xfd, 0xad, 0x0a, 0x0b, 0x50, 0x99, 0xeb,
+	0xf5, 0xc7, 0x0a, 0xae, 0xd7, 0x37, 0xec, 0xb3, 0xde, 0xdb, 0xff, 0xfa, 0xbf, 0x3a, 0xe4, 0x78,
+	0xa1, 0xc6, 0x7d, 0x98, 0x60, 0xdb, 0xe6, 0x04, 0x7b, 0xc1, 0x7a, 0xaf, 0x7b, 0xcc, 0xae, 0x6f,
+	0x56, 0xba, 0x7a, 0xcb, 0x2e, 0x71, 0x9f, 0x74, 0xc8, 0x00, 0x4a, 0xcb, 0xd2, 0x39, 0xeb, 0xc3,
+	0x47, 0x32, 0x03, 0x98, 0x5c, 0x2f, 0x76, 0x67, 0xd5, 0x3e, 0x06, 0x03, 0xce, 0x7d, 0xfa, 0x17,
+	0x1d, 0x42, 0x72, 0xa4, 0x77, 0x4a, 0x04, 0xf6, 0x7f, 0xa3, 0x42, 0x4e, 0x96, 0x4e, 0x23, 0xf7,
+	0xd3, 0x4a, 0x23, 0xe7, 0xd8, 0x76, 0x3d, 0x34, 0x18, 0xe9, 0x8a, 0xb9, 0x71, 0x43, 0x31, 0x27,
+	0xf4, 0x71, 0xef, 0xd4, 0x05, 0x46, 0x6c, 0xd3, 0xda, 0x60, 0xfd, 0xa9, 0x93, 0x7b, 0xb3, 0xca,
+	0xc1, 0xfc, 0xab, 0x18, 0x91, 0xe3, 0xff, 0x50, 0x0b, 0x57, 0x90, 0x1d, 0xbd, 0x0f, 0x7b, 0xc5,
+	0x8e, 0xb9, 0x57, 0x80, 0x7d, 0x3b, 0x72, 0x8f, 0xcd, 0xe2, 0x55, 0x52, 0x66, 0x58, 0xde, 0x5f,
+	0xba, 0x4a, 0x23, 0xb6, 0xb5, 0xb2, 0xef, 0xd8, 0xd6, 0x71, 0x32, 0xfa, 0x72, 0xa8, 0x52, 0x9d,
+	0xce, 0xcf, 0x7e, 0xe7, 0x07, 0x67, 0x1e, 0xf8, 0xee, 0x0f, 0xce, 0x3c, 0xf0, 0xfd, 0x1f, 0x9c,
+	0x79, 0xe0, 0xe3, 0xb7, 0xcf, 0x38, 0xdf, 0xb9, 0x7d, 0xc6, 0xf9, 0xee, 0xed, 0x33, 0xce, 0xf7,
+	0x6f, 0x9f, 0x71, 0xfe, 0xe3, 0xed, 0x33, 0xce, 0xdf, 0xf9, 0x93, 0x33, 0x0f, 0xbc, 0x3c, 0x2c,
+	0x3b, 0xf6, 0xff, 0x06, 0x00, 0x60, 0xf3, 0x3d, 0xa2, 0x3f, 0xe2, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -7305,6 +7358,136 @@ func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReplaceGracePeriod != nil {
+		{
+			size, err := m.ReplaceGracePeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ErroredJobsHistoryLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ErroredJobsHistoryLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.StartingDeadlineFraction != nil {
+		{
+			size, err := m.StartingDeadlineFraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Freeze != nil {
+		{
+			size, err := m.Freeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	i -= len(m.SuspendPolicy)
+	copy(dAtA[i:], m.SuspendPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuspendPolicy)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.ScheduleWindow != nil {
+		{
+			size, err := m.ScheduleWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MaxRuns != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRuns))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ActiveDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ScheduleStartingDeadlineSeconds) > 0 {
+		keysForScheduleStartingDeadlineSeconds := make([]string, 0, len(m.ScheduleStartingDeadlineSeconds))
+		for k := range m.ScheduleStartingDeadlineSeconds {
+			keysForScheduleStartingDeadlineSeconds = append(keysForScheduleStartingDeadlineSeconds, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForScheduleStartingDeadlineSeconds)
+		for iNdEx := len(keysForScheduleStartingDeadlineSeconds) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ScheduleStartingDeadlineSeconds[string(keysForScheduleStartingDeadlineSeconds[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForScheduleStartingDeadlineSeconds[iNdEx])
+			copy(dAtA[i:], keysForScheduleStartingDeadlineSeconds[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForScheduleStartingDeadlineSeconds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.WhenData != nil {
+		{
+			size, err := m.WhenData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.DeleteAfterStopped != nil {
+		{
+			size, err := m.DeleteAfterStopped.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i -= len(m.When)
 	copy(dAtA[i:], m.When)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
@@ -7414,6 +7597,69 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.SubmissionErrorRetryable {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
+	i -= len(m.LastRunSchedule)
+	copy(dAtA[i:], m.LastRunSchedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastRunSchedule)))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ActivePhases) > 0 {
+		keysForActivePhases := make([]string, 0, len(m.ActivePhases))
+		for k := range m.ActivePhases {
+			keysForActivePhases = append(keysForActivePhases, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForActivePhases)
+		for iNdEx := len(keysForActivePhases) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ActivePhases[string(keysForActivePhases[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForActivePhases[iNdEx])
+			copy(dAtA[i:], keysForActivePhases[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForActivePhases[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Released[iNdEx])
+			copy(dAtA[i:], m.Released[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Released[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.StoppedReason)
+	copy(dAtA[i:], m.StoppedReason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StoppedReason)))
+	i--
+	dAtA[i] = 0x42
+	if m.StoppedAt != nil {
+		{
+			size, err := m.StoppedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	i -= len(m.Phase)
 	copy(dAtA[i:], m.Phase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -10915,6 +11161,39 @@ func (m *S3EncryptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduleWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.End)
+	copy(dAtA[i:], m.End)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15071,6 +15350,49 @@ func (m *CronWorkflowSpec) Size() (n int) {
 	}
 	l = len(m.When)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.DeleteAfterStopped != nil {
+		l = m.DeleteAfterStopped.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.WhenData != nil {
+		l = m.WhenData.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ScheduleStartingDeadlineSeconds) > 0 {
+		for k, v := range m.ScheduleStartingDeadlineSeconds {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.ActiveDeadlineSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
+	}
+	if m.MaxRuns != nil {
+		n += 2 + sovGenerated(uint64(*m.MaxRuns))
+	}
+	if m.ScheduleWindow != nil {
+		l = m.ScheduleWindow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.SuspendPolicy)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.Freeze != nil {
+		l = m.Freeze.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.StartingDeadlineFraction != nil {
+		l = m.StartingDeadlineFraction.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ErroredJobsHistoryLimit != nil {
+		n += 2 + sovGenerated(uint64(*m.ErroredJobsHistoryLimit))
+	}
+	if m.ReplaceGracePeriod != nil {
+		l = m.ReplaceGracePeriod.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15100,6 +15422,29 @@ func (m *CronWorkflowStatus) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.Failed))
 	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.StoppedAt != nil {
+		l = m.StoppedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.StoppedReason)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Released) > 0 {
+		for _, s := range m.Released {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ActivePhases) > 0 {
+		for k, v := range m.ActivePhases {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.LastRunSchedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -16381,6 +16726,19 @@ func (m *S3EncryptionOptions) Size() (n int) {
 	return n
 }
 
+func (m *ScheduleWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.End)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ScriptTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18108,6 +18466,16 @@ func (this *CronWorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForScheduleStartingDeadlineSeconds := make([]string, 0, len(this.ScheduleStartingDeadlineSeconds))
+	for k := range this.ScheduleStartingDeadlineSeconds {
+		keysForScheduleStartingDeadlineSeconds = append(keysForScheduleStartingDeadlineSeconds, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForScheduleStartingDeadlineSeconds)
+	mapStringForScheduleStartingDeadlineSeconds := "map[string]int64{"
+	for _, k := range keysForScheduleStartingDeadlineSeconds {
+		mapStringForScheduleStartingDeadlineSeconds += fmt.Sprintf("%v: %v,", k, this.ScheduleStartingDeadlineSeconds[k])
+	}
+	mapStringForScheduleStartingDeadlineSeconds += "}"
 	s := strings.Join([]string{`&CronWorkflowSpec{`,
 		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
@@ -18121,6 +18489,17 @@ func (this *CronWorkflowSpec) String() string {
 		`StopStrategy:` + strings.Replace(this.StopStrategy.String(), "StopStrategy", "StopStrategy", 1) + `,`,
 		`Schedules:` + fmt.Sprintf("%v", this.Schedules) + `,`,
 		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`DeleteAfterStopped:` + strings.Replace(fmt.Sprintf("%v", this.DeleteAfterStopped), "Duration", "v11.Duration", 1) + `,`,
+		`WhenData:` + strings.Replace(fmt.Sprintf("%v", this.WhenData), "LocalObjectReference", "v1.LocalObjectReference", 1) + `,`,
+		`ScheduleStartingDeadlineSeconds:` + mapStringForScheduleStartingDeadlineSeconds + `,`,
+		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
+		`MaxRuns:` + valueToStringGenerated(this.MaxRuns) + `,`,
+		`ScheduleWindow:` + strings.Replace(this.ScheduleWindow.String(), "ScheduleWindow", "ScheduleWindow", 1) + `,`,
+		`SuspendPolicy:` + fmt.Sprintf("%v", this.SuspendPolicy) + `,`,
+		`Freeze:` + strings.Replace(fmt.Sprintf("%v", this.Freeze), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`StartingDeadlineFraction:` + strings.Replace(this.StartingDeadlineFraction.String(), "Amount", "Amount", 1) + `,`,
+		`ErroredJobsHistoryLimit:` + valueToStringGenerated(this.ErroredJobsHistoryLimit) + `,`,
+		`ReplaceGracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.ReplaceGracePeriod), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18139,6 +18518,16 @@ func (this *CronWorkflowStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	keysForActivePhases := make([]string, 0, len(this.ActivePhases))
+	for k := range this.ActivePhases {
+		keysForActivePhases = append(keysForActivePhases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForActivePhases)
+	mapStringForActivePhases := "map[string]WorkflowPhase{"
+	for _, k := range keysForActivePhases {
+		mapStringForActivePhases += fmt.Sprintf("%v: %v,", k, this.ActivePhases[k])
+	}
+	mapStringForActivePhases += "}"
 	s := strings.Join([]string{`&CronWorkflowStatus{`,
 		`Active:` + repeatedStringForActive + `,`,
 		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
@@ -18146,6 +18535,12 @@ func (this *CronWorkflowStatus) String() string {
 		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
 		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`StoppedAt:` + strings.Replace(fmt.Sprintf("%v", this.StoppedAt), "Time", "v11.Time", 1) + `,`,
+		`StoppedReason:` + fmt.Sprintf("%v", this.StoppedReason) + `,`,
+		`Released:` + fmt.Sprintf("%v", this.Released) + `,`,
+		`ActivePhases:` + mapStringForActivePhases + `,`,
+		`LastRunSchedule:` + fmt.Sprintf("%v", this.LastRunSchedule) + `,`,
+		`SubmissionErrorRetryable:` + fmt.Sprintf("%v", this.SubmissionErrorRetryable) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19081,6 +19476,17 @@ func (this *S3EncryptionOptions) String() string {
 	}, "")
 	return s
 }
+func (this *ScheduleWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleWindow{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScriptTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -26739,59 +27145,81 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAfterStopped", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.DeleteAfterStopped == nil {
+				m.DeleteAfterStopped = &v11.Duration{}
 			}
-			if iNdEx >= l {
+			if err := m.DeleteAfterStopped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhenData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.WhenData == nil {
+				m.WhenData = &v1.LocalObjectReference{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.WhenData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleStartingDeadlineSeconds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26818,14 +27246,413 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ScheduleStartingDeadlineSeconds == nil {
+				m.ScheduleStartingDeadlineSeconds = make(map[string]int64)
 			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ScheduleStartingDeadlineSeconds[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveDeadlineSeconds = &v
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxRuns = &v
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleWindow == nil {
+				m.ScheduleWindow = &ScheduleWindow{}
+			}
+			if err := m.ScheduleWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendPolicy = SuspendPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Freeze == nil {
+				m.Freeze = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.Freeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartingDeadlineFraction == nil {
+				m.StartingDeadlineFraction = &Amount{}
+			}
+			if err := m.StartingDeadlineFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErroredJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ErroredJobsHistoryLimit = &v
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplaceGracePeriod == nil {
+				m.ReplaceGracePeriod = &v11.Duration{}
+			}
+			if err := m.ReplaceGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26888,52 +27715,311 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = CronWorkflowPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoppedAt == nil {
+				m.StoppedAt = &v11.Time{}
+			}
+			if err := m.StoppedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoppedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePhases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivePhases == nil {
+				m.ActivePhases = make(map[string]WorkflowPhase)
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
-			}
-			m.Succeeded = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Succeeded |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
-			}
-			m.Failed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue WorkflowPhase
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = WorkflowPhase(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 6:
+			m.ActivePhases[mapkey] = ((WorkflowPhase)(mapvalue))
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunSchedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26961,8 +28047,28 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = CronWorkflowPhase(dAtA[iNdEx:postIndex])
+			m.LastRunSchedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionErrorRetryable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SubmissionErrorRetryable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37874,6 +38980,120 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduleWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
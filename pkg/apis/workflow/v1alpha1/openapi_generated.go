@@ -122,6 +122,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.S3ArtifactRepository":          schema_pkg_apis_workflow_v1alpha1_S3ArtifactRepository(ref),
 		"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.S3Bucket":                      schema_pkg_apis_workflow_v1alpha1_S3Bucket(ref),
 		"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.S3EncryptionOptions":           schema_pkg_apis_workflow_v1alpha1_S3EncryptionOptions(ref),
+		"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.ScheduleWindow":                schema_pkg_apis_workflow_v1alpha1_ScheduleWindow(ref),
 		"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.ScriptTemplate":                schema_pkg_apis_workflow_v1alpha1_ScriptTemplate(ref),
 		"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.SemaphoreHolding":              schema_pkg_apis_workflow_v1alpha1_SemaphoreHolding(ref),
 		"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.SemaphoreRef":                  schema_pkg_apis_workflow_v1alpha1_SemaphoreRef(ref),
@@ -2324,12 +2325,92 @@ func schema_pkg_apis_workflow_v1alpha1_CronWorkflowSpec(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"deleteAfterStopped": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteAfterStopped is how long to keep the CronWorkflow once its StopStrategy has stopped it. After that it is deleted, along with any Workflows it owns. It is kept forever if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"whenData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WhenData references a ConfigMap whose data is available to When as `cronworkflow.data.<key>`. Keys that are not in the ConfigMap resolve to an empty string.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"scheduleStartingDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduleStartingDeadlineSeconds overrides StartingDeadlineSeconds for individual schedules, keyed by the schedule as written in Schedules, e.g. so a daily report can run late but a heartbeat every minute cannot.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds is how long a Workflow started by the CronWorkflow may be active before it is considered stuck and terminated, so that a hung Workflow does not block a ForbidConcurrent CronWorkflow forever.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxRuns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRuns is how many Workflows the CronWorkflow may run. Once that many have completed, it is stopped as if by its StopStrategy. It runs without limit if not set.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"scheduleWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduleWindow runs the Workflow once a day at a random time within a window, instead of at fixed times, to spread the load of many CronWorkflows. It may not be used with Schedule or Schedules.",
+							Ref:         ref("github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.ScheduleWindow"),
+						},
+					},
+					"suspendPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuspendPolicy is whether Workflows that are active when the CronWorkflow is suspended still count towards its ConcurrencyPolicy once it is resumed. Either way, Workflows that have already been created run to completion. Defaults to DrainActive.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"freeze": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Freeze references a ConfigMap key that stops runs from being scheduled while its value is \"true\", so that many CronWorkflows can be paused from one place, e.g. during a deploy, without setting Suspend on each of them.",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+					"startingDeadlineFraction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartingDeadlineFraction is the starting deadline for missed runs as a fraction of the time from the missed run to the next one, e.g. 0.5 to run a missed run unless it is more than half way to the next. It may not be used with StartingDeadlineSeconds.",
+							Ref:         ref("github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.Amount"),
+						},
+					},
+					"erroredJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErroredJobsHistoryLimit is the number of errored jobs to be kept at a time. If it is not set, errored jobs count towards FailedJobsHistoryLimit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"replaceGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplaceGracePeriod, with the Replace concurrency policy, is how long an active Workflow runs before it may be replaced. A run is skipped, rather than replacing a Workflow that started more recently.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"workflowSpec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.StopStrategy", "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.WorkflowSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.Amount", "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.ScheduleWindow", "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.StopStrategy", "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1.WorkflowSpec", "k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2398,6 +2479,64 @@ func schema_pkg_apis_workflow_v1alpha1_CronWorkflowStatus(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"stoppedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StoppedAt is the time the CronWorkflow was stopped by its StopStrategy",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"stoppedReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StoppedReason describes why the CronWorkflow was stopped",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"released": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Released are the UIDs of the active Workflows that no longer count towards the ConcurrencyPolicy, because the CronWorkflow was suspended with SuspendPolicy Immediate while they were active, or because the schedule that fired them was removed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"activePhases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActivePhases are the phases of the active Workflows, keyed by UID, as of the last reconciliation, so that they can be seen without getting each Workflow",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastRunSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunSchedule is the schedule, with its timezone, that fired the last scheduled run at LastScheduledTime, so that it is known even after the schedules change",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"submissionErrorRetryable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubmissionErrorRetryable is true if the error the SubmissionError condition reports is transient, and so retrying may succeed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5534,6 +5673,36 @@ func schema_pkg_apis_workflow_v1alpha1_S3EncryptionOptions(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_ScheduleWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleWindow is a daily window of time in which a CronWorkflow runs once. The minute it runs at is chosen at random for each day, but is the same every time it is chosen for that day, e.g. after the controller restarts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the time of day the window opens, as HH:MM in the CronWorkflow's timezone",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the time of day the window closes, as HH:MM in the CronWorkflow's timezone. It must be after Start, and the CronWorkflow runs before it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_ScriptTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
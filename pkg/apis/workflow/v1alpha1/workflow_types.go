@@ -2183,14 +2183,16 @@ func ResourceQuantityDenominator(r apiv1.ResourceName) *resource.Quantity {
 
 type Conditions []Condition
 
+// UpsertCondition replaces the condition of the same type, or adds it if there is none. Any further conditions of the
+// same type, e.g. added before conditions were upserted, are removed so that there is at most one of each type.
 func (cs *Conditions) UpsertCondition(condition Condition) {
-	for index, wfCondition := range *cs {
-		if wfCondition.Type == condition.Type {
-			(*cs)[index] = condition
-			return
-		}
+	index := slices.IndexFunc(*cs, func(c Condition) bool { return c.Type == condition.Type })
+	if index < 0 {
+		*cs = append(*cs, condition)
+		return
 	}
-	*cs = append(*cs, condition)
+	(*cs)[index] = condition
+	*cs = append((*cs)[:index+1], slices.DeleteFunc((*cs)[index+1:], func(c Condition) bool { return c.Type == condition.Type })...)
 }
 
 func (cs *Conditions) UpsertConditionMessage(condition Condition) {
@@ -2209,13 +2211,9 @@ func (cs *Conditions) JoinConditions(conditions *Conditions) {
 	}
 }
 
+// RemoveCondition removes all conditions of the type
 func (cs *Conditions) RemoveCondition(conditionType ConditionType) {
-	for index, wfCondition := range *cs {
-		if wfCondition.Type == conditionType {
-			*cs = append((*cs)[:index], (*cs)[index+1:]...)
-			return
-		}
-	}
+	*cs = slices.DeleteFunc(*cs, func(c Condition) bool { return c.Type == conditionType })
 }
 
 func (cs *Conditions) DisplayString(fmtStr string, iconMap map[ConditionType]string) string {
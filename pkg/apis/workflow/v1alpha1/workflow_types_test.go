@@ -869,6 +869,40 @@ func TestCronWorkflowConditions(t *testing.T) {
 	assert.Empty(t, cwfCond)
 }
 
+func TestConditions_UpsertCondition(t *testing.T) {
+	submissionError := Condition{Type: ConditionTypeSubmissionError, Message: "Failed to submit Workflow", Status: metav1.ConditionTrue}
+	specError := Condition{Type: ConditionTypeSpecError, Message: "invalid spec", Status: metav1.ConditionTrue}
+
+	t.Run("Insert", func(t *testing.T) {
+		cs := Conditions{specError}
+		cs.UpsertCondition(submissionError)
+		assert.Equal(t, Conditions{specError, submissionError}, cs)
+	})
+	t.Run("Update", func(t *testing.T) {
+		cs := Conditions{submissionError, specError}
+		updated := Condition{Type: ConditionTypeSubmissionError, Message: "Failed again", Status: metav1.ConditionTrue}
+		cs.UpsertCondition(updated)
+		assert.Equal(t, Conditions{updated, specError}, cs)
+	})
+	t.Run("Duplicates", func(t *testing.T) {
+		cs := Conditions{submissionError, specError, submissionError}
+		updated := Condition{Type: ConditionTypeSubmissionError, Message: "Failed again", Status: metav1.ConditionTrue}
+		cs.UpsertCondition(updated)
+		assert.Equal(t, Conditions{updated, specError}, cs)
+	})
+}
+
+func TestConditions_RemoveCondition(t *testing.T) {
+	submissionError := Condition{Type: ConditionTypeSubmissionError, Message: "Failed to submit Workflow", Status: metav1.ConditionTrue}
+	specError := Condition{Type: ConditionTypeSpecError, Message: "invalid spec", Status: metav1.ConditionTrue}
+
+	cs := Conditions{submissionError, specError, submissionError}
+	cs.RemoveCondition(ConditionTypeSubmissionError)
+	assert.Equal(t, Conditions{specError}, cs)
+	cs.RemoveCondition(ConditionTypeSubmissionError)
+	assert.Equal(t, Conditions{specError}, cs)
+}
+
 func TestDisplayConditions(t *testing.T) {
 	const fmtStr = "%-20s %v\n"
 	cwfCond := Conditions{}
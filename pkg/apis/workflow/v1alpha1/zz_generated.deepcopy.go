@@ -12,6 +12,7 @@ import (
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	types "k8s.io/apimachinery/pkg/types"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -1125,6 +1126,58 @@ func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DeleteAfterStopped != nil {
+		in, out := &in.DeleteAfterStopped, &out.DeleteAfterStopped
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.WhenData != nil {
+		in, out := &in.WhenData, &out.WhenData
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ScheduleStartingDeadlineSeconds != nil {
+		in, out := &in.ScheduleStartingDeadlineSeconds, &out.ScheduleStartingDeadlineSeconds
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxRuns != nil {
+		in, out := &in.MaxRuns, &out.MaxRuns
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScheduleWindow != nil {
+		in, out := &in.ScheduleWindow, &out.ScheduleWindow
+		*out = new(ScheduleWindow)
+		**out = **in
+	}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartingDeadlineFraction != nil {
+		in, out := &in.StartingDeadlineFraction, &out.StartingDeadlineFraction
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.ErroredJobsHistoryLimit != nil {
+		in, out := &in.ErroredJobsHistoryLimit, &out.ErroredJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplaceGracePeriod != nil {
+		in, out := &in.ReplaceGracePeriod, &out.ReplaceGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1155,6 +1208,22 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.StoppedAt != nil {
+		in, out := &in.StoppedAt, &out.StoppedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Released != nil {
+		in, out := &in.Released, &out.Released
+		*out = make([]types.UID, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActivePhases != nil {
+		in, out := &in.ActivePhases, &out.ActivePhases
+		*out = make(map[string]WorkflowPhase, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2820,6 +2889,22 @@ func (in *S3EncryptionOptions) DeepCopy() *S3EncryptionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
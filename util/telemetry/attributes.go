@@ -13,6 +13,8 @@ const (
 	AttribConcurrencyPolicy string = `concurrency_policy`
 	AttribCronWFName        string = `name`
 	AttribCronWFNamespace   string = `namespace`
+	AttribCronWFOutcome     string = `outcome`
+	AttribCronWFSkipReason  string = `reason`
 	AttribDeprecatedFeature string = `feature`
 	AttribErrorCause        string = `cause`
 	AttribLogLevel          string = `level`
@@ -1,6 +1,39 @@
 // Code generated by util/telemetry/builder. DO NOT EDIT.
 package telemetry
 
+var InstrumentCronworkflowsActive = BuiltinInstrument{
+	name:        "cronworkflows_active",
+	description: "A gauge of the number of active Workflows of each CronWorkflow",
+	unit:        "{workflow}",
+	instType:    Int64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribCronWFName,
+		},
+		{
+			name: AttribCronWFNamespace,
+		},
+	},
+}
+
+var InstrumentCronworkflowsCompletedTotal = BuiltinInstrument{
+	name:        "cronworkflows_completed_total",
+	description: "A counter of the number of Workflows started by a CronWorkflow that have completed, by outcome",
+	unit:        "{workflow}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribCronWFName,
+		},
+		{
+			name: AttribCronWFNamespace,
+		},
+		{
+			name: AttribCronWFOutcome,
+		},
+	},
+}
+
 var InstrumentCronworkflowsConcurrencypolicyTriggered = BuiltinInstrument{
 	name:        "cronworkflows_concurrencypolicy_triggered",
 	description: "A counter of the number of times a CronWorkflow has triggered its `concurrencyPolicy` to limit the number of workflows running",
@@ -19,6 +52,24 @@ var InstrumentCronworkflowsConcurrencypolicyTriggered = BuiltinInstrument{
 	},
 }
 
+var InstrumentCronworkflowsSkippedTotal = BuiltinInstrument{
+	name:        "cronworkflows_skipped_total",
+	description: "A counter of the number of times a CronWorkflow's schedule fired but no Workflow was submitted, by reason",
+	unit:        "{cronworkflow}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribCronWFName,
+		},
+		{
+			name: AttribCronWFNamespace,
+		},
+		{
+			name: AttribCronWFSkipReason,
+		},
+	},
+}
+
 var InstrumentCronworkflowsTriggeredTotal = BuiltinInstrument{
 	name:        "cronworkflows_triggered_total",
 	description: "A counter of the total number of times a CronWorkflow has been triggered",
@@ -37,6 +37,18 @@ const (
 	// AnnotationKeyCronWfScheduledTime is the workflow metadata annotation key containing the time when the workflow
 	// was scheduled to run by CronWorkflow.
 	AnnotationKeyCronWfScheduledTime = workflow.WorkflowFullName + "/scheduled-time"
+	// AnnotationKeyCronWfTimezone is the workflow metadata annotation key containing the timezone of the CronWorkflow
+	// that scheduled the workflow. It is omitted if the CronWorkflow has no timezone.
+	AnnotationKeyCronWfTimezone = workflow.CronWorkflowFullName + "/timezone"
+	// AnnotationKeyCronWfUID is the workflow metadata annotation key containing the UID of the CronWorkflow that
+	// scheduled the workflow.
+	AnnotationKeyCronWfUID = workflow.CronWorkflowFullName + "/uid"
+	// AnnotationKeyCronWfGeneration is the workflow metadata annotation key containing the metadata.generation of the
+	// CronWorkflow that scheduled the workflow, i.e. the revision of its spec the workflow was created from.
+	AnnotationKeyCronWfGeneration = workflow.CronWorkflowFullName + "/generation"
+	// AnnotationKeyCronWfSchedule is the workflow metadata annotation key containing the schedule, with its timezone, of
+	// the CronWorkflow that fired the workflow. It is omitted if none of its schedules fired it, e.g. a schedule window.
+	AnnotationKeyCronWfSchedule = workflow.CronWorkflowFullName + "/schedule"
 
 	// AnnotationKeyWorkflowName is the name of the workflow
 	AnnotationKeyWorkflowName = workflow.WorkflowFullName + "/workflow-name"
@@ -60,6 +72,10 @@ const (
 	// the strategy for the pod, in case the pod is orphaned from its workflow
 	AnnotationKeyPodGCStrategy = workflow.WorkflowFullName + "/pod-gc-strategy"
 
+	// AnnotationKeyEntrypointResolution set to "disabled" on a Workflow, or on a template's or the Workflow's pod
+	// metadata, stops the controller looking up the entrypoint/cmd of images in their registry
+	AnnotationKeyEntrypointResolution = workflow.WorkflowFullName + "/entrypoint-resolution"
+
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
 	LabelKeyControllerInstanceID = workflow.WorkflowFullName + "/controller-instanceid"
@@ -230,6 +246,8 @@ const (
 	LabelValueTypeConfigMapParameter = "Parameter"
 	// LabelValueTypeConfigMapExecutorPlugin is a key for configmaps that contains an executor plugin.
 	LabelValueTypeConfigMapExecutorPlugin = "ExecutorPlugin"
+	// LabelValueTypeConfigMapEntrypointCache is a key for configmaps that cache the entrypoint/cmd of images.
+	LabelValueTypeConfigMapEntrypointCache = "EntrypointCache"
 
 	// LocalVarPodName is a step level variable that references the name of the pod
 	LocalVarPodName = "pod.name"
@@ -273,6 +291,28 @@ const (
 	ConfigMapName = "workflow-controller-configmap"
 )
 
+// ReservedCronWorkflowLabels are the labels the controller manages on Workflows started by a CronWorkflow. A
+// CronWorkflow's WorkflowMetadata may not set them, as that would break tracking of its active Workflows.
+var ReservedCronWorkflowLabels = []string{
+	LabelKeyCronWorkflow,
+	LabelKeyCronWorkflowBackfill,
+	LabelKeyControllerInstanceID,
+	LabelKeyCompleted,
+	LabelKeyPhase,
+	LabelKeyWorkflowArchivingStatus,
+}
+
+// ReservedCronWorkflowAnnotations are the annotations the controller manages on Workflows started by a CronWorkflow.
+// A CronWorkflow's WorkflowMetadata may not set them, as the controller relies on them, e.g. to release a Workflow's
+// concurrency slot for the schedule that fired it.
+var ReservedCronWorkflowAnnotations = []string{
+	AnnotationKeyCronWfScheduledTime,
+	AnnotationKeyCronWfTimezone,
+	AnnotationKeyCronWfUID,
+	AnnotationKeyCronWfGeneration,
+	AnnotationKeyCronWfSchedule,
+}
+
 // AnnotationKeyKillCmd specifies the command to use to kill to container, useful for injected sidecars
 var AnnotationKeyKillCmd = func(containerName string) string { return workflow.WorkflowFullName + "/kill-cmd-" + containerName }
 
@@ -1,13 +1,19 @@
 package common
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/template"
 )
 
 // labelsToPropagate includes the labels of a CronWorkflow which are to be
@@ -22,11 +28,9 @@ func ConvertCronWorkflowToWorkflow(cronWf *wfv1.CronWorkflow) *wfv1.Workflow {
 	meta := metav1.ObjectMeta{
 		GenerateName: cronWf.Name + "-",
 		Labels:       make(map[string]string),
-		Annotations: map[string]string{
-			AnnotationKeyCronWfScheduledTime: time.Now().Format(time.RFC3339),
-		},
+		Annotations:  make(map[string]string),
 	}
-	return toWorkflow(*cronWf, meta)
+	return toWorkflow(*cronWf, meta, time.Now())
 }
 
 func ConvertCronWorkflowToWorkflowWithProperties(cronWf *wfv1.CronWorkflow, name string, scheduledTime time.Time) *wfv1.Workflow {
@@ -43,13 +47,115 @@ func ConvertCronWorkflowToWorkflowWithProperties(cronWf *wfv1.CronWorkflow, name
 	}
 
 	meta := metav1.ObjectMeta{
-		Name:   name,
-		Labels: wfLabels,
-		Annotations: map[string]string{
-			AnnotationKeyCronWfScheduledTime: scheduledTime.Format(time.RFC3339),
-		},
+		Name:        name,
+		Labels:      wfLabels,
+		Annotations: make(map[string]string),
+	}
+	return toWorkflow(*cronWf, meta, scheduledTime)
+}
+
+// BuildWorkflow returns the Workflow that cronWf submits for scheduledTime, ready to be created. Label and annotation
+// values of Spec.WorkflowMetadata, and the images of the templates' containers, may reference {{cronworkflow.name}},
+// {{cronworkflow.namespace}}, {{cronworkflow.scheduledTime}}, {{cronworkflow.scheduledDate}} and
+// {{cronworkflow.schedule}}; any other tag is left untouched. The resulting metadata is validated before the Workflow
+// is returned. The annotations the controller manages are set last, so the workflow metadata cannot override them.
+func BuildWorkflow(cronWf *wfv1.CronWorkflow, scheduledTime time.Time, schedule string) (*wfv1.Workflow, error) {
+	wf := ConvertCronWorkflowToWorkflowWithProperties(cronWf, fmt.Sprintf("%s-%d", cronWf.Name, scheduledTime.Unix()), scheduledTime)
+	replaceMap := map[string]interface{}{
+		"cronworkflow.name":          cronWf.Name,
+		"cronworkflow.namespace":     cronWf.Namespace,
+		"cronworkflow.scheduledTime": scheduledTime.Format(time.RFC3339),
+		"cronworkflow.scheduledDate": scheduledTime.Format(time.DateOnly),
+		"cronworkflow.schedule":      schedule,
+	}
+	if err := replaceCronImages(wf, replaceMap); err != nil {
+		return nil, err
+	}
+	md := cronWf.Spec.WorkflowMetadata
+	if md == nil {
+		return wf, nil
+	}
+	for key, value := range md.Labels {
+		v, err := replaceCronVariables(value, replaceMap)
+		if err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "failed to template workflow label %q: %s", key, err)
+		}
+		if errs := validation.IsQualifiedName(key); errs != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "invalid workflow label key %q: %s", key, strings.Join(errs, ";"))
+		}
+		if errs := validation.IsValidLabelValue(v); errs != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "invalid label value %q for workflow label %q: %s", v, key, strings.Join(errs, ";"))
+		}
+		wf.Labels[key] = v
+	}
+	for key, value := range md.Annotations {
+		v, err := replaceCronVariables(value, replaceMap)
+		if err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "failed to template workflow annotation %q: %s", key, err)
+		}
+		if errs := validation.IsQualifiedName(key); errs != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "invalid workflow annotation key %q: %s", key, strings.Join(errs, ";"))
+		}
+		wf.Annotations[key] = v
 	}
-	return toWorkflow(*cronWf, meta)
+	setCronWorkflowAnnotations(wf, cronWf, scheduledTime)
+	return wf, nil
+}
+
+// replaceCronImages templates the images of the containers of wf's templates. The templates are copied first, as wf
+// shares them with the CronWorkflow. A {{cronworkflow.*}} tag that cannot be resolved is an error, so that the
+// Workflow is not submitted with an image that cannot be pulled.
+func replaceCronImages(wf *wfv1.Workflow, replaceMap map[string]interface{}) error {
+	if len(wf.Spec.Templates) == 0 {
+		return nil
+	}
+	templates := make([]wfv1.Template, len(wf.Spec.Templates))
+	for i, tmpl := range wf.Spec.Templates {
+		templates[i] = *tmpl.DeepCopy()
+		var images []*string
+		if c := templates[i].Container; c != nil {
+			images = append(images, &c.Image)
+		}
+		if s := templates[i].Script; s != nil {
+			images = append(images, &s.Image)
+		}
+		if cs := templates[i].ContainerSet; cs != nil {
+			for j := range cs.Containers {
+				images = append(images, &cs.Containers[j].Image)
+			}
+		}
+		for j := range templates[i].InitContainers {
+			images = append(images, &templates[i].InitContainers[j].Image)
+		}
+		for j := range templates[i].Sidecars {
+			images = append(images, &templates[i].Sidecars[j].Image)
+		}
+		for _, image := range images {
+			v, err := replaceCronVariables(*image, replaceMap)
+			if err == nil {
+				err = template.Validate(v, func(tag string) error {
+					if strings.HasPrefix(strings.TrimSpace(tag), "cronworkflow.") {
+						return fmt.Errorf("failed to resolve {{%s}}", tag)
+					}
+					return nil
+				})
+			}
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "failed to template image %q of template %q: %s", *image, tmpl.Name, err)
+			}
+			*image = v
+		}
+	}
+	wf.Spec.Templates = templates
+	return nil
+}
+
+func replaceCronVariables(s string, replaceMap map[string]interface{}) (string, error) {
+	t, err := template.NewTemplate(s)
+	if err != nil {
+		return "", err
+	}
+	return t.Replace(replaceMap, true)
 }
 
 func NewWorkflowFromWorkflowTemplate(templateName string, clusterScope bool) *wfv1.Workflow {
@@ -75,7 +181,7 @@ func NewWorkflowFromWorkflowTemplate(templateName string, clusterScope bool) *wf
 	return wf
 }
 
-func toWorkflow(cronWf wfv1.CronWorkflow, objectMeta metav1.ObjectMeta) *wfv1.Workflow {
+func toWorkflow(cronWf wfv1.CronWorkflow, objectMeta metav1.ObjectMeta, scheduledTime time.Time) *wfv1.Workflow {
 	wf := &wfv1.Workflow{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       workflow.WorkflowKind,
@@ -104,6 +210,27 @@ func toWorkflow(cronWf wfv1.CronWorkflow, objectMeta metav1.ObjectMeta) *wfv1.Wo
 		wf.Finalizers = append(wf.Finalizers, cronWf.Spec.WorkflowMetadata.Finalizers...)
 	}
 	wf.SetOwnerReferences(append(wf.GetOwnerReferences(), *metav1.NewControllerRef(&cronWf, wfv1.SchemeGroupVersion.WithKind(workflow.CronWorkflowKind))))
+	setCronWorkflowAnnotations(wf, &cronWf, scheduledTime)
 
 	return wf
 }
+
+// setCronWorkflowAnnotations sets the annotations the controller manages on wf: the time it was scheduled for, the
+// timezone of cronWf, and the UID and generation that tie wf to the revision of cronWf that created it. It must be
+// called after the workflow metadata is applied, so that it cannot override them. The UID and generation are omitted
+// if cronWf has no UID, i.e. it was never persisted, and the timezone if cronWf has none.
+func setCronWorkflowAnnotations(wf *wfv1.Workflow, cronWf *wfv1.CronWorkflow, scheduledTime time.Time) {
+	wf.Annotations[AnnotationKeyCronWfScheduledTime] = scheduledTime.Format(time.RFC3339)
+	if cronWf.Spec.Timezone != "" {
+		wf.Annotations[AnnotationKeyCronWfTimezone] = cronWf.Spec.Timezone
+	} else {
+		delete(wf.Annotations, AnnotationKeyCronWfTimezone)
+	}
+	if cronWf.UID == "" {
+		delete(wf.Annotations, AnnotationKeyCronWfUID)
+		delete(wf.Annotations, AnnotationKeyCronWfGeneration)
+		return
+	}
+	wf.Annotations[AnnotationKeyCronWfUID] = string(cronWf.UID)
+	wf.Annotations[AnnotationKeyCronWfGeneration] = strconv.FormatInt(cronWf.Generation, 10)
+}
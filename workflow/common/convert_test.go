@@ -6,6 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -124,6 +127,140 @@ spec:
 	assert.NotEmpty(t, wf.GetAnnotations()[AnnotationKeyCronWfScheduledTime])
 }
 
+func TestConvertCronWorkflowToWorkflowTimezone(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "hello-world"},
+		Spec:       v1alpha1.CronWorkflowSpec{Schedules: []string{"* * * * *"}},
+	}
+	wf := ConvertCronWorkflowToWorkflowWithProperties(cronWf, "hello-world-1", time.Now())
+	assert.NotContains(t, wf.Annotations, AnnotationKeyCronWfTimezone)
+
+	cronWf.Spec.Timezone = "America/Los_Angeles"
+	wf = ConvertCronWorkflowToWorkflowWithProperties(cronWf, "hello-world-1", time.Now())
+	assert.Equal(t, "America/Los_Angeles", wf.Annotations[AnnotationKeyCronWfTimezone])
+	wf = ConvertCronWorkflowToWorkflow(cronWf)
+	assert.Equal(t, "America/Los_Angeles", wf.Annotations[AnnotationKeyCronWfTimezone])
+}
+
+func TestConvertCronWorkflowToWorkflowLineage(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "hello-world", UID: "my-uid", Generation: 3},
+		Spec: v1alpha1.CronWorkflowSpec{
+			Schedules:        []string{"* * * * *"},
+			WorkflowMetadata: &metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyCronWfGeneration: "1"}},
+		},
+	}
+	wf := ConvertCronWorkflowToWorkflowWithProperties(cronWf, "hello-world-1", time.Now())
+	assert.Equal(t, "my-uid", wf.Annotations[AnnotationKeyCronWfUID])
+	assert.Equal(t, "3", wf.Annotations[AnnotationKeyCronWfGeneration])
+	require.Len(t, wf.OwnerReferences, 1)
+	assert.Equal(t, "hello-world", wf.OwnerReferences[0].Name)
+	assert.Equal(t, types.UID("my-uid"), wf.OwnerReferences[0].UID)
+	assert.True(t, *wf.OwnerReferences[0].Controller)
+
+	cronWf.UID = ""
+	wf = ConvertCronWorkflowToWorkflow(cronWf)
+	assert.NotContains(t, wf.Annotations, AnnotationKeyCronWfUID)
+	assert.Len(t, wf.OwnerReferences, 1)
+}
+
+func TestBuildWorkflow(t *testing.T) {
+	scheduledTime := time.Date(2021, 2, 19, 10, 29, 0, 0, time.UTC)
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(`apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: hello-world
+  namespace: argo
+spec:
+  schedules:
+    - "* * * * *"
+  workflowMetadata:
+    labels:
+      owner: "{{cronworkflow.name}}"
+    annotations:
+      scheduled: "{{cronworkflow.schedule}} at {{cronworkflow.scheduledTime}}"
+      untouched: "{{workflow.name}}"
+  workflowSpec:
+    entrypoint: whalesay
+`), &cronWf)
+
+	wf, err := BuildWorkflow(&cronWf, scheduledTime, "* * * * *")
+	require.NoError(t, err)
+	assert.Equal(t, "hello-world-1613730540", wf.Name)
+	assert.Equal(t, "hello-world", wf.Labels["owner"])
+	assert.Equal(t, "hello-world", wf.Labels[LabelKeyCronWorkflow])
+	assert.Equal(t, "* * * * * at 2021-02-19T10:29:00Z", wf.Annotations["scheduled"])
+	assert.Equal(t, "{{workflow.name}}", wf.Annotations["untouched"])
+	assert.Equal(t, "2021-02-19T10:29:00Z", wf.Annotations[AnnotationKeyCronWfScheduledTime])
+
+	t.Run("ControllerAnnotations", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.UID = "my-uid"
+		cronWf.Generation = 3
+		cronWf.Spec.Timezone = "America/Los_Angeles"
+		cronWf.Spec.WorkflowMetadata.Annotations = map[string]string{
+			AnnotationKeyCronWfUID:           "other-uid",
+			AnnotationKeyCronWfGeneration:    "1",
+			AnnotationKeyCronWfTimezone:      "UTC",
+			AnnotationKeyCronWfScheduledTime: "{{cronworkflow.scheduledDate}}",
+		}
+		wf, err := BuildWorkflow(cronWf, scheduledTime, "* * * * *")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			AnnotationKeyCronWfUID:           "my-uid",
+			AnnotationKeyCronWfGeneration:    "3",
+			AnnotationKeyCronWfTimezone:      "America/Los_Angeles",
+			AnnotationKeyCronWfScheduledTime: "2021-02-19T10:29:00Z",
+		}, wf.Annotations, "the workflow metadata cannot override them")
+
+		cronWf.UID = ""
+		cronWf.Spec.Timezone = ""
+		wf, err = BuildWorkflow(cronWf, scheduledTime, "* * * * *")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{AnnotationKeyCronWfScheduledTime: "2021-02-19T10:29:00Z"}, wf.Annotations)
+	})
+
+	t.Run("InvalidLabelValue", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.WorkflowMetadata.Labels["owner"] = "{{cronworkflow.schedule}}"
+		_, err := BuildWorkflow(cronWf, scheduledTime, "* * * * *")
+		require.ErrorContains(t, err, `invalid label value "* * * * *" for workflow label "owner"`)
+	})
+
+	t.Run("NoWorkflowMetadata", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.WorkflowMetadata = nil
+		wf, err := BuildWorkflow(cronWf, scheduledTime, "* * * * *")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{LabelKeyCronWorkflow: "hello-world"}, wf.Labels)
+	})
+
+	t.Run("Image", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.WorkflowSpec.Templates = []v1alpha1.Template{{
+			Name:      "whalesay",
+			Container: &corev1.Container{Image: "myapp:{{cronworkflow.scheduledDate}}"},
+			Sidecars:  []v1alpha1.UserContainer{{Container: corev1.Container{Image: "proxy:{{workflow.parameters.tag}}"}}},
+		}}
+		wf, err := BuildWorkflow(cronWf, scheduledTime, "* * * * *")
+		require.NoError(t, err)
+		assert.Equal(t, "myapp:2021-02-19", wf.Spec.Templates[0].Container.Image)
+		assert.Equal(t, "proxy:{{workflow.parameters.tag}}", wf.Spec.Templates[0].Sidecars[0].Image)
+		assert.Equal(t, "myapp:{{cronworkflow.scheduledDate}}", cronWf.Spec.WorkflowSpec.Templates[0].Container.Image, "the CronWorkflow is not modified")
+	})
+
+	t.Run("UnresolvedImage", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.WorkflowSpec.Templates = []v1alpha1.Template{{
+			Name:   "whalesay",
+			Script: &v1alpha1.ScriptTemplate{Container: corev1.Container{Image: "myapp:{{cronworkflow.version}}"}},
+		}}
+		_, err := BuildWorkflow(cronWf, scheduledTime, "* * * * *")
+		require.ErrorContains(t, err, `failed to template image "myapp:{{cronworkflow.version}}" of template "whalesay": failed to resolve {{cronworkflow.version}}`)
+	})
+}
+
 const workflowTmpl = `
 apiVersion: argoproj.io/v1alpha1
 kind: WorkflowTemplate
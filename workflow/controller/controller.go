@@ -226,7 +226,11 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 	}
 
 	deprecation.Initialize(wfc.metrics.DeprecatedFeature)
-	wfc.entrypoint = entrypoint.New(kubeclientset, wfc.Config.Images)
+	if c := wfc.Config.EntrypointCache; c != nil && c.ConfigMapName != "" {
+		wfc.entrypoint = entrypoint.NewWithConfigMapCache(kubeclientset, wfc.Config.Images, wfc.namespace, c.ConfigMapName, c.GetMaxEntries(), c.GetTagTTL())
+	} else {
+		wfc.entrypoint = entrypoint.New(kubeclientset, wfc.Config.Images)
+	}
 
 	workqueue.SetProvider(wfc.metrics) // must execute SetProvider before we create the queues
 	wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkers(ctx, &fixedItemIntervalRateLimiter{}, "workflow_queue")
@@ -261,7 +265,9 @@ func (wfc *WorkflowController) runPodController(ctx context.Context, podGCWorker
 func (wfc *WorkflowController) runCronController(ctx context.Context, cronWorkflowWorkers int) {
 	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
 
-	cronController := cron.NewCronController(ctx, wfc.wfclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager, cronWorkflowWorkers, wfc.wftmplInformer, wfc.cwftmplInformer, wfc.Config.WorkflowDefaults)
+	cronController := cron.NewCronController(ctx, wfc.wfclientset, wfc.kubeclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager, cronWorkflowWorkers, wfc.wftmplInformer, wfc.cwftmplInformer, wfc.Config.WorkflowDefaults, wfc.entrypoint)
+	cronController.SetNamespaceTimezones(wfc.Config.CronWorkflowTimezones)
+	cronController.SetDefaultTimezoneUTC(wfc.Config.CronWorkflowDefaultTimezoneUTC)
 	cronController.Run(ctx)
 }
 
@@ -275,6 +275,10 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 					Entrypoint: []string{"my-entrypoint"},
 					Cmd:        []string{"my-cmd"},
 				},
+				"my-shell-image": {
+					Entrypoint: []string{},
+					Cmd:        []string{"/bin/sh", "-c", "echo hi"},
+				},
 				"argoproj/argosay:v2":    {Cmd: []string{""}},
 				"docker/whalesay:latest": {Cmd: []string{""}},
 				"busybox":                {Cmd: []string{""}},
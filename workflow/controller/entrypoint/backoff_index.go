@@ -0,0 +1,82 @@
+package entrypoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// backoffIndex stops looking up an image for a while after the registry rate limits a lookup of it, doubling the time
+// for each rate limit in a row, so that callers that retry on every reconcile do not keep the registry rate limiting
+// them. Lookups of the image return ErrRateLimited without calling the delegate until the backoff has passed.
+type backoffIndex struct {
+	backoff  *flowcontrol.Backoff
+	delegate Interface
+}
+
+// WithRateLimitBackoff returns an index that backs off looking up an image, starting at initial and up to max, when
+// the registry rate limits lookups of it
+func WithRateLimitBackoff(delegate Interface, initial, max time.Duration) Interface {
+	return &backoffIndex{flowcontrol.NewBackOff(initial, max), delegate}
+}
+
+func (i *backoffIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	if err := i.checkBackoff(image); err != nil {
+		return nil, err
+	}
+	v, err := i.delegate.Lookup(ctx, image, options)
+	i.update(image, err)
+	return v, err
+}
+
+func (i *backoffIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	if err := i.checkBackoff(image); err != nil {
+		return nil, err
+	}
+	v, err := i.delegate.LookupConfig(ctx, image, options)
+	i.update(image, err)
+	return v, err
+}
+
+func (i *backoffIndex) Ping(ctx context.Context, image string, options Options) error {
+	if err := i.checkBackoff(image); err != nil {
+		return err
+	}
+	err := i.delegate.Ping(ctx, image, options)
+	i.update(image, err)
+	return err
+}
+
+// Warm skips the images that are backing off
+func (i *backoffIndex) Warm(ctx context.Context, images []string, options Options) error {
+	var ready []string
+	for _, image := range images {
+		if i.checkBackoff(image) == nil {
+			ready = append(ready, image)
+		}
+	}
+	return i.delegate.Warm(ctx, ready, options)
+}
+
+func (i *backoffIndex) checkBackoff(image string) error {
+	now := i.backoff.Clock.Now()
+	if i.backoff.IsInBackOffSinceUpdate(image, now) {
+		return fmt.Errorf("%s: %w: backing off for %s", image, ErrRateLimited, i.backoff.Get(image))
+	}
+	return nil
+}
+
+func (i *backoffIndex) update(image string, err error) {
+	if IsRateLimited(err) {
+		i.backoff.Next(image, i.backoff.Clock.Now())
+		log.WithError(err).WithField("image", image).WithField("backoff", i.backoff.Get(image)).Warn("registry rate limited the look-up, backing off")
+	} else {
+		i.backoff.Reset(image)
+	}
+}
+
+var _ Interface = &backoffIndex{}
@@ -0,0 +1,99 @@
+package entrypoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/flowcontrol"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+// noRetryIndex looks images up in a registry without retrying, so that each lookup is a single request
+type noRetryIndex struct{}
+
+func (noRetryIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	ref, err := name.ParseReference(image, name.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	return lookupRemote(ctx, ref, options, remote.WithRetryStatusCodes())
+}
+
+func (noRetryIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	ref, err := name.ParseReference(image, name.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	return lookupRemoteConfig(ctx, ref, options, remote.WithRetryStatusCodes())
+}
+
+func (noRetryIndex) Ping(ctx context.Context, image string, options Options) error {
+	ref, err := name.ParseReference(image, name.Insecure)
+	if err != nil {
+		return err
+	}
+	return pingRemote(ref, remote.WithContext(ctx), remote.WithRetryStatusCodes())
+}
+
+func (noRetryIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
+func TestRateLimitBackoff(t *testing.T) {
+	var requests atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		requests.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer s.Close()
+	image := strings.TrimPrefix(s.URL, "http://") + "/app:latest"
+	clock := testingclock.NewFakeClock(time.Now())
+	i := &backoffIndex{flowcontrol.NewFakeBackOff(10*time.Second, time.Minute, clock), noRetryIndex{}}
+	ctx := context.Background()
+
+	_, err := i.Lookup(ctx, image, Options{})
+	require.ErrorIs(t, err, ErrRateLimited)
+	assert.True(t, IsRateLimited(err))
+	assert.Equal(t, int32(1), requests.Load())
+
+	// the registry is not asked again until the backoff has passed
+	_, err = i.Lookup(ctx, image, Options{})
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.ErrorIs(t, i.Ping(ctx, image, Options{}), ErrRateLimited)
+	_, err = i.LookupConfig(ctx, image, Options{})
+	require.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(1), requests.Load())
+
+	clock.Step(11 * time.Second)
+	_, err = i.Lookup(ctx, image, Options{})
+	require.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// the backoff doubles while the registry keeps rate limiting
+	clock.Step(11 * time.Second)
+	_, err = i.Lookup(ctx, image, Options{})
+	require.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(2), requests.Load())
+	clock.Step(10 * time.Second)
+	require.ErrorIs(t, i.Ping(ctx, image, Options{}), ErrRateLimited)
+	assert.Equal(t, int32(3), requests.Load())
+
+	// other images are not affected
+	_, err = i.Lookup(ctx, strings.TrimPrefix(s.URL, "http://")+"/other:latest", Options{})
+	require.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(4), requests.Load())
+}
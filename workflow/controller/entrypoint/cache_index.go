@@ -2,7 +2,9 @@ package entrypoint
 
 import (
 	"context"
+	"time"
 
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/utils/lru"
 )
@@ -13,15 +15,95 @@ type cacheIndex struct {
 }
 
 func (i *cacheIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
-	if cmd, ok := i.cache.Get(image); ok {
+	if v, ok := options.entrypointOverride(image); ok {
+		log.WithField("image", image).WithField("cmd", v).Debug("Entrypoint override")
+		return v, nil
+	}
+	if options.SignaturePolicy != nil {
+		// the signature is verified on every lookup, so that one that is removed is noticed
+		return i.delegate.Lookup(ctx, image, options)
+	}
+	key := options.cacheKey(image)
+	if cmd, ok := i.cache.Get(key); ok {
 		log.WithField("image", image).WithField("cmd", cmd).Debug("Cache hit")
-		return cmd.(*Image), nil
+		v := *cmd.(*Image)
+		v.Source = SourceCache
+		return &v, nil
 	}
 	log.WithField("image", image).Debug("Cache miss")
 	v, err := i.delegate.Lookup(ctx, image, options)
 	if err != nil {
 		return nil, err
 	}
-	i.cache.Add(image, v)
+	if v.ResolvedAt.IsZero() {
+		resolved := *v
+		resolved.ResolvedAt = time.Now()
+		v = &resolved
+	}
+	i.cache.Add(key, v)
 	return v, nil
 }
+
+func (i *cacheIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	return i.delegate.LookupConfig(ctx, image, options)
+}
+
+func (i *cacheIndex) Ping(ctx context.Context, image string, options Options) error {
+	return i.delegate.Ping(ctx, image, options)
+}
+
+func (i *cacheIndex) Warm(ctx context.Context, images []string, options Options) error {
+	for _, image := range images {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := i.Lookup(ctx, image, options); err != nil {
+			log.WithError(err).WithField("image", image).Warn("failed to warm entrypoint cache")
+		}
+	}
+	return nil
+}
+
+// cacheKey returns the key to cache the entrypoint/cmd of image under, which is the same for equivalent references
+func (o Options) cacheKey(image string) string {
+	key, err := canonicalReference(image)
+	if err != nil {
+		// not a valid reference, let the delegate decide what to do with it
+		key = image
+	}
+	// the same multi-platform image resolves to a different entrypoint per platform
+	for _, platform := range o.platforms() {
+		key = key + " " + platform.String()
+	}
+	if o.EntrypointAnnotation != "" {
+		// as does an image read with a different annotation
+		key = key + " " + o.EntrypointAnnotation
+	}
+	if o.AllowSchema1 {
+		// a schema 1 image resolved with it must not be served to lookups that do not allow them
+		key = key + " schema1"
+	}
+	return key
+}
+
+// entrypointOverride returns the override for image, if any, matching the keys as equivalent references
+func (o Options) entrypointOverride(image string) (*Image, bool) {
+	if len(o.EntrypointOverrides) == 0 {
+		return nil, false
+	}
+	if v, ok := o.EntrypointOverrides[image]; ok {
+		v.Source = SourceOverride
+		return v.normalized(), true
+	}
+	ref, err := canonicalReference(image)
+	if err != nil {
+		return nil, false
+	}
+	for key, v := range o.EntrypointOverrides {
+		if keyRef, err := canonicalReference(key); err == nil && keyRef == ref {
+			v.Source = SourceOverride
+			return v.normalized(), true
+		}
+	}
+	return nil, false
+}
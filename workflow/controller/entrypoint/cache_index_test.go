@@ -0,0 +1,198 @@
+package entrypoint
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/lru"
+)
+
+type countingIndex struct {
+	lookups int
+	image   *Image
+}
+
+func (c *countingIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	c.lookups++
+	return c.image, nil
+}
+
+func (c *countingIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	return nil, nil
+}
+
+func (c *countingIndex) Ping(ctx context.Context, image string, options Options) error {
+	return nil
+}
+
+func (c *countingIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
+func TestCacheIndex(t *testing.T) {
+	delegate := &countingIndex{image: &Image{Entrypoint: []string{"nginx"}}}
+	i := &cacheIndex{lru.New(10), delegate}
+	ctx := context.Background()
+	for _, image := range []string{"nginx", "docker.io/library/nginx:latest", "index.docker.io/library/nginx:latest"} {
+		v, err := i.Lookup(ctx, image, Options{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"nginx"}, v.Entrypoint)
+	}
+	assert.Equal(t, 1, delegate.lookups)
+}
+
+func TestCacheIndexResolvedAt(t *testing.T) {
+	delegate := &countingIndex{image: &Image{Entrypoint: []string{"nginx"}, Source: SourceRegistry}}
+	i := &cacheIndex{lru.New(10), delegate}
+	ctx := context.Background()
+	before := time.Now()
+	resolved, err := i.Lookup(ctx, "nginx", Options{})
+	require.NoError(t, err)
+	assert.WithinRange(t, resolved.ResolvedAt, before, time.Now())
+	assert.True(t, delegate.image.ResolvedAt.IsZero(), "the delegate's image is not modified")
+
+	// a hit reports when the entry was resolved, not when it was looked up
+	cached, err := i.Lookup(ctx, "nginx", Options{})
+	require.NoError(t, err)
+	assert.Equal(t, SourceCache, cached.Source)
+	assert.Equal(t, resolved.ResolvedAt, cached.ResolvedAt)
+	age, ok := cached.Age(resolved.ResolvedAt.Add(time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, time.Hour, age)
+
+	override, err := i.Lookup(ctx, "nginx", Options{EntrypointOverrides: map[string]Image{"nginx": {Entrypoint: []string{"/override"}}}})
+	require.NoError(t, err)
+	_, ok = override.Age(time.Now())
+	assert.False(t, ok, "an override is not resolved")
+}
+
+func TestCacheIndexWarm(t *testing.T) {
+	delegate := &countingIndex{image: &Image{Entrypoint: []string{"nginx"}}}
+	i := &cacheIndex{lru.New(10), delegate}
+	ctx := context.Background()
+	require.NoError(t, i.Warm(ctx, []string{"nginx", "alpine"}, Options{}))
+	assert.Equal(t, 2, delegate.lookups)
+	v, err := i.Lookup(ctx, "docker.io/library/nginx:latest", Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nginx"}, v.Entrypoint)
+	assert.Equal(t, 2, delegate.lookups, "lookup hits the cache")
+
+	t.Run("Error", func(t *testing.T) {
+		i := &cacheIndex{lru.New(10), chainIndex{}}
+		require.NoError(t, i.Warm(ctx, []string{"nginx"}, Options{}))
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		require.ErrorIs(t, i.Warm(ctx, []string{"busybox"}, Options{}), context.Canceled)
+		assert.Equal(t, 2, delegate.lookups)
+	})
+}
+
+func TestCacheIndexEntrypointOverrides(t *testing.T) {
+	delegate := &countingIndex{image: &Image{Entrypoint: []string{"nginx"}}}
+	i := &cacheIndex{lru.New(10), delegate}
+	ctx := context.Background()
+	_, err := i.Lookup(ctx, "nginx", Options{})
+	require.NoError(t, err)
+
+	options := Options{EntrypointOverrides: map[string]Image{"docker.io/library/nginx:latest": {Entrypoint: []string{"/debug"}}}}
+	for _, image := range []string{"nginx", "docker.io/library/nginx:latest", "index.docker.io/library/nginx"} {
+		v, err := i.Lookup(ctx, image, options)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/debug"}, v.Entrypoint)
+	}
+	v, err := i.Lookup(ctx, "nginx:1.27", options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nginx"}, v.Entrypoint)
+	assert.Equal(t, 2, delegate.lookups)
+}
+
+type remoteIndex struct {
+	lookups int
+}
+
+func (r *remoteIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	r.lookups++
+	ref, err := name.ParseReference(image, name.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	return lookupRemote(ctx, ref, options)
+}
+
+func (r *remoteIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	ref, err := name.ParseReference(image, name.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	return lookupRemoteConfig(ctx, ref, options)
+}
+
+func (r *remoteIndex) Ping(ctx context.Context, image string, options Options) error {
+	return nil
+}
+
+func (r *remoteIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
+func TestCacheIndexPlatform(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	image := strings.TrimPrefix(s.URL, "http://") + "/multi-arch:latest"
+
+	amd64 := &gcrv1.Platform{OS: "linux", Architecture: "amd64"}
+	arm64 := &gcrv1.Platform{OS: "linux", Architecture: "arm64"}
+	var index gcrv1.ImageIndex = empty.Index
+	for _, p := range []*gcrv1.Platform{amd64, arm64} {
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/bin/" + p.Architecture}})
+		require.NoError(t, err)
+		index = mutate.AppendManifests(index, mutate.IndexAddendum{Add: img, Descriptor: gcrv1.Descriptor{Platform: p}})
+	}
+	ref, err := name.ParseReference(image, name.Insecure)
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, index))
+
+	delegate := &remoteIndex{}
+	i := &cacheIndex{lru.New(10), delegate}
+	ctx := context.Background()
+	for range 2 {
+		v, err := i.Lookup(ctx, image, Options{Platform: amd64})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/bin/amd64"}, v.Entrypoint)
+		v, err = i.Lookup(ctx, image, Options{Platform: arm64})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/bin/arm64"}, v.Entrypoint)
+	}
+	assert.Equal(t, 2, delegate.lookups)
+}
+
+func TestCacheIndexAllowSchema1(t *testing.T) {
+	image := newSchema1Registry(t) + "/legacy/app:v1"
+	delegate := &remoteIndex{}
+	i := &cacheIndex{lru.New(10), delegate}
+	ctx := context.Background()
+	for range 2 {
+		v, err := i.Lookup(ctx, image, Options{AllowSchema1: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/docker-entrypoint.sh"}, v.Entrypoint)
+		_, err = i.Lookup(ctx, image, Options{})
+		require.ErrorIs(t, err, ErrUnsupportedManifestSchema)
+	}
+	assert.Equal(t, 3, delegate.lookups, "only the lookup allowing schema 1 is cached")
+}
@@ -3,6 +3,8 @@ package entrypoint
 import (
 	"context"
 	"fmt"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 )
 
 type chainIndex []Interface
@@ -17,4 +19,28 @@ func (c chainIndex) Lookup(ctx context.Context, image string, options Options) (
 	return nil, fmt.Errorf("image not found")
 }
 
+func (c chainIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	for _, i := range c {
+		v, err := i.LookupConfig(ctx, image, options)
+		if v != nil || err != nil {
+			return v, err
+		}
+	}
+	return nil, fmt.Errorf("image not found")
+}
+
+func (c chainIndex) Ping(ctx context.Context, image string, options Options) error {
+	for _, i := range c {
+		if err := i.Ping(ctx, image, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Warm has nothing to do, since the chain does not cache
+func (c chainIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
 var _ Interface = chainIndex{}
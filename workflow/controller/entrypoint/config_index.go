@@ -3,6 +3,8 @@ package entrypoint
 import (
 	"context"
 
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+
 	"github.com/argoproj/argo-workflows/v3/config"
 )
 
@@ -13,7 +15,26 @@ func (c configIndex) Lookup(ctx context.Context, image string, options Options)
 	if !ok {
 		return nil, nil
 	}
-	return &Image{Cmd: v.Cmd, Entrypoint: v.Entrypoint}, nil
+	return &Image{Cmd: nilIfEmpty(v.Cmd), Entrypoint: nilIfEmpty(v.Entrypoint), Source: SourceConfig}, nil
+}
+
+// LookupConfig returns a config with only the configured entrypoint/cmd set
+func (c configIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	v, ok := c[image]
+	if !ok {
+		return nil, nil
+	}
+	return &gcrv1.ConfigFile{Config: gcrv1.Config{Cmd: nilIfEmpty(v.Cmd), Entrypoint: nilIfEmpty(v.Entrypoint)}}, nil
+}
+
+// Ping has nothing to check, since configured images are never fetched from a registry
+func (c configIndex) Ping(ctx context.Context, image string, options Options) error {
+	return nil
+}
+
+// Warm has nothing to do, since configured images are already in memory
+func (c configIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
 }
 
 var _ Interface = &configIndex{}
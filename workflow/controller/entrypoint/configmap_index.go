@@ -0,0 +1,249 @@
+package entrypoint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// maxConfigMapCacheSize limits the size of the data of the ConfigMap, leaving room for its metadata within the 1MiB
+// limit of a Kubernetes object
+const maxConfigMapCacheSize = 900 * 1024
+
+// configMapIndex persists the entrypoint/cmd of images resolved from their registry in a ConfigMap, so that they are
+// not looked up again after the controller restarts. The ConfigMap is read once, on the first lookup, and then kept in
+// memory; lookups write through it once the delegate resolves the image. Writes are best effort: a failure to read or
+// write the ConfigMap is logged, and the lookup falls back to the delegate.
+type configMapIndex struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	name       string
+	// maxEntries limits the number of images in the ConfigMap. The least recently resolved are evicted first, as they
+	// are to keep the ConfigMap within maxConfigMapCacheSize.
+	maxEntries int
+	// tagTTL is how long the entrypoint/cmd of an image referenced by tag is kept, since the tag may be moved to another
+	// image. Images referenced by digest never change, so they are kept until evicted.
+	tagTTL   time.Duration
+	delegate Interface
+	lock     sync.Mutex
+	// data is the ConfigMap's data once it has been read, updated with each write to it
+	data map[string]string
+	now  func() time.Time
+}
+
+// configMapCacheEntry is the value of an image in the ConfigMap, keyed by the hash of the image's cache key
+type configMapCacheEntry struct {
+	// Key is the image's cache key, e.g. its canonical reference, to detect hash collisions
+	Key        string      `json:"key"`
+	Entrypoint []string    `json:"entrypoint,omitempty"`
+	Cmd        []string    `json:"cmd,omitempty"`
+	StopSignal string      `json:"stopSignal,omitempty"`
+	Resolved   metav1.Time `json:"resolved"`
+}
+
+// WithConfigMapCache returns an index that persists the entrypoint/cmd of images that delegate resolves from their
+// registry in the ConfigMap namespace/name, which is created if it does not exist. An existing ConfigMap must have the
+// workflows.argoproj.io/configmap-type: EntrypointCache label, so that another ConfigMap is never overwritten. At most
+// maxEntries images are kept, and images referenced by tag are looked up again after tagTTL.
+func WithConfigMapCache(delegate Interface, kubeClient kubernetes.Interface, namespace, name string, maxEntries int, tagTTL time.Duration) Interface {
+	return &configMapIndex{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		name:       name,
+		maxEntries: maxEntries,
+		tagTTL:     tagTTL,
+		delegate:   delegate,
+		now:        time.Now,
+	}
+}
+
+func (i *configMapIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	if _, ok := options.entrypointOverride(image); ok || options.SignaturePolicy != nil {
+		return i.delegate.Lookup(ctx, image, options)
+	}
+	key := options.cacheKey(image)
+	if v := i.load(ctx, key); v != nil {
+		log.WithField("image", image).WithField("cmd", v).Debug("ConfigMap cache hit")
+		return v, nil
+	}
+	v, err := i.delegate.Lookup(ctx, image, options)
+	if err != nil || v == nil {
+		return v, err
+	}
+	if v.ResolvedAt.IsZero() {
+		resolved := *v
+		resolved.ResolvedAt = i.now()
+		v = &resolved
+	}
+	// only images resolved from their registry are worth persisting, the other sources are as fast as the ConfigMap
+	if v.Source == SourceRegistry {
+		i.save(ctx, key, v)
+	}
+	return v, nil
+}
+
+func (i *configMapIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	return i.delegate.LookupConfig(ctx, image, options)
+}
+
+func (i *configMapIndex) Ping(ctx context.Context, image string, options Options) error {
+	return i.delegate.Ping(ctx, image, options)
+}
+
+func (i *configMapIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return i.delegate.Warm(ctx, images, options)
+}
+
+func (i *configMapIndex) log() *log.Entry {
+	return log.WithField("namespace", i.namespace).WithField("name", i.name)
+}
+
+// get returns the ConfigMap, or nil if it does not exist or is not an entrypoint cache
+func (i *configMapIndex) get(ctx context.Context) (*apiv1.ConfigMap, error) {
+	cm, err := i.kubeClient.CoreV1().ConfigMaps(i.namespace).Get(ctx, i.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cm.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapEntrypointCache {
+		i.log().Warnf("entrypoint cache ConfigMap does not have the %s: %s label, refusing to use it", common.LabelKeyConfigMapType, common.LabelValueTypeConfigMapEntrypointCache)
+		return nil, nil
+	}
+	return cm, nil
+}
+
+func (i *configMapIndex) load(ctx context.Context, key string) *Image {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.data == nil {
+		cm, err := i.get(ctx)
+		if err != nil {
+			i.log().WithError(err).Warn("failed to read entrypoint cache ConfigMap")
+			return nil
+		}
+		i.data = map[string]string{}
+		if cm != nil {
+			maps.Copy(i.data, cm.Data)
+		}
+	}
+	data, ok := i.data[configMapCacheKey(key)]
+	if !ok {
+		return nil
+	}
+	var entry configMapCacheEntry
+	if err := json.Unmarshal([]byte(data), &entry); err != nil || entry.Key != key || i.expired(entry) {
+		return nil
+	}
+	return Image{Entrypoint: entry.Entrypoint, Cmd: entry.Cmd, StopSignal: entry.StopSignal, Source: SourceCache, ResolvedAt: entry.Resolved.Time}.normalized()
+}
+
+func (i *configMapIndex) save(ctx context.Context, key string, v *Image) {
+	data, err := json.Marshal(configMapCacheEntry{
+		Key:        key,
+		Entrypoint: v.Entrypoint,
+		Cmd:        v.Cmd,
+		StopSignal: v.StopSignal,
+		Resolved:   metav1.Time{Time: v.ResolvedAt},
+	})
+	if err != nil {
+		i.log().WithError(err).Warn("failed to marshal entrypoint cache entry")
+		return
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	// the ConfigMap is read again before each write, so that a concurrent write is merged rather than overwritten
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		cm, err := i.get(ctx)
+		if err != nil {
+			return err
+		}
+		if cm == nil {
+			cm = &apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      i.name,
+				Namespace: i.namespace,
+				Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapEntrypointCache},
+			}}
+			cm.Data = map[string]string{configMapCacheKey(key): string(data)}
+			i.evict(cm)
+			cm, err = i.kubeClient.CoreV1().ConfigMaps(i.namespace).Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			if cm.Data == nil {
+				cm.Data = map[string]string{}
+			}
+			cm.Data[configMapCacheKey(key)] = string(data)
+			i.evict(cm)
+			cm, err = i.kubeClient.CoreV1().ConfigMaps(i.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return err
+		}
+		i.data = map[string]string{}
+		maps.Copy(i.data, cm.Data)
+		return nil
+	})
+	if err != nil {
+		// the entry is saved the next time the image is resolved
+		i.log().WithError(err).Warn("failed to write entrypoint cache ConfigMap")
+	}
+}
+
+// evict removes expired and malformed entries, and then the least recently resolved entries until the ConfigMap has at
+// most maxEntries and is within maxConfigMapCacheSize
+func (i *configMapIndex) evict(cm *apiv1.ConfigMap) {
+	type resolved struct {
+		key  string
+		time time.Time
+	}
+	var entries []resolved
+	size := 0
+	for k, data := range cm.Data {
+		var entry configMapCacheEntry
+		if err := json.Unmarshal([]byte(data), &entry); err != nil || i.expired(entry) {
+			delete(cm.Data, k)
+			continue
+		}
+		entries = append(entries, resolved{k, entry.Resolved.Time})
+		size += len(k) + len(data)
+	}
+	slices.SortFunc(entries, func(a, b resolved) int { return a.time.Compare(b.time) })
+	for _, e := range entries {
+		if len(cm.Data) <= i.maxEntries && size <= maxConfigMapCacheSize {
+			break
+		}
+		size -= len(e.key) + len(cm.Data[e.key])
+		delete(cm.Data, e.key)
+	}
+}
+
+// expired returns true if the entry is of an image referenced by tag that was resolved more than tagTTL ago
+func (i *configMapIndex) expired(entry configMapCacheEntry) bool {
+	ref, _, _ := strings.Cut(entry.Key, " ")
+	return !strings.Contains(ref, "@") && i.now().Sub(entry.Resolved.Time) > i.tagTTL
+}
+
+// configMapCacheKey returns the ConfigMap data key for the cache key, which may contain characters that ConfigMap
+// keys may not, such as '/' and ':'
+func configMapCacheKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16])
+}
+
+var _ Interface = &configMapIndex{}
@@ -0,0 +1,192 @@
+package entrypoint
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestConfigMapIndex(t *testing.T) {
+	const digest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+	ctx := context.Background()
+	newIndex := func(kubeClient *fake.Clientset, delegate Interface, maxEntries int) *configMapIndex {
+		return WithConfigMapCache(delegate, kubeClient, "argo", "entrypoint-cache", maxEntries, time.Hour).(*configMapIndex)
+	}
+	getConfigMap := func(t *testing.T, kubeClient *fake.Clientset) *apiv1.ConfigMap {
+		t.Helper()
+		cm, err := kubeClient.CoreV1().ConfigMaps("argo").Get(ctx, "entrypoint-cache", metav1.GetOptions{})
+		require.NoError(t, err)
+		return cm
+	}
+
+	t.Run("ReadThroughWriteThrough", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		i := newIndex(kubeClient, delegate, 10)
+		image, err := i.Lookup(ctx, "app", Options{})
+		require.NoError(t, err)
+		assert.Equal(t, SourceRegistry, image.Source)
+		cm := getConfigMap(t, kubeClient)
+		assert.Equal(t, common.LabelValueTypeConfigMapEntrypointCache, cm.Labels[common.LabelKeyConfigMapType])
+		assert.Len(t, cm.Data, 1)
+
+		// an equivalent reference is read from the ConfigMap, as it is by another controller after a restart
+		for _, i := range []*configMapIndex{i, newIndex(kubeClient, delegate, 10)} {
+			image, err := i.Lookup(ctx, "docker.io/library/app:latest", Options{})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"/app"}, image.Entrypoint)
+			assert.Equal(t, SourceCache, image.Source)
+		}
+		assert.Equal(t, 1, delegate.lookups)
+	})
+	t.Run("ReadOnce", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		i := newIndex(kubeClient, delegate, 10)
+		for _, image := range []string{"a", "b", "a", "b", "c"} {
+			_, err := i.Lookup(ctx, image, Options{})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, delegate.lookups)
+		gets := 0
+		for _, action := range kubeClient.Actions() {
+			if action.GetVerb() == "get" {
+				gets++
+			}
+		}
+		// once by the first lookup, and once before each write
+		assert.Equal(t, 4, gets)
+	})
+	t.Run("Conflict", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		i := newIndex(kubeClient, delegate, 10)
+		_, err := i.Lookup(ctx, "a", Options{})
+		require.NoError(t, err)
+		conflicts := 0
+		kubeClient.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if conflicts > 0 {
+				return false, nil, nil
+			}
+			conflicts++
+			return true, nil, apierr.NewConflict(schema.GroupResource{Resource: "configmaps"}, "entrypoint-cache", errors.New("the object has been modified"))
+		})
+		_, err = i.Lookup(ctx, "b", Options{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, conflicts)
+		assert.Len(t, getConfigMap(t, kubeClient).Data, 2, "the write is retried")
+	})
+	t.Run("ResolvedAt", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		resolvedAt := time.Date(2024, time.June, 1, 9, 0, 0, 0, time.UTC)
+		i := newIndex(kubeClient, delegate, 10)
+		i.now = func() time.Time { return resolvedAt }
+		image, err := i.Lookup(ctx, "app", Options{})
+		require.NoError(t, err)
+		assert.True(t, resolvedAt.Equal(image.ResolvedAt))
+
+		// another controller reads when the entry was resolved, so the age spans the restart
+		restarted := newIndex(kubeClient, delegate, 10)
+		restarted.now = func() time.Time { return resolvedAt.Add(30 * time.Minute) }
+		image, err = restarted.Lookup(ctx, "app", Options{})
+		require.NoError(t, err)
+		assert.Equal(t, SourceCache, image.Source)
+		age, ok := image.Age(resolvedAt.Add(30 * time.Minute))
+		assert.True(t, ok)
+		assert.Equal(t, 30*time.Minute, age)
+	})
+	t.Run("NotFromRegistry", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceLocal}}
+		_, err := newIndex(kubeClient, delegate, 10).Lookup(ctx, "app", Options{})
+		require.NoError(t, err)
+		_, err = kubeClient.CoreV1().ConfigMaps("argo").Get(ctx, "entrypoint-cache", metav1.GetOptions{})
+		require.Error(t, err, "images resolved locally are not persisted")
+	})
+	t.Run("Override", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		i := newIndex(kubeClient, delegate, 10)
+		_, err := i.Lookup(ctx, "app", Options{})
+		require.NoError(t, err)
+		// the override is the delegate's to apply
+		_, err = i.Lookup(ctx, "app", Options{EntrypointOverrides: map[string]Image{"app": {Entrypoint: []string{"/override"}}}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, delegate.lookups)
+	})
+	t.Run("Eviction", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		i := newIndex(kubeClient, delegate, 2)
+		now := time.Now()
+		for _, image := range []string{"a", "b", "c"} {
+			i.now = func() time.Time { return now }
+			now = now.Add(time.Minute)
+			_, err := i.Lookup(ctx, image, Options{})
+			require.NoError(t, err)
+		}
+		assert.Len(t, getConfigMap(t, kubeClient).Data, 2)
+		// the least recently resolved is evicted
+		for _, image := range []string{"c", "b", "a"} {
+			_, err := i.Lookup(ctx, image, Options{})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 4, delegate.lookups)
+	})
+	t.Run("TagTTL", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		i := newIndex(kubeClient, delegate, 10)
+		for _, image := range []string{"app:v1", "app@" + digest} {
+			_, err := i.Lookup(ctx, image, Options{})
+			require.NoError(t, err)
+		}
+		i.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		_, err := i.Lookup(ctx, "app@"+digest, Options{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, delegate.lookups, "an image referenced by digest does not expire")
+		_, err = i.Lookup(ctx, "app:v1", Options{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, delegate.lookups, "an image referenced by tag expires")
+	})
+	t.Run("Unlabeled", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset(&apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "entrypoint-cache", Namespace: "argo"},
+			Data:       map[string]string{"other": "data"},
+		})
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry}}
+		image, err := newIndex(kubeClient, delegate, 10).Lookup(ctx, "app", Options{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/app"}, image.Entrypoint)
+		assert.Equal(t, map[string]string{"other": "data"}, getConfigMap(t, kubeClient).Data, "another ConfigMap is never overwritten")
+	})
+	t.Run("SizeLimit", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{strings.Repeat("x", 100*1024)}, Source: SourceRegistry}}
+		i := newIndex(kubeClient, delegate, 1000)
+		for j := range 20 {
+			_, err := i.Lookup(ctx, "app:"+string(rune('a'+j)), Options{})
+			require.NoError(t, err)
+		}
+		size := 0
+		for k, v := range getConfigMap(t, kubeClient).Data {
+			size += len(k) + len(v)
+		}
+		assert.LessOrEqual(t, size, maxConfigMapCacheSize)
+	})
+}
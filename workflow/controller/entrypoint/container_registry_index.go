@@ -2,45 +2,367 @@ package entrypoint
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
 
+	ecr "github.com/awslabs/amazon-ecr-credential-helper/ecr-login"
+	"github.com/chrismellard/docker-credential-acr-env/pkg/credhelper"
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	kauth "github.com/google/go-containerregistry/pkg/authn/kubernetes"
 	"github.com/google/go-containerregistry/pkg/name"
 	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/google"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
+// ErrUnsupportedManifestSchema is returned when an image is only available as a Docker schema 1 manifest and
+// Options.AllowSchema1 is not set.
+var ErrUnsupportedManifestSchema = errors.New("unsupported manifest schema: image only has a Docker schema 1 manifest")
+
+// ErrPlatformNotFound is returned when a multi-platform image has no image for the requested platform
+var ErrPlatformNotFound = errors.New("no image for platform")
+
+// ErrPlatformMismatch is returned instead of ErrPlatformNotFound when a multi-platform image has no image for any of
+// several Options.PlatformPreferences
+var ErrPlatformMismatch = errors.New("no image for any preferred platform")
+
 type containerRegistryIndex struct {
 	kubernetesClient kubernetes.Interface
 }
 
 func (i *containerRegistryIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
-	kc, err := k8schain.New(ctx, i.kubernetesClient, k8schain.Options{
+	f, err := i.LookupConfig(ctx, image, options)
+	if err != nil {
+		return nil, err
+	}
+	return newImage(f, SourceRegistry), nil
+}
+
+func (i *containerRegistryIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	kc, err := i.k8sKeychain(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := parseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	return lookupRemoteConfigRefreshing(ctx, ref, options, kc)
+}
+
+// k8sKeychain returns the keychain for the service account and image pull secrets. If it cannot be built, e.g. because
+// the controller may not read the service account, that is an error unless Options.FailClosed is false, in which case
+// registries are accessed anonymously so that public images can still be resolved.
+func (i *containerRegistryIndex) k8sKeychain(ctx context.Context, options Options) (authn.Keychain, error) {
+	kc, err := i.newK8sKeychain(ctx, options)
+	if errors.Is(err, errUnknownCloudKeychain) {
+		return nil, err
+	}
+	if err != nil {
+		if options.failClosed() {
+			return nil, err
+		}
+		log.WithError(err).WithField("namespace", options.Namespace).WithField("serviceAccountName", options.ServiceAccountName).
+			Warn("failed to build the keychain for the image pull secrets, falling back to anonymous access")
+		return authn.NewMultiKeychain(), nil
+	}
+	return kc, nil
+}
+
+// cloudKeychains resolve credentials from a cloud's ambient identity, e.g. GKE or EKS workload identity, keyed by the
+// names Options.CloudKeychains uses
+var cloudKeychains = map[string]authn.Keychain{
+	"google": google.Keychain,
+	"amazon": authn.NewKeychainFromHelper(ecr.NewECRHelper(ecr.WithLogger(io.Discard))),
+	"azure":  authn.NewKeychainFromHelper(credhelper.NewACRCredentialsHelper()),
+}
+
+var errUnknownCloudKeychain = errors.New("unknown cloud keychain")
+
+// newK8sKeychain returns the keychain for the service account and image pull secrets, followed by the Docker config
+// and the cloud keychains in Options.CloudKeychains, or all of them if it is nil
+func (i *containerRegistryIndex) newK8sKeychain(ctx context.Context, options Options) (authn.Keychain, error) {
+	k8sOptions := k8schain.Options{
 		Namespace:          options.Namespace,
 		ServiceAccountName: options.ServiceAccountName,
 		ImagePullSecrets:   imagePullSecretNames(options.ImagePullSecrets),
-	})
+	}
+	if options.CloudKeychains == nil {
+		return k8schain.New(ctx, i.kubernetesClient, k8sOptions)
+	}
+	keychains := []authn.Keychain{nil, authn.DefaultKeychain}
+	for _, name := range options.CloudKeychains {
+		kc, ok := cloudKeychains[name]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", errUnknownCloudKeychain, name)
+		}
+		keychains = append(keychains, kc)
+	}
+	kc, err := kauth.New(ctx, i.kubernetesClient, kauth.Options(k8sOptions))
 	if err != nil {
 		return nil, err
 	}
-	ref, err := name.ParseReference(image)
+	keychains[0] = kc
+	return authn.NewMultiKeychain(keychains...), nil
+}
+
+func (o Options) failClosed() bool {
+	return o.FailClosed == nil || *o.FailClosed
+}
+
+// lookupRemoteConfigRefreshing looks the config up using kc, retrying once with the keychain from
+// Options.RefreshCredentials if the registry returns 401
+func lookupRemoteConfigRefreshing(ctx context.Context, ref name.Reference, options Options, kc authn.Keychain) (*gcrv1.ConfigFile, error) {
+	f, err := lookupRemoteConfig(ctx, ref, options, remote.WithAuthFromKeychain(options.keychain(kc)))
+	if err == nil || options.RefreshCredentials == nil || !isUnauthorized(err) {
+		return f, err
+	}
+	refreshed, refreshErr := options.RefreshCredentials(ctx)
+	if refreshErr != nil {
+		return nil, fmt.Errorf("failed to refresh credentials: %w: %w", refreshErr, err)
+	}
+	return lookupRemoteConfig(ctx, ref, options, remote.WithAuthFromKeychain(options.keychain(refreshed)))
+}
+
+func isUnauthorized(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusUnauthorized
+}
+
+// rateLimitedError wraps err with ErrRateLimited if the registry responded with 429 Too Many Requests
+func rateLimitedError(ref name.Reference, err error) error {
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%s: %w: %w", ref, ErrRateLimited, err)
+	}
+	return err
+}
+
+// IsRateLimited returns true if err is because the registry rate limited the lookup, or it was not made because of an
+// earlier rate limit, so that callers can back off rather than retry immediately
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// keychain returns a keychain that resolves registries from Authenticators first and then falls back to fallback
+func (o Options) keychain(fallback authn.Keychain) authn.Keychain {
+	if len(o.Authenticators) == 0 {
+		return fallback
+	}
+	return authn.NewMultiKeychain(authenticatorKeychain(o.Authenticators), fallback)
+}
+
+type authenticatorKeychain map[string]authn.Authenticator
+
+func (k authenticatorKeychain) Resolve(r authn.Resource) (authn.Authenticator, error) {
+	if a, ok := k[r.RegistryStr()]; ok {
+		return a, nil
+	}
+	return authn.Anonymous, nil
+}
+
+// lookupRemoteConfig fetches the image's manifest, and then its config file. Each phase is limited by its timeout in
+// options, if set. Layers are never fetched, so images with foreign layers, e.g. Windows base images whose layers are
+// only available from their URLs, are resolved like any other.
+func lookupRemoteConfig(ctx context.Context, ref name.Reference, options Options, opts ...remote.Option) (*gcrv1.ConfigFile, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	opts = append(opts, remote.WithContext(ctx), remote.WithPlatform(options.platforms()[0]))
+
+	endManifest := startLookupPhase(ctx, cancel, "manifest", options.ManifestTimeout)
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		return nil, rateLimitedError(ref, endManifest(ref, err))
+	}
+	if desc.MediaType.IsSchema1() {
+		endManifest(ref, nil)
+		if !options.AllowSchema1 {
+			return nil, fmt.Errorf("%s: %w", ref, ErrUnsupportedManifestSchema)
+		}
+		return schema1Config(desc.Manifest)
+	}
+	// for an index, this fetches the platform's manifest
+	var img gcrv1.Image
+	if desc.MediaType.IsIndex() {
+		img, err = platformImage(ref, desc, options.platforms())
+	} else {
+		img, err = desc.Image()
+	}
+	if err = endManifest(ref, err); err != nil {
+		return nil, rateLimitedError(ref, err)
+	}
+	if options.SignaturePolicy != nil {
+		if err := verifySignature(ctx, ref, desc.Digest, options.SignaturePolicy, opts...); err != nil {
+			return nil, rateLimitedError(ref, err)
+		}
+	}
+
+	endConfig := startLookupPhase(ctx, cancel, "config file", options.ConfigTimeout)
+	f, err := img.ConfigFile()
+	if err = endConfig(ref, err); err != nil {
+		return nil, rateLimitedError(ref, err)
+	}
+	if options.EntrypointAnnotation != "" {
+		return annotatedEntrypointConfig(ref, img, f, options.EntrypointAnnotation)
+	}
+	return f, nil
+}
+
+// platformImage returns the image in the index for the first of the platforms it has an image for. The OS and
+// architecture must match, and the variant (e.g. v7 for linux/arm/v7) and os.version if they are set. Windows images
+// only run on hosts with the same build, so unlike other platforms, a Windows os.version such as 10.0.17763 matches any
+// revision of that build, e.g. 10.0.17763.5576.
+func platformImage(ref name.Reference, desc *remote.Descriptor, platforms []gcrv1.Platform) (gcrv1.Image, error) {
+	idx, err := desc.ImageIndex()
 	if err != nil {
 		return nil, err
 	}
-	img, err := remote.Image(ref, remote.WithAuthFromKeychain(kc), remote.WithPlatform(currentPlatform()))
+	m, err := idx.IndexManifest()
 	if err != nil {
 		return nil, err
 	}
-	f, err := img.ConfigFile()
+	available := make([]gcrv1.Platform, len(m.Manifests))
+	for i, child := range m.Manifests {
+		// like go-containerregistry, a manifest without a platform is assumed to be for linux/amd64
+		available[i] = gcrv1.Platform{OS: "linux", Architecture: "amd64"}
+		if child.Platform != nil {
+			available[i] = *child.Platform
+		}
+	}
+	for _, platform := range platforms {
+		for i, child := range m.Manifests {
+			if platformMatches(available[i], platform) {
+				return idx.Image(child.Digest)
+			}
+		}
+	}
+	if len(platforms) == 1 {
+		return nil, fmt.Errorf("%s: %w %s, the image is only available for %s", ref, ErrPlatformNotFound, platforms[0], platformList(available))
+	}
+	return nil, fmt.Errorf("%s: %w %s, the image is only available for %s", ref, ErrPlatformMismatch, platformList(platforms), platformList(available))
+}
+
+func platformList(platforms []gcrv1.Platform) string {
+	names := make([]string, len(platforms))
+	for i, p := range platforms {
+		names[i] = p.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+func platformMatches(given, required gcrv1.Platform) bool {
+	if given.OS != required.OS || (required.Architecture != "" && given.Architecture != required.Architecture) {
+		return false
+	}
+	if required.Variant != "" && given.Variant != required.Variant {
+		return false
+	}
+	if required.OSVersion == "" || given.OSVersion == required.OSVersion {
+		return true
+	}
+	return required.OS == "windows" && strings.HasPrefix(given.OSVersion, required.OSVersion+".")
+}
+
+// annotatedEntrypointConfig returns f with its entrypoint replaced by the JSON array in the manifest's annotation key,
+// if the manifest has it
+func annotatedEntrypointConfig(ref name.Reference, img gcrv1.Image, f *gcrv1.ConfigFile, key string) (*gcrv1.ConfigFile, error) {
+	// the manifest was fetched with the image, so this does not go back to the registry
+	m, err := img.Manifest()
 	if err != nil {
 		return nil, err
 	}
-	return &Image{
-		Entrypoint: f.Config.Entrypoint,
-		Cmd:        f.Config.Cmd,
-	}, nil
+	v, ok := m.Annotations[key]
+	if !ok {
+		return f, nil
+	}
+	var entrypoint []string
+	if err := json.Unmarshal([]byte(v), &entrypoint); err != nil {
+		return nil, fmt.Errorf("%s: invalid entrypoint in annotation %q, expected a JSON array of strings: %w", ref, key, err)
+	}
+	f = f.DeepCopy()
+	f.Config.Entrypoint = entrypoint
+	return f, nil
+}
+
+// lookupTimeoutError is the cause ctx is cancelled with when a phase of a lookup exceeds its timeout
+type lookupTimeoutError struct {
+	phase   string
+	timeout time.Duration
+}
+
+func (e *lookupTimeoutError) Error() string {
+	return fmt.Sprintf("timed out fetching the %s after %s", e.phase, e.timeout)
+}
+
+func (e *lookupTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// startLookupPhase cancels ctx if the phase has not ended within timeout. The returned function ends the phase and, if
+// it failed because of the timeout, adds the phase that timed out to err.
+func startLookupPhase(ctx context.Context, cancel context.CancelCauseFunc, phase string, timeout time.Duration) func(ref name.Reference, err error) error {
+	if timeout <= 0 {
+		return func(_ name.Reference, err error) error { return err }
+	}
+	timer := time.AfterFunc(timeout, func() { cancel(&lookupTimeoutError{phase: phase, timeout: timeout}) })
+	return func(ref name.Reference, err error) error {
+		timer.Stop()
+		var terr *lookupTimeoutError
+		if err != nil && errors.As(context.Cause(ctx), &terr) {
+			return fmt.Errorf("%s: %w: %w", ref, terr, err)
+		}
+		return err
+	}
+}
+
+// schema1Config reads the config from a schema 1 manifest. Schema 1 manifests have no config blob, instead the most
+// recent history entry holds the image's config as a v1 compatibility JSON string. Only the container config is read.
+func schema1Config(manifest []byte) (*gcrv1.ConfigFile, error) {
+	var m struct {
+		History []struct {
+			V1Compatibility string `json:"v1Compatibility"`
+		} `json:"history"`
+	}
+	if err := json.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse schema 1 manifest: %w", err)
+	}
+	if len(m.History) == 0 {
+		return nil, fmt.Errorf("schema 1 manifest has no history")
+	}
+	var c struct {
+		Config gcrv1.Config `json:"config"`
+	}
+	if err := json.Unmarshal([]byte(m.History[0].V1Compatibility), &c); err != nil {
+		return nil, fmt.Errorf("failed to parse schema 1 v1Compatibility: %w", err)
+	}
+	return &gcrv1.ConfigFile{Config: c.Config}, nil
+}
+
+func (o Options) platform() gcrv1.Platform {
+	if o.Platform != nil {
+		return *o.Platform
+	}
+	return currentPlatform()
+}
+
+// platforms returns the platforms to resolve multi-platform images for, in order of preference. It is never empty.
+func (o Options) platforms() []gcrv1.Platform {
+	if len(o.PlatformPreferences) > 0 {
+		return o.PlatformPreferences
+	}
+	return []gcrv1.Platform{o.platform()}
 }
 
 func currentPlatform() gcrv1.Platform {
@@ -48,9 +370,28 @@ func currentPlatform() gcrv1.Platform {
 		OS:           runtime.GOOS,
 		Architecture: runtime.GOARCH,
 	}
+	// 32-bit ARM images differ by variant, which is the GOARM the controller was built for
+	if platform.Architecture == "arm" {
+		platform.Variant = goarmVariant()
+	}
 	return platform
 }
 
+// goarmVariant returns the platform variant of the GOARM the binary was built with, e.g. v7, or empty if it is unknown
+func goarmVariant() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		// the setting may have a float ABI suffix, e.g. 7,softfloat
+		if setting.Key == "GOARM" && setting.Value != "" {
+			return "v" + setting.Value[:1]
+		}
+	}
+	return ""
+}
+
 func imagePullSecretNames(secrets []v1.LocalObjectReference) []string {
 	var v []string
 	for _, s := range secrets {
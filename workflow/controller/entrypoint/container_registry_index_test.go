@@ -0,0 +1,623 @@
+package entrypoint
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+// lookupRemote looks up the image's entrypoint/cmd from its registry with opts, as containerRegistryIndex does
+func lookupRemote(ctx context.Context, ref name.Reference, options Options, opts ...remote.Option) (*Image, error) {
+	f, err := lookupRemoteConfig(ctx, ref, options, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newImage(f, SourceRegistry), nil
+}
+
+// schema1Image reads the entrypoint/cmd from a schema 1 manifest
+func schema1Image(manifest []byte) (*Image, error) {
+	f, err := schema1Config(manifest)
+	if err != nil {
+		return nil, err
+	}
+	return newImage(f, SourceRegistry), nil
+}
+
+const schema1Manifest = `{
+  "schemaVersion": 1,
+  "name": "legacy/app",
+  "tag": "v1",
+  "architecture": "amd64",
+  "fsLayers": [{"blobSum": "sha256:a3ed95caeb02ffe68cdd9fd84406680ae93d633cb16422d00e8a7c22955b46d4"}],
+  "history": [{"v1Compatibility": "{\"id\":\"abc\",\"config\":{\"Entrypoint\":[\"/docker-entrypoint.sh\"],\"Cmd\":[\"serve\"]}}"}]
+}`
+
+func newSchema1Registry(t *testing.T) string {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/":
+			w.WriteHeader(http.StatusOK)
+		case "/v2/legacy/app/manifests/v1":
+			w.Header().Set("Content-Type", "application/vnd.docker.distribution.manifest.v1+json")
+			_, _ = w.Write([]byte(schema1Manifest))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+func TestLookupRemoteSchema1(t *testing.T) {
+	ref, err := name.ParseReference(newSchema1Registry(t)+"/legacy/app:v1", name.Insecure)
+	require.NoError(t, err)
+	t.Run("Default", func(t *testing.T) {
+		_, err := lookupRemote(context.Background(), ref, Options{})
+		require.ErrorIs(t, err, ErrUnsupportedManifestSchema)
+	})
+	t.Run("AllowSchema1", func(t *testing.T) {
+		image, err := lookupRemote(context.Background(), ref, Options{AllowSchema1: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/docker-entrypoint.sh"}, image.Entrypoint)
+		assert.Equal(t, []string{"serve"}, image.Cmd)
+	})
+}
+
+func TestSchema1Image(t *testing.T) {
+	_, err := schema1Image([]byte(`{"schemaVersion": 1}`))
+	require.EqualError(t, err, "schema 1 manifest has no history")
+	_, err = schema1Image([]byte(`{"history": [{"v1Compatibility": "{"}]}`))
+	require.Error(t, err)
+}
+
+type stubAuthenticator struct {
+	calls int
+	authn.AuthConfig
+}
+
+func (a *stubAuthenticator) Authorization() (*authn.AuthConfig, error) {
+	a.calls++
+	return &a.AuthConfig, nil
+}
+
+type stubKeychain struct {
+	authenticator authn.Authenticator
+}
+
+func (k stubKeychain) Resolve(authn.Resource) (authn.Authenticator, error) {
+	return k.authenticator, nil
+}
+
+func TestLookupRemoteAuthenticators(t *testing.T) {
+	handler := registry.New()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "token" || password != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+	ref, err := name.ParseReference(host+"/private/app:v1", name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/app"}})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img, remote.WithAuth(&authn.Basic{Username: "token", Password: "secret"})))
+
+	fallback := stubKeychain{&authn.Basic{Username: "pull-secret", Password: "wrong"}}
+	t.Run("Fallback", func(t *testing.T) {
+		options := Options{Authenticators: map[string]authn.Authenticator{"ghcr.io": &stubAuthenticator{}}}
+		_, err := lookupRemote(context.Background(), ref, options, remote.WithAuthFromKeychain(options.keychain(fallback)))
+		require.Error(t, err)
+	})
+	t.Run("Authenticator", func(t *testing.T) {
+		authenticator := &stubAuthenticator{AuthConfig: authn.AuthConfig{Username: "token", Password: "secret"}}
+		options := Options{Authenticators: map[string]authn.Authenticator{host: authenticator}}
+		image, err := lookupRemote(context.Background(), ref, options, remote.WithAuthFromKeychain(options.keychain(fallback)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/app"}, image.Entrypoint)
+		assert.Positive(t, authenticator.calls)
+	})
+}
+
+func TestLookupRemoteStopSignal(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+	for tag, stopSignal := range map[string]string{"custom": "SIGQUIT", "unset": ""} {
+		ref, err := name.ParseReference(host+"/app:"+tag, name.Insecure)
+		require.NoError(t, err)
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/app"}, StopSignal: stopSignal})
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+
+		image, err := lookupRemote(context.Background(), ref, Options{})
+		require.NoError(t, err)
+		assert.Equal(t, stopSignal, image.StopSignal)
+	}
+}
+
+func TestLookupRemoteConfig(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	ref, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/app:latest", name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err = mutate.Config(img, gcrv1.Config{
+		Entrypoint:   []string{"/app"},
+		Cmd:          []string{"serve"},
+		User:         "1000",
+		ExposedPorts: map[string]struct{}{"8080/tcp": {}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	f, err := lookupRemoteConfig(context.Background(), ref, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "1000", f.Config.User)
+	assert.Contains(t, f.Config.ExposedPorts, "8080/tcp")
+	image, err := lookupRemote(context.Background(), ref, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, f.Config.Entrypoint, image.Entrypoint)
+	assert.Equal(t, f.Config.Cmd, image.Cmd)
+
+	t.Run("Schema1", func(t *testing.T) {
+		ref, err := name.ParseReference(newSchema1Registry(t)+"/legacy/app:v1", name.Insecure)
+		require.NoError(t, err)
+		f, err := lookupRemoteConfig(context.Background(), ref, Options{AllowSchema1: true})
+		require.NoError(t, err)
+		image, err := lookupRemote(context.Background(), ref, Options{AllowSchema1: true})
+		require.NoError(t, err)
+		assert.Equal(t, f.Config.Entrypoint, image.Entrypoint)
+	})
+}
+
+func TestLookupRemoteConfigRefreshing(t *testing.T) {
+	handler := registry.New()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, password, ok := r.BasicAuth(); !ok || password != "fresh" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	ref, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/private/app:v1", name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/app"}})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img, remote.WithAuth(&authn.Basic{Username: "token", Password: "fresh"})))
+
+	ctx := context.Background()
+	expired := stubKeychain{&authn.Basic{Username: "token", Password: "expired"}}
+	refreshing := func(password string, refreshes *int) func(context.Context) (authn.Keychain, error) {
+		return func(context.Context) (authn.Keychain, error) {
+			*refreshes++
+			return stubKeychain{&authn.Basic{Username: "token", Password: password}}, nil
+		}
+	}
+	t.Run("NoRefresh", func(t *testing.T) {
+		_, err := lookupRemoteConfigRefreshing(ctx, ref, Options{}, expired)
+		require.True(t, isUnauthorized(err))
+	})
+	t.Run("Refreshed", func(t *testing.T) {
+		refreshes := 0
+		f, err := lookupRemoteConfigRefreshing(ctx, ref, Options{RefreshCredentials: refreshing("fresh", &refreshes)}, expired)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/app"}, f.Config.Entrypoint)
+		assert.Equal(t, 1, refreshes)
+	})
+	t.Run("StillExpired", func(t *testing.T) {
+		refreshes := 0
+		_, err := lookupRemoteConfigRefreshing(ctx, ref, Options{RefreshCredentials: refreshing("expired", &refreshes)}, expired)
+		require.True(t, isUnauthorized(err))
+		assert.Equal(t, 1, refreshes, "only retried once")
+	})
+	t.Run("RefreshFailed", func(t *testing.T) {
+		refreshErr := errors.New("token service unavailable")
+		options := Options{RefreshCredentials: func(context.Context) (authn.Keychain, error) { return nil, refreshErr }}
+		_, err := lookupRemoteConfigRefreshing(ctx, ref, options, expired)
+		require.ErrorIs(t, err, refreshErr)
+		assert.True(t, isUnauthorized(err))
+	})
+	t.Run("NotUnauthorized", func(t *testing.T) {
+		refreshes := 0
+		missing, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/private/missing:v1", name.Insecure)
+		require.NoError(t, err)
+		_, err = lookupRemoteConfigRefreshing(ctx, missing, Options{RefreshCredentials: refreshing("fresh", &refreshes)}, stubKeychain{&authn.Basic{Username: "token", Password: "fresh"}})
+		require.Error(t, err)
+		assert.Equal(t, 0, refreshes)
+	})
+}
+
+func TestLookupRemoteConfigPhaseTimeouts(t *testing.T) {
+	var slowPath atomic.Value
+	slowPath.Store("")
+	handler := registry.New()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if path := slowPath.Load().(string); path != "" && r.Method == http.MethodGet && strings.Contains(r.URL.Path, path) {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	ref, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/app:latest", name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	ctx := context.Background()
+	options := Options{ManifestTimeout: 100 * time.Millisecond, ConfigTimeout: 100 * time.Millisecond}
+
+	t.Run("Fast", func(t *testing.T) {
+		slowPath.Store("")
+		_, err := lookupRemoteConfig(ctx, ref, options)
+		require.NoError(t, err)
+	})
+	t.Run("SlowManifest", func(t *testing.T) {
+		slowPath.Store("/manifests/")
+		_, err := lookupRemoteConfig(ctx, ref, options)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "timed out fetching the manifest after 100ms")
+	})
+	t.Run("SlowConfig", func(t *testing.T) {
+		slowPath.Store("/blobs/")
+		_, err := lookupRemoteConfig(ctx, ref, options)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "timed out fetching the config file after 100ms")
+	})
+	t.Run("NoTimeout", func(t *testing.T) {
+		slowPath.Store("/blobs/")
+		_, err := lookupRemoteConfig(ctx, ref, Options{})
+		require.NoError(t, err)
+	})
+}
+
+func TestLookupRemoteEntrypointAnnotation(t *testing.T) {
+	const key = "org.example.entrypoint"
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+	write := func(t *testing.T, tag string, annotations map[string]string) name.Reference {
+		t.Helper()
+		ref, err := name.ParseReference(host+"/app:"+tag, name.Insecure)
+		require.NoError(t, err)
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/config-entrypoint"}, Cmd: []string{"serve"}})
+		require.NoError(t, err)
+		img = mutate.Annotations(img, annotations).(gcrv1.Image)
+		require.NoError(t, remote.Write(ref, img))
+		return ref
+	}
+
+	ref := write(t, "annotated", map[string]string{key: `["/annotated-entrypoint", "--flag"]`})
+	image, err := lookupRemote(context.Background(), ref, Options{EntrypointAnnotation: key})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/annotated-entrypoint", "--flag"}, image.Entrypoint)
+	assert.Equal(t, []string{"serve"}, image.Cmd)
+
+	t.Run("Disabled", func(t *testing.T) {
+		image, err := lookupRemote(context.Background(), ref, Options{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/config-entrypoint"}, image.Entrypoint)
+	})
+	t.Run("NotAnnotated", func(t *testing.T) {
+		ref := write(t, "plain", map[string]string{"other": "value"})
+		image, err := lookupRemote(context.Background(), ref, Options{EntrypointAnnotation: key})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/config-entrypoint"}, image.Entrypoint)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		ref := write(t, "invalid", map[string]string{key: "/not-json"})
+		_, err := lookupRemote(context.Background(), ref, Options{EntrypointAnnotation: key})
+		require.ErrorContains(t, err, `invalid entrypoint in annotation "org.example.entrypoint"`)
+	})
+}
+
+func TestFailClosed(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	// a loopback registry is accessed over HTTP
+	image := strings.TrimPrefix(s.URL, "http://") + "/public/app:latest"
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/app"}})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	kubernetesClient := fake.NewSimpleClientset()
+	kubernetesClient.PrependReactor("get", "serviceaccounts", func(ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierr.NewForbidden(schema.GroupResource{Resource: "serviceaccounts"}, "default", nil)
+	})
+	i := &containerRegistryIndex{kubernetesClient}
+	options := Options{Namespace: "argo", ServiceAccountName: "default"}
+
+	t.Run("Default", func(t *testing.T) {
+		_, err := i.Lookup(context.Background(), image, options)
+		require.True(t, apierr.IsForbidden(err), err)
+		require.Error(t, i.Ping(context.Background(), image, options))
+	})
+	t.Run("FailClosed", func(t *testing.T) {
+		options := options
+		options.FailClosed = ptr.To(true)
+		_, err := i.Lookup(context.Background(), image, options)
+		require.True(t, apierr.IsForbidden(err), err)
+	})
+	t.Run("FailOpen", func(t *testing.T) {
+		options := options
+		options.FailClosed = ptr.To(false)
+		v, err := i.Lookup(context.Background(), image, options)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/app"}, v.Entrypoint)
+		require.NoError(t, i.Ping(context.Background(), image, options))
+	})
+}
+
+func TestLookupRemoteScratch(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+	for tag, cmd := range map[string][]string{"nil-cmd": nil, "empty-cmd": {}} {
+		ref, err := name.ParseReference(host+"/scratch:"+tag, name.Insecure)
+		require.NoError(t, err)
+		// a FROM scratch image with a single binary and only an ENTRYPOINT
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/app"}, Cmd: cmd})
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+
+		image, err := lookupRemote(context.Background(), ref, Options{})
+		require.NoError(t, err)
+		require.NotNil(t, image)
+		assert.Equal(t, []string{"/app"}, image.Entrypoint, tag)
+		assert.Nil(t, image.Cmd, tag)
+	}
+}
+
+func TestLookupRemoteWindows(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	ref, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/windows:latest", name.Insecure)
+	require.NoError(t, err)
+	// a Windows image is built for each Windows Server version it supports, alongside a Linux image
+	var index gcrv1.ImageIndex = empty.Index
+	for _, p := range []*gcrv1.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "windows", Architecture: "amd64", OSVersion: "10.0.17763.5576"},
+		{OS: "windows", Architecture: "amd64", OSVersion: "10.0.20348.2340"},
+	} {
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.ConfigFile(img, &gcrv1.ConfigFile{OS: p.OS, Architecture: p.Architecture, OSVersion: p.OSVersion, Config: gcrv1.Config{Entrypoint: []string{p.OS + ":" + p.OSVersion}}})
+		require.NoError(t, err)
+		index = mutate.AppendManifests(index, mutate.IndexAddendum{Add: img, Descriptor: gcrv1.Descriptor{Platform: p}})
+	}
+	require.NoError(t, remote.WriteIndex(ref, index))
+
+	ctx := context.Background()
+	for osVersion, entrypoint := range map[string]string{
+		"":                "windows:10.0.17763.5576",
+		"10.0.17763":      "windows:10.0.17763.5576",
+		"10.0.20348":      "windows:10.0.20348.2340",
+		"10.0.20348.2340": "windows:10.0.20348.2340",
+	} {
+		image, err := lookupRemote(ctx, ref, Options{Platform: &gcrv1.Platform{OS: "windows", Architecture: "amd64", OSVersion: osVersion}})
+		require.NoError(t, err, osVersion)
+		assert.Equal(t, []string{entrypoint}, image.Entrypoint, osVersion)
+	}
+
+	_, err = lookupRemote(ctx, ref, Options{Platform: &gcrv1.Platform{OS: "windows", Architecture: "amd64", OSVersion: "10.0.26100"}})
+	require.ErrorIs(t, err, ErrPlatformNotFound)
+	assert.Contains(t, err.Error(), "no image for platform windows/amd64:10.0.26100, the image is only available for linux/amd64, windows/amd64:10.0.17763.5576, windows/amd64:10.0.20348.2340")
+
+	_, err = lookupRemote(ctx, ref, Options{Platform: &gcrv1.Platform{OS: "windows", Architecture: "arm64"}})
+	require.ErrorIs(t, err, ErrPlatformNotFound)
+}
+
+func TestLookupRemoteVariant(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	ref, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/arm:latest", name.Insecure)
+	require.NoError(t, err)
+	var index gcrv1.ImageIndex = empty.Index
+	for _, p := range []*gcrv1.Platform{
+		{OS: "linux", Architecture: "arm", Variant: "v6"},
+		{OS: "linux", Architecture: "arm", Variant: "v7"},
+		{OS: "linux", Architecture: "arm64", Variant: "v8"},
+	} {
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/bin/" + p.Architecture + p.Variant}})
+		require.NoError(t, err)
+		index = mutate.AppendManifests(index, mutate.IndexAddendum{Add: img, Descriptor: gcrv1.Descriptor{Platform: p}})
+	}
+	require.NoError(t, remote.WriteIndex(ref, index))
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		platform   gcrv1.Platform
+		entrypoint string
+	}{
+		{gcrv1.Platform{OS: "linux", Architecture: "arm", Variant: "v6"}, "/bin/armv6"},
+		{gcrv1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, "/bin/armv7"},
+		{gcrv1.Platform{OS: "linux", Architecture: "arm"}, "/bin/armv6"},
+		{gcrv1.Platform{OS: "linux", Architecture: "arm64"}, "/bin/arm64v8"},
+	} {
+		image, err := lookupRemote(ctx, ref, Options{Platform: &tt.platform})
+		require.NoError(t, err, tt.platform)
+		assert.Equal(t, []string{tt.entrypoint}, image.Entrypoint, tt.platform)
+	}
+
+	_, err = lookupRemote(ctx, ref, Options{Platform: &gcrv1.Platform{OS: "linux", Architecture: "arm", Variant: "v5"}})
+	require.ErrorIs(t, err, ErrPlatformNotFound)
+	assert.Contains(t, err.Error(), "no image for platform linux/arm/v5, the image is only available for linux/arm/v6, linux/arm/v7, linux/arm64/v8")
+}
+
+func TestLookupRemotePlatformPreferences(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	ref, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/app:latest", name.Insecure)
+	require.NoError(t, err)
+	// there is no arm64 image
+	var index gcrv1.ImageIndex = empty.Index
+	for _, p := range []*gcrv1.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "s390x"},
+	} {
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/bin/" + p.Architecture}})
+		require.NoError(t, err)
+		index = mutate.AppendManifests(index, mutate.IndexAddendum{Add: img, Descriptor: gcrv1.Descriptor{Platform: p}})
+	}
+	require.NoError(t, remote.WriteIndex(ref, index))
+
+	ctx := context.Background()
+	arm64 := gcrv1.Platform{OS: "linux", Architecture: "arm64"}
+	amd64 := gcrv1.Platform{OS: "linux", Architecture: "amd64"}
+	s390x := gcrv1.Platform{OS: "linux", Architecture: "s390x"}
+	image, err := lookupRemote(ctx, ref, Options{PlatformPreferences: []gcrv1.Platform{arm64, amd64, s390x}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/amd64"}, image.Entrypoint, "the first available preference is used")
+	image, err = lookupRemote(ctx, ref, Options{PlatformPreferences: []gcrv1.Platform{s390x, amd64}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/s390x"}, image.Entrypoint)
+	// preferences take precedence over the platform
+	image, err = lookupRemote(ctx, ref, Options{Platform: &s390x, PlatformPreferences: []gcrv1.Platform{amd64}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/amd64"}, image.Entrypoint)
+
+	riscv64 := gcrv1.Platform{OS: "linux", Architecture: "riscv64"}
+	_, err = lookupRemote(ctx, ref, Options{PlatformPreferences: []gcrv1.Platform{arm64, riscv64}})
+	require.ErrorIs(t, err, ErrPlatformMismatch)
+	assert.Contains(t, err.Error(), "no image for any preferred platform linux/arm64, linux/riscv64, the image is only available for linux/amd64, linux/s390x")
+	_, err = lookupRemote(ctx, ref, Options{PlatformPreferences: []gcrv1.Platform{arm64}})
+	require.ErrorIs(t, err, ErrPlatformNotFound)
+}
+
+func TestLookupRemoteForeignLayers(t *testing.T) {
+	config := []byte(`{"architecture":"amd64","os":"windows","os.version":"10.0.17763.5576","config":{"Cmd":["c:\\windows\\system32\\cmd.exe"]},"rootfs":{"type":"layers","diff_ids":["sha256:1111111111111111111111111111111111111111111111111111111111111111"]}}`)
+	configDigest, _, err := gcrv1.SHA256(bytes.NewReader(config))
+	require.NoError(t, err)
+	var foreignLayerRequests atomic.Int32
+	var s *httptest.Server
+	s = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/":
+			w.WriteHeader(http.StatusOK)
+		case "/v2/windows/nanoserver/manifests/ltsc2019":
+			// like Windows base images, the base layer is not in the registry, it is only available from its URLs
+			w.Header().Set("Content-Type", string(types.DockerManifestSchema2))
+			_, _ = fmt.Fprintf(w, `{
+  "schemaVersion": 2,
+  "mediaType": %q,
+  "config": {"mediaType": %q, "size": %d, "digest": %q},
+  "layers": [{
+    "mediaType": %q,
+    "size": 102661372,
+    "digest": "sha256:2222222222222222222222222222222222222222222222222222222222222222",
+    "urls": [%q]
+  }]
+}`, types.DockerManifestSchema2, types.DockerConfigJSON, len(config), configDigest, types.DockerForeignLayer, s.URL+"/foreign")
+		case "/v2/windows/nanoserver/blobs/" + configDigest.String():
+			_, _ = w.Write(config)
+		case "/foreign":
+			foreignLayerRequests.Add(1)
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+	ref, err := name.ParseReference(strings.TrimPrefix(s.URL, "http://")+"/windows/nanoserver:ltsc2019", name.Insecure)
+	require.NoError(t, err)
+
+	image, err := lookupRemote(context.Background(), ref, Options{Platform: &gcrv1.Platform{OS: "windows", Architecture: "amd64"}})
+	require.NoError(t, err)
+	assert.Nil(t, image.Entrypoint)
+	assert.Equal(t, []string{`c:\windows\system32\cmd.exe`}, image.Cmd)
+	assert.Zero(t, foreignLayerRequests.Load(), "only the config is needed, so the foreign layer is not fetched")
+}
+
+func TestCloudKeychains(t *testing.T) {
+	handler := registry.New()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "workload-identity" || password != "token" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	// a loopback registry is accessed over HTTP
+	image := strings.TrimPrefix(s.URL, "http://") + "/private/app:v1"
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/app"}})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img, remote.WithAuth(&authn.Basic{Username: "workload-identity", Password: "token"})))
+
+	cloudKeychains["stub"] = stubKeychain{&authn.Basic{Username: "workload-identity", Password: "token"}}
+	defer delete(cloudKeychains, "stub")
+	i := &containerRegistryIndex{fake.NewSimpleClientset()}
+	ctx := context.Background()
+
+	v, err := i.Lookup(ctx, image, Options{CloudKeychains: []string{"google", "stub"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/app"}, v.Entrypoint)
+
+	_, err = i.Lookup(ctx, image, Options{CloudKeychains: []string{}})
+	require.Error(t, err, "no cloud keychains")
+	assert.True(t, isUnauthorized(err))
+
+	_, err = i.Lookup(ctx, image, Options{CloudKeychains: []string{"ibm"}, FailClosed: ptr.To(false)})
+	require.EqualError(t, err, `unknown cloud keychain "ibm"`)
+}
@@ -2,35 +2,200 @@ package entrypoint
 
 import (
 	"context"
+	"slices"
+	"time"
 
+	"github.com/google/go-containerregistry/pkg/authn"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/lru"
 
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 type Interface interface {
 	Lookup(ctx context.Context, image string, options Options) (*Image, error)
+	// LookupConfig returns the image's full config, e.g. for its exposed ports, volumes or user, rather than only its
+	// entrypoint/cmd. It is not cached, and Options.EntrypointOverrides do not apply, but Options.EntrypointAnnotation
+	// does.
+	LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error)
+	// Ping checks that the image's manifest can be resolved from its registry without fetching its config. It returns
+	// ErrUnauthorized, ErrNotFound, ErrRateLimited or ErrUnavailable, wrapping the underlying error, if it cannot.
+	Ping(ctx context.Context, image string, options Options) error
+	// Warm looks up the images ahead of time, e.g. when a workflow is submitted, so that later lookups hit the cache
+	// rather than the registry. It is best-effort: failed lookups are logged and only a done context is returned.
+	Warm(ctx context.Context, images []string, options Options) error
 }
 
 type Options struct {
 	Namespace          string
 	ServiceAccountName string
 	ImagePullSecrets   []apiv1.LocalObjectReference
+	// AllowSchema1 allows images that are only available as legacy Docker schema 1 manifests. The entrypoint/cmd is
+	// read from the manifest's v1 compatibility history. When false, such images return ErrUnsupportedManifestSchema.
+	AllowSchema1 bool
+	// Platform is the platform to resolve multi-platform images for. It defaults to the controller's platform.
+	Platform *gcrv1.Platform
+	// PlatformPreferences, if set, are the platforms to resolve multi-platform images for in order of preference,
+	// instead of Platform, e.g. arm64 and then amd64 for a node that can run amd64 images under emulation. The first
+	// that the image is available for is used. If it is available for none of them, ErrPlatformMismatch is returned, or
+	// ErrPlatformNotFound if there is only one.
+	PlatformPreferences []gcrv1.Platform
+	// Authenticators are used for registries, keyed by registry host (e.g. "ghcr.io"), that need credentials the image
+	// pull secrets cannot provide. They take precedence over the service account's and image pull secrets' credentials.
+	Authenticators map[string]authn.Authenticator
+	// EntrypointOverrides are returned by Lookup, without looking in the cache, config or registry, for images whose
+	// reference matches the key. Keys match any equivalent reference, e.g. "nginx" matches "docker.io/library/nginx".
+	EntrypointOverrides map[string]Image
+	// LocalImages, if set, is asked for the image's config before the registry, e.g. for a controller running on the
+	// node, so that images already pulled are not fetched again.
+	LocalImages LocalImageService
+	// RefreshCredentials, if set, is called when the registry rejects the credentials with a 401, e.g. because a
+	// short-lived token expired, and the lookup is retried once with the keychain it returns.
+	RefreshCredentials func(ctx context.Context) (authn.Keychain, error)
+	// ManifestTimeout limits how long fetching the image's manifest from the registry may take, and ConfigTimeout how
+	// long fetching its config file may then take, e.g. from a slow blob store. They are not limited if zero.
+	ManifestTimeout time.Duration
+	ConfigTimeout   time.Duration
+	// EntrypointAnnotation, if set, is the key of an annotation on the image's manifest that holds its entrypoint as a
+	// JSON array, e.g. `["/bin/app"]`, for images built by tools that record it there. If the manifest has the
+	// annotation, it overrides the entrypoint in the image's config. It does not apply to schema 1 manifests.
+	EntrypointAnnotation string
+	// FailClosed, if nil or true, makes a failure to build the keychain for the service account and image pull
+	// secrets an error. If false, the registry is accessed anonymously instead and a warning is logged, so that public
+	// images can be resolved even if, e.g., the service account cannot be read.
+	FailClosed *bool
+	// CloudKeychains are the clouds whose ambient credentials, e.g. from GKE or EKS workload identity, are used for
+	// registries the service account and image pull secrets have no credentials for: "google", "amazon" or "azure".
+	// All of them are used if it is nil, and none if it is empty.
+	CloudKeychains []string
+	// SignaturePolicy, if set, requires the image to be signed before its entrypoint/cmd is looked up in the registry,
+	// and Lookup returns ErrSignatureVerificationFailed if it is not. The signature is verified for the digest the
+	// image's reference resolves to. Such lookups are neither cached nor answered from LocalImages, but
+	// EntrypointOverrides and the controller's configured images are trusted as they are.
+	SignaturePolicy *SignaturePolicy
+	// Resolver, if set, looks images up instead of the registry, e.g. a sidecar that holds the registry credentials so
+	// the controller does not need them. The controller's configured images, EntrypointOverrides and LocalImages still
+	// take precedence. It is not used if SignaturePolicy is set.
+	Resolver *SocketResolver
+	// RetryBudget, if set, is shared by the lookups of LookupAll, and of any other LookupAll given the same budget,
+	// which retry lookups that fail because the registry is unavailable until it is spent
+	RetryBudget *RetryBudget
 }
 
+// Image is the entrypoint/cmd of an image. Entrypoint and Cmd are nil, never empty, if the image does not set them, so
+// that callers can check for nil, e.g. a scratch image with only an ENTRYPOINT has its Entrypoint and a nil Cmd.
+// Likewise, an image with only a CMD has a nil Entrypoint, and its Cmd is the whole command, which is returned as the
+// image stores it: a shell-form `CMD echo hi` is ["/bin/sh", "-c", "echo hi"], and a single element is kept as one.
 type Image struct {
 	Entrypoint []string
 	Cmd        []string
+	// StopSignal is the signal the image expects to be sent to stop it, e.g. "SIGQUIT". It is empty if not set.
+	StopSignal string
+	// Source is where Lookup resolved the entrypoint/cmd from, for auditing
+	Source Source
+	// ResolvedAt is when the entrypoint/cmd was resolved from its source, as recorded by the cache. For a SourceCache
+	// image it is when the cached entry was resolved, so callers can tell how stale it may be, e.g. to distrust an old
+	// entry. It is zero if not known, e.g. for SourceOverride.
+	ResolvedAt time.Time
+}
+
+// Age returns how long before now the entrypoint/cmd was resolved, or false if ResolvedAt is not known
+func (i *Image) Age(now time.Time) (time.Duration, bool) {
+	if i.ResolvedAt.IsZero() {
+		return 0, false
+	}
+	return now.Sub(i.ResolvedAt), true
+}
+
+// Source is where the entrypoint/cmd of an Image was resolved from
+type Source string
+
+const (
+	// SourceOverride is an entry in Options.EntrypointOverrides
+	SourceOverride Source = "Override"
+	// SourceCache is a previous lookup, from whichever source it was resolved
+	SourceCache Source = "Cache"
+	// SourceConfig is the images configured for the controller
+	SourceConfig Source = "Config"
+	// SourceLocal is Options.LocalImages, i.e. an image already present on the node
+	SourceLocal Source = "Local"
+	// SourceRegistry is the image's registry
+	SourceRegistry Source = "Registry"
+	// SourceResolver is Options.Resolver
+	SourceResolver Source = "Resolver"
+)
+
+func newImage(f *gcrv1.ConfigFile, source Source) *Image {
+	return &Image{
+		Entrypoint: nilIfEmpty(f.Config.Entrypoint),
+		Cmd:        nilIfEmpty(f.Config.Cmd),
+		StopSignal: f.Config.StopSignal,
+		Source:     source,
+	}
+}
+
+// Equal returns true if i and other have the same entrypoint, cmd and stop signal. Source and ResolvedAt are excluded,
+// since they describe where and when the image was resolved rather than how it runs, so an image resolved from the
+// cache equals the same image resolved from its registry. Nil and empty slices are equal, and two nil images are equal.
+func (i *Image) Equal(other *Image) bool {
+	if i == nil || other == nil {
+		return i == other
+	}
+	return slices.Equal(i.Entrypoint, other.Entrypoint) && slices.Equal(i.Cmd, other.Cmd) && i.StopSignal == other.StopSignal
+}
+
+// normalized returns a copy of i with empty slices replaced by nil
+func (i Image) normalized() *Image {
+	i.Entrypoint = nilIfEmpty(i.Entrypoint)
+	i.Cmd = nilIfEmpty(i.Cmd)
+	return &i
+}
+
+func nilIfEmpty(v []string) []string {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// NeedsLookup returns true if the image's entrypoint/cmd must be looked up to run the container. As with Kubernetes, an
+// explicit command replaces both the image's entrypoint and cmd, so no lookup is needed. Args on their own only replace
+// the image's cmd, so the image's entrypoint must still be looked up.
+// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
+func NeedsLookup(c apiv1.Container) bool {
+	return len(c.Command) == 0
+}
+
+// ResolutionDisabled returns true if the object opts out of entrypoint/cmd resolution with the
+// workflows.argoproj.io/entrypoint-resolution: disabled annotation, e.g. for a template whose image's entrypoint is
+// irrelevant because it runs its args as the command. Lookup must not be called for its containers, even those that
+// NeedsLookup.
+func ResolutionDisabled(meta metav1.ObjectMeta) bool {
+	return meta.Annotations[common.AnnotationKeyEntrypointResolution] == "disabled"
 }
 
 func New(kubernetesClient kubernetes.Interface, config map[string]config.Image) Interface {
+	return newIndex(config, &containerRegistryIndex{kubernetesClient})
+}
+
+// NewWithConfigMapCache returns an index like New, but that persists the images it resolves from their registry in the
+// ConfigMap namespace/name, see WithConfigMapCache.
+func NewWithConfigMapCache(kubernetesClient kubernetes.Interface, config map[string]config.Image, namespace, name string, maxEntries int, tagTTL time.Duration) Interface {
+	return newIndex(config, WithConfigMapCache(&containerRegistryIndex{kubernetesClient}, kubernetesClient, namespace, name, maxEntries, tagTTL))
+}
+
+func newIndex(config map[string]config.Image, registry Interface) Interface {
 	return &cacheIndex{
 		lru.New(1024),
 		chainIndex{
 			configIndex(config),
-			&containerRegistryIndex{kubernetesClient},
+			localIndex{},
+			resolverIndex{},
+			registry,
 		},
 	}
 }
@@ -0,0 +1,139 @@
+package entrypoint
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestNeedsLookup(t *testing.T) {
+	assert.True(t, NeedsLookup(apiv1.Container{}))
+	assert.True(t, NeedsLookup(apiv1.Container{Args: []string{"foo"}}))
+	assert.False(t, NeedsLookup(apiv1.Container{Command: []string{"sh"}}))
+	assert.False(t, NeedsLookup(apiv1.Container{Command: []string{"sh"}, Args: []string{"-c", "echo"}}))
+}
+
+func TestResolutionDisabled(t *testing.T) {
+	assert.False(t, ResolutionDisabled(metav1.ObjectMeta{}))
+	assert.False(t, ResolutionDisabled(metav1.ObjectMeta{Annotations: map[string]string{common.AnnotationKeyEntrypointResolution: "enabled"}}))
+	assert.True(t, ResolutionDisabled(metav1.ObjectMeta{Annotations: map[string]string{common.AnnotationKeyEntrypointResolution: "disabled"}}))
+}
+
+func TestNewImage(t *testing.T) {
+	image := newImage(&gcrv1.ConfigFile{Config: gcrv1.Config{Entrypoint: []string{}, Cmd: []string{}}}, SourceRegistry)
+	assert.Nil(t, image.Entrypoint)
+	assert.Nil(t, image.Cmd)
+
+	image = newImage(&gcrv1.ConfigFile{Config: gcrv1.Config{Entrypoint: []string{"/app"}}}, SourceRegistry)
+	assert.Equal(t, []string{"/app"}, image.Entrypoint)
+	assert.Nil(t, image.Cmd)
+
+	// an image with only a shell-form CMD has no entrypoint, and its cmd is kept as is
+	image = newImage(&gcrv1.ConfigFile{Config: gcrv1.Config{Entrypoint: []string{}, Cmd: []string{"/bin/sh", "-c", "echo hi && sleep 1"}}}, SourceRegistry)
+	assert.Nil(t, image.Entrypoint)
+	assert.Equal(t, []string{"/bin/sh", "-c", "echo hi && sleep 1"}, image.Cmd)
+	image = newImage(&gcrv1.ConfigFile{Config: gcrv1.Config{Cmd: []string{"echo hi && sleep 1"}}}, SourceRegistry)
+	assert.Nil(t, image.Entrypoint)
+	assert.Equal(t, []string{"echo hi && sleep 1"}, image.Cmd)
+
+	override, ok := Options{EntrypointOverrides: map[string]Image{"app": {Entrypoint: []string{"/app"}, Cmd: []string{}}}}.entrypointOverride("app")
+	assert.True(t, ok)
+	assert.Nil(t, override.Cmd)
+}
+
+func TestConfigIndexCmdOnly(t *testing.T) {
+	ctx := context.Background()
+	index := configIndex{"app": {Entrypoint: []string{}, Cmd: []string{"/bin/sh", "-c", "serve"}}}
+
+	image, err := index.Lookup(ctx, "app", Options{})
+	require.NoError(t, err)
+	assert.Nil(t, image.Entrypoint)
+	assert.Equal(t, []string{"/bin/sh", "-c", "serve"}, image.Cmd)
+
+	// the config has the same entrypoint/cmd as Lookup returns
+	f, err := index.LookupConfig(ctx, "app", Options{})
+	require.NoError(t, err)
+	assert.Nil(t, f.Config.Entrypoint)
+	assert.Equal(t, image.Cmd, f.Config.Cmd)
+}
+
+func TestImageEqual(t *testing.T) {
+	image := &Image{Entrypoint: []string{"/app"}, Cmd: []string{"serve"}, StopSignal: "SIGQUIT"}
+	assert.True(t, image.Equal(&Image{Entrypoint: []string{"/app"}, Cmd: []string{"serve"}, StopSignal: "SIGQUIT"}))
+	assert.False(t, image.Equal(&Image{Entrypoint: []string{"/app"}, Cmd: []string{"serve"}}))
+	assert.False(t, image.Equal(&Image{Entrypoint: []string{"/app"}, StopSignal: "SIGQUIT"}))
+	assert.False(t, image.Equal(&Image{Entrypoint: []string{"/other"}, Cmd: []string{"serve"}, StopSignal: "SIGQUIT"}))
+	assert.False(t, image.Equal(nil))
+
+	// nil and empty slices are equal
+	assert.True(t, (&Image{Entrypoint: []string{"/app"}}).Equal(&Image{Entrypoint: []string{"/app"}, Cmd: []string{}}))
+	assert.True(t, (&Image{}).Equal(&Image{Entrypoint: []string{}, Cmd: []string{}}))
+
+	// where and when the image was resolved are excluded
+	resolvedAt := time.Date(2024, time.June, 1, 9, 0, 0, 0, time.UTC)
+	fromRegistry := &Image{Entrypoint: []string{"/app"}, Source: SourceRegistry, ResolvedAt: resolvedAt}
+	assert.True(t, fromRegistry.Equal(&Image{Entrypoint: []string{"/app"}, Source: SourceCache, ResolvedAt: resolvedAt}))
+	assert.True(t, fromRegistry.Equal(&Image{Entrypoint: []string{"/app"}, Source: SourceRegistry, ResolvedAt: resolvedAt.Add(time.Hour)}))
+	assert.True(t, fromRegistry.Equal(&Image{Entrypoint: []string{"/app"}}))
+
+	var none *Image
+	assert.True(t, none.Equal(nil))
+	assert.False(t, none.Equal(&Image{}))
+}
+
+func TestLookupSource(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+	for _, image := range []string{"remote", "local"} {
+		ref, err := name.ParseReference(host + "/" + image + ":latest")
+		require.NoError(t, err)
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"/" + image}})
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+	}
+	remoteImage := host + "/remote:latest"
+	localImage := host + "/local:latest"
+
+	i := New(fake.NewSimpleClientset(), map[string]config.Image{"configured": {Entrypoint: []string{"/configured"}}})
+	options := Options{
+		LocalImages:         &fakeLocalImageService{images: map[string]gcrv1.Config{localImage: {Entrypoint: []string{"/local"}}}},
+		EntrypointOverrides: map[string]Image{"overridden": {Entrypoint: []string{"/overridden"}}},
+	}
+	ctx := context.Background()
+	for _, tt := range []struct {
+		image  string
+		source Source
+	}{
+		{"overridden", SourceOverride},
+		{"configured", SourceConfig},
+		{localImage, SourceLocal},
+		{remoteImage, SourceRegistry},
+		{remoteImage, SourceCache},
+		{"configured", SourceCache},
+		{"overridden", SourceOverride},
+	} {
+		v, err := i.Lookup(ctx, tt.image, options)
+		require.NoError(t, err)
+		assert.Equal(t, tt.source, v.Source, tt.image)
+	}
+}
@@ -0,0 +1,52 @@
+package entrypoint
+
+import (
+	"context"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	log "github.com/sirupsen/logrus"
+)
+
+// LocalImageService reads the config of images already present on the node, e.g. from the CRI image service, so
+// they need not be fetched from the registry.
+type LocalImageService interface {
+	// ImageConfig returns the config of the image, or nil if the image is not present.
+	ImageConfig(ctx context.Context, image string) (*gcrv1.ConfigFile, error)
+}
+
+// localIndex looks images up in Options.LocalImages. It returns nil, so the chain falls back to the registry, when
+// no service is set, a SignaturePolicy is, the image is not present, or the service fails.
+type localIndex struct{}
+
+func (i localIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	f, err := i.LookupConfig(ctx, image, options)
+	if f == nil || err != nil {
+		return nil, err
+	}
+	return newImage(f, SourceLocal), nil
+}
+
+func (i localIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	// the signatures of local images cannot be verified
+	if options.LocalImages == nil || options.SignaturePolicy != nil {
+		return nil, nil
+	}
+	f, err := options.LocalImages.ImageConfig(ctx, image)
+	if err != nil {
+		log.WithError(err).WithField("image", image).Warn("failed to look up local image, falling back to the registry")
+		return nil, nil
+	}
+	return f, nil
+}
+
+// Ping has nothing to check, since an image being present on the node says nothing about its registry
+func (i localIndex) Ping(ctx context.Context, image string, options Options) error {
+	return nil
+}
+
+// Warm has nothing to do, since local images are not cached
+func (i localIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
+var _ Interface = localIndex{}
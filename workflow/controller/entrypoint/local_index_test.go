@@ -0,0 +1,105 @@
+package entrypoint
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLocalImageService struct {
+	images  map[string]gcrv1.Config
+	err     error
+	lookups int
+}
+
+func (s *fakeLocalImageService) ImageConfig(ctx context.Context, image string) (*gcrv1.ConfigFile, error) {
+	s.lookups++
+	if s.err != nil {
+		return nil, s.err
+	}
+	c, ok := s.images[image]
+	if !ok {
+		return nil, nil
+	}
+	return &gcrv1.ConfigFile{Config: c}, nil
+}
+
+// remoteOnlyIndex is the registry half of the chain, using the given registry without any credentials
+type remoteOnlyIndex struct{}
+
+func (remoteOnlyIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	return lookupRemote(ctx, ref, options)
+}
+
+func (remoteOnlyIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	return lookupRemoteConfig(ctx, ref, options)
+}
+
+func (remoteOnlyIndex) Ping(ctx context.Context, image string, options Options) error {
+	return nil
+}
+
+func (remoteOnlyIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
+func TestLocalIndex(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	remoteImage := strings.TrimPrefix(s.URL, "http://") + "/test/remote:latest"
+	ref, err := name.ParseReference(remoteImage)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{"remote"}})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	local := &fakeLocalImageService{images: map[string]gcrv1.Config{
+		remoteImage: {Entrypoint: []string{"local"}, Cmd: []string{"cmd"}, StopSignal: "SIGQUIT"},
+	}}
+	i := chainIndex{localIndex{}, remoteOnlyIndex{}}
+	ctx := context.Background()
+
+	t.Run("Present", func(t *testing.T) {
+		v, err := i.Lookup(ctx, remoteImage, Options{LocalImages: local})
+		require.NoError(t, err)
+		assert.Equal(t, &Image{Entrypoint: []string{"local"}, Cmd: []string{"cmd"}, StopSignal: "SIGQUIT", Source: SourceLocal}, v)
+	})
+	t.Run("NotPresent", func(t *testing.T) {
+		v, err := i.Lookup(ctx, remoteImage, Options{LocalImages: &fakeLocalImageService{}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"remote"}, v.Entrypoint)
+	})
+	t.Run("Error", func(t *testing.T) {
+		failing := &fakeLocalImageService{err: errors.New("connection refused")}
+		v, err := i.Lookup(ctx, remoteImage, Options{LocalImages: failing})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"remote"}, v.Entrypoint)
+		assert.Equal(t, 1, failing.lookups)
+	})
+	t.Run("NotSet", func(t *testing.T) {
+		v, err := i.Lookup(ctx, remoteImage, Options{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"remote"}, v.Entrypoint)
+	})
+}
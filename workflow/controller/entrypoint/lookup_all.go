@@ -0,0 +1,128 @@
+package entrypoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// ErrRetryBudgetExhausted is returned, wrapping the lookup's error, when a lookup fails and could be retried but its
+// RetryBudget has no retries or time left
+var ErrRetryBudgetExhausted = errors.New("retry budget exhausted")
+
+// RetryBudget bounds the retries of all the lookups that share it, e.g. all of a workflow's, so that a flaky registry
+// costs at most maxRetries retries and maxDuration in total, rather than that much for each image. It is safe for
+// concurrent use, and is shared by setting it in the Options of each lookup.
+type RetryBudget struct {
+	lock     sync.Mutex
+	retries  int
+	deadline time.Time
+	delay    time.Duration
+}
+
+// NewRetryBudget returns a budget of maxRetries retries that must start within maxDuration from now, or without a time
+// limit if it is zero. A lookup waits delay before its first retry, doubling before each retry after that, but never
+// beyond the budget's deadline.
+func NewRetryBudget(maxRetries int, maxDuration, delay time.Duration) *RetryBudget {
+	b := &RetryBudget{retries: maxRetries, delay: delay}
+	if maxDuration > 0 {
+		b.deadline = time.Now().Add(maxDuration)
+	}
+	return b
+}
+
+// Remaining returns the number of retries left
+func (b *RetryBudget) Remaining() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.retries
+}
+
+// take takes a retry from the budget for the retry'th retry of a lookup, returning how long to wait before it, or
+// false if the budget is exhausted
+func (b *RetryBudget) take(retry int) (time.Duration, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	now := time.Now()
+	if b.retries <= 0 || (!b.deadline.IsZero() && !now.Before(b.deadline)) {
+		return 0, false
+	}
+	b.retries--
+	delay := b.delay << min(retry-1, 16)
+	if !b.deadline.IsZero() {
+		delay = min(delay, b.deadline.Sub(now))
+	}
+	return delay, true
+}
+
+// LookupAll looks up the distinct images concurrently, returning the entrypoint/cmd of those that were resolved keyed
+// by image, and the errors of those that were not joined together. If Options.RetryBudget is set, lookups that fail
+// because the registry is unavailable, e.g. a 5xx response or a network error, are retried while the shared budget
+// lasts, and fail with ErrRetryBudgetExhausted once it is spent. Without a budget, each image is looked up once.
+func LookupAll(ctx context.Context, index Interface, images []string, options Options) (map[string]*Image, error) {
+	var (
+		lock     sync.Mutex
+		wg       sync.WaitGroup
+		resolved = map[string]*Image{}
+		errs     []error
+	)
+	seen := map[string]bool{}
+	for _, image := range images {
+		if seen[image] {
+			continue
+		}
+		seen[image] = true
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := lookupRetrying(ctx, index, image, options)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to look up %q: %w", image, err))
+				return
+			}
+			resolved[image] = v
+		}()
+	}
+	wg.Wait()
+	return resolved, errors.Join(errs...)
+}
+
+func lookupRetrying(ctx context.Context, index Interface, image string, options Options) (*Image, error) {
+	for retry := 1; ; retry++ {
+		v, err := index.Lookup(ctx, image, options)
+		if err == nil || options.RetryBudget == nil || !isTransient(err) {
+			return v, err
+		}
+		delay, ok := options.RetryBudget.take(retry)
+		if !ok {
+			return nil, fmt.Errorf("%w: %w", ErrRetryBudgetExhausted, err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isTransient returns true if err may not recur, because the registry failed to respond rather than rejecting the
+// lookup. Rate limits are not transient, since retrying them makes them worse; see WithRateLimitBackoff.
+func isTransient(err error) bool {
+	if errors.Is(err, ErrUnavailable) {
+		return true
+	}
+	var terr *transport.Error
+	if errors.As(err, &terr) {
+		return terr.StatusCode >= http.StatusInternalServerError
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr)
+}
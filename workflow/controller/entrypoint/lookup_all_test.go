@@ -0,0 +1,144 @@
+package entrypoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyIndex fails each image's first failures lookups with err, and then resolves it
+type flakyIndex struct {
+	countingIndex
+	lock     sync.Mutex
+	failures int
+	err      error
+	attempts map[string]int
+}
+
+func (i *flakyIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.attempts == nil {
+		i.attempts = map[string]int{}
+	}
+	i.attempts[image]++
+	if i.attempts[image] <= i.failures {
+		return nil, i.err
+	}
+	return &Image{Entrypoint: []string{"/" + image}}, nil
+}
+
+func (i *flakyIndex) total() int {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	total := 0
+	for _, n := range i.attempts {
+		total += n
+	}
+	return total
+}
+
+func TestLookupAll(t *testing.T) {
+	ctx := context.Background()
+	unavailable := fmt.Errorf("app: %w", ErrUnavailable)
+
+	t.Run("Resolved", func(t *testing.T) {
+		index := &flakyIndex{}
+		images, err := LookupAll(ctx, index, []string{"a", "b", "a"}, Options{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*Image{"a": {Entrypoint: []string{"/a"}}, "b": {Entrypoint: []string{"/b"}}}, images)
+		assert.Equal(t, 2, index.total(), "each distinct image is looked up once")
+	})
+	t.Run("NoBudget", func(t *testing.T) {
+		index := &flakyIndex{failures: 1, err: unavailable}
+		images, err := LookupAll(ctx, index, []string{"a", "b"}, Options{})
+		require.ErrorIs(t, err, ErrUnavailable)
+		assert.NotErrorIs(t, err, ErrRetryBudgetExhausted)
+		assert.Empty(t, images)
+		assert.Equal(t, 2, index.total(), "lookups are not retried")
+	})
+	t.Run("Retried", func(t *testing.T) {
+		index := &flakyIndex{failures: 2, err: unavailable}
+		budget := NewRetryBudget(10, time.Minute, time.Millisecond)
+		images, err := LookupAll(ctx, index, []string{"a", "b", "c"}, Options{RetryBudget: budget})
+		require.NoError(t, err)
+		assert.Len(t, images, 3)
+		assert.Equal(t, 9, index.total())
+		assert.Equal(t, 4, budget.Remaining())
+	})
+	t.Run("BudgetCapsAttempts", func(t *testing.T) {
+		index := &flakyIndex{failures: 100, err: unavailable}
+		budget := NewRetryBudget(5, time.Minute, time.Millisecond)
+		images := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+		resolved, err := LookupAll(ctx, index, images, Options{RetryBudget: budget})
+		require.ErrorIs(t, err, ErrRetryBudgetExhausted)
+		require.ErrorIs(t, err, ErrUnavailable)
+		assert.Empty(t, resolved)
+		// one attempt for each image, plus the budget's retries shared between them
+		assert.Equal(t, len(images)+5, index.total())
+		assert.Zero(t, budget.Remaining())
+
+		// the budget is shared with later lookups, which fail without being retried
+		_, err = LookupAll(ctx, index, []string{"i"}, Options{RetryBudget: budget})
+		require.ErrorIs(t, err, ErrRetryBudgetExhausted)
+		assert.Equal(t, len(images)+6, index.total())
+	})
+	t.Run("Deadline", func(t *testing.T) {
+		index := &flakyIndex{failures: 100, err: unavailable}
+		budget := NewRetryBudget(1000, 50*time.Millisecond, 10*time.Millisecond)
+		start := time.Now()
+		_, err := LookupAll(ctx, index, []string{"a", "b"}, Options{RetryBudget: budget})
+		require.ErrorIs(t, err, ErrRetryBudgetExhausted)
+		assert.Less(t, time.Since(start), time.Second, "retries stop at the deadline")
+		assert.Greater(t, budget.Remaining(), 900)
+	})
+	t.Run("NotTransient", func(t *testing.T) {
+		for name, err := range map[string]error{
+			"NotFound":    fmt.Errorf("app: %w", ErrNotFound),
+			"RateLimited": fmt.Errorf("app: %w", ErrRateLimited),
+			"Forbidden":   &transport.Error{StatusCode: http.StatusForbidden},
+			"Other":       errors.New("invalid reference"),
+		} {
+			index := &flakyIndex{failures: 1, err: err}
+			budget := NewRetryBudget(5, time.Minute, time.Millisecond)
+			_, lookupErr := LookupAll(ctx, index, []string{"a"}, Options{RetryBudget: budget})
+			require.ErrorIs(t, lookupErr, err, name)
+			assert.Equal(t, 1, index.total(), name)
+			assert.Equal(t, 5, budget.Remaining(), name)
+		}
+	})
+	t.Run("Transient", func(t *testing.T) {
+		for name, err := range map[string]error{
+			"ServerError": &transport.Error{StatusCode: http.StatusBadGateway},
+			// e.g. a dial timeout
+			"NetworkError": &timeoutError{},
+		} {
+			index := &flakyIndex{failures: 1, err: err}
+			_, lookupErr := LookupAll(ctx, index, []string{"a"}, Options{RetryBudget: NewRetryBudget(5, time.Minute, time.Millisecond)})
+			require.NoError(t, lookupErr, name)
+			assert.Equal(t, 2, index.total(), name)
+		}
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		index := &flakyIndex{failures: 100, err: unavailable}
+		_, err := LookupAll(ctx, index, []string{"a"}, Options{RetryBudget: NewRetryBudget(5, 0, time.Hour)})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+// timeoutError is a net.Error
+type timeoutError struct{}
+
+func (e *timeoutError) Error() string   { return "i/o timeout" }
+func (e *timeoutError) Timeout() bool   { return true }
+func (e *timeoutError) Temporary() bool { return true }
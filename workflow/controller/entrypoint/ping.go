@@ -0,0 +1,60 @@
+package entrypoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+var (
+	// ErrUnauthorized is returned by Ping when the registry rejects the credentials
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound is returned by Ping when the registry does not have the image
+	ErrNotFound = errors.New("image not found")
+	// ErrUnavailable is returned by Ping when the registry cannot be reached or fails to respond
+	ErrUnavailable = errors.New("registry unavailable")
+	// ErrRateLimited is returned when the registry responds with 429 Too Many Requests, or when a lookup is not made
+	// because the image is backing off after that. See IsRateLimited.
+	ErrRateLimited = errors.New("rate limited by the registry")
+)
+
+func (i *containerRegistryIndex) Ping(ctx context.Context, image string, options Options) error {
+	kc, err := i.k8sKeychain(ctx, options)
+	if err != nil {
+		return err
+	}
+	ref, err := parseReference(image)
+	if err != nil {
+		return err
+	}
+	return pingRemote(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(options.keychain(kc)))
+}
+
+// Warm has nothing to do, since the registry index does not cache
+func (i *containerRegistryIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
+func pingRemote(ref name.Reference, opts ...remote.Option) error {
+	_, err := remote.Head(ref, opts...)
+	if err == nil {
+		return nil
+	}
+	var terr *transport.Error
+	if errors.As(err, &terr) {
+		switch terr.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return fmt.Errorf("%s: %w: %w", ref, ErrUnauthorized, err)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w: %w", ref, ErrNotFound, err)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: %w: %w", ref, ErrRateLimited, err)
+		}
+	}
+	return fmt.Errorf("%s: %w: %w", ref, ErrUnavailable, err)
+}
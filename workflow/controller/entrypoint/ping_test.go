@@ -0,0 +1,48 @@
+package entrypoint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingRemote(t *testing.T) {
+	handler := registry.New()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/v2/private/") {
+			if _, _, ok := r.BasicAuth(); !ok {
+				w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+	parse := func(image string) name.Reference {
+		ref, err := name.ParseReference(host+"/"+image, name.Insecure)
+		require.NoError(t, err)
+		return ref
+	}
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(parse("public/app:v1"), img))
+	require.NoError(t, remote.Write(parse("private/app:v1"), img, remote.WithAuth(&authn.Basic{Username: "user", Password: "pass"})))
+
+	require.NoError(t, pingRemote(parse("public/app:v1")))
+	require.NoError(t, pingRemote(parse("private/app:v1"), remote.WithAuth(&authn.Basic{Username: "user", Password: "pass"})))
+	require.ErrorIs(t, pingRemote(parse("public/app:v2")), ErrNotFound)
+	require.ErrorIs(t, pingRemote(parse("private/app:v1")), ErrUnauthorized)
+
+	s.Close()
+	require.ErrorIs(t, pingRemote(parse("public/app:v1")), ErrUnavailable)
+}
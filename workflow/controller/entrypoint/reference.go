@@ -0,0 +1,66 @@
+package entrypoint
+
+import (
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// ImageReference is an image reference split into its components, with the registry and tag defaults applied.
+type ImageReference struct {
+	// Registry is the registry host, including the port if any, e.g. `index.docker.io` or `localhost:5000`
+	Registry string
+	// Repository is the repository within the registry, e.g. `library/nginx`
+	Repository string
+	// Tag is the tag of the image. It defaults to `latest` unless the image is referenced by digest, in which case it
+	// is only set if the reference explicitly includes a tag.
+	Tag string
+	// Digest is the digest of the image, e.g. `sha256:...`, if the image is referenced by digest
+	Digest string
+}
+
+// ParseImageReference splits image into its components. The registry host includes its port, e.g. the registry of
+// `localhost:5000/image:v1` is `localhost:5000` and its tag `v1`, but a reference without a repository, e.g.
+// `localhost:5000`, is a Docker Hub image with a tag. As with Docker, `localhost` is a registry even without a port.
+func ParseImageReference(image string) (*ImageReference, error) {
+	ref, err := parseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	v := &ImageReference{
+		Registry:   ref.Context().RegistryStr(),
+		Repository: ref.Context().RepositoryStr(),
+	}
+	switch r := ref.(type) {
+	case name.Tag:
+		v.Tag = r.TagStr()
+	case name.Digest:
+		v.Digest = r.DigestStr()
+		// name.Digest drops any tag, so split it out the same way name.NewTag does
+		base, _, _ := strings.Cut(image, "@")
+		if i := strings.LastIndex(base, ":"); i >= 0 && !strings.Contains(base[i+1:], "/") {
+			v.Tag = base[i+1:]
+		}
+	}
+	return v, nil
+}
+
+// canonicalReference returns the fully qualified form of image, so that equivalent references such as `nginx`,
+// `docker.io/library/nginx:latest` and `index.docker.io/library/nginx:latest` map to the same value.
+func canonicalReference(image string, opts ...name.Option) (string, error) {
+	ref, err := parseReference(image, opts...)
+	if err != nil {
+		return "", err
+	}
+	return ref.Name(), nil
+}
+
+// parseReference parses image as Docker, and so the kubelet, does. go-containerregistry only treats the first
+// component of a reference as the registry if it has a '.' or ':', so `localhost/image` would otherwise be looked up
+// on Docker Hub rather than on localhost, where the kubelet pulls it from.
+func parseReference(image string, opts ...name.Option) (name.Reference, error) {
+	if rest, ok := strings.CutPrefix(image, "localhost/"); ok {
+		return name.ParseReference(rest, append(opts, name.WithDefaultRegistry("localhost"))...)
+	}
+	return name.ParseReference(image, opts...)
+}
@@ -0,0 +1,82 @@
+package entrypoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageReference(t *testing.T) {
+	const digest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+	for image, expected := range map[string]ImageReference{
+		"nginx":                          {Registry: "index.docker.io", Repository: "library/nginx", Tag: "latest"},
+		"argoproj/argoexec:v3.6":         {Registry: "index.docker.io", Repository: "argoproj/argoexec", Tag: "v3.6"},
+		"quay.io/argoproj/argocli":       {Registry: "quay.io", Repository: "argoproj/argocli", Tag: "latest"},
+		"alpine@" + digest:               {Registry: "index.docker.io", Repository: "library/alpine", Digest: digest},
+		"alpine:3.20@" + digest:          {Registry: "index.docker.io", Repository: "library/alpine", Tag: "3.20", Digest: digest},
+		"localhost:5000/my/image:1.0":    {Registry: "localhost:5000", Repository: "my/image", Tag: "1.0"},
+		"localhost:5000/my/image":        {Registry: "localhost:5000", Repository: "my/image", Tag: "latest"},
+		"localhost:5000/image@" + digest: {Registry: "localhost:5000", Repository: "image", Digest: digest},
+		// the port is part of the registry host, not a tag, whether or not the host is localhost
+		"localhost:5000/image:5000@" + digest:            {Registry: "localhost:5000", Repository: "image", Tag: "5000", Digest: digest},
+		"registry.example.com:5000/team/image:v1":        {Registry: "registry.example.com:5000", Repository: "team/image", Tag: "v1"},
+		"registry.example.com:5000/team/image@" + digest: {Registry: "registry.example.com:5000", Repository: "team/image", Digest: digest},
+		"registry.example.com:5000/team/image":           {Registry: "registry.example.com:5000", Repository: "team/image", Tag: "latest"},
+		"myregistry:5000/image:v1":                       {Registry: "myregistry:5000", Repository: "image", Tag: "v1"},
+		"127.0.0.1:5000/image":                           {Registry: "127.0.0.1:5000", Repository: "image", Tag: "latest"},
+		"[::1]:5000/image:v1":                            {Registry: "[::1]:5000", Repository: "image", Tag: "v1"},
+		// as with Docker, localhost is a registry even without a port
+		"localhost/image:5000": {Registry: "localhost", Repository: "image", Tag: "5000"},
+		"localhost/team/image": {Registry: "localhost", Repository: "team/image", Tag: "latest"},
+		// without a repository, `localhost:5000` is the Docker Hub image `localhost` with the tag `5000`
+		"localhost:5000": {Registry: "index.docker.io", Repository: "library/localhost", Tag: "5000"},
+	} {
+		v, err := ParseImageReference(image)
+		require.NoError(t, err, image)
+		assert.Equal(t, expected, *v, image)
+	}
+
+	_, err := ParseImageReference("Not A Reference")
+	require.Error(t, err)
+}
+
+func TestCanonicalReference(t *testing.T) {
+	for canonical, images := range map[string][]string{
+		"index.docker.io/library/nginx:latest": {"nginx", "nginx:latest", "library/nginx", "docker.io/library/nginx:latest", "index.docker.io/library/nginx:latest"},
+		"index.docker.io/argoproj/argoexec:v3": {"argoproj/argoexec:v3", "docker.io/argoproj/argoexec:v3"},
+		"quay.io/argoproj/argocli:latest":      {"quay.io/argoproj/argocli", "quay.io/argoproj/argocli:latest"},
+		"index.docker.io/library/alpine@sha256:0000000000000000000000000000000000000000000000000000000000000000": {
+			"alpine@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			"docker.io/library/alpine@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		"localhost:5000/image:latest": {"localhost:5000/image", "localhost:5000/image:latest"},
+		"localhost:5000/image@sha256:0000000000000000000000000000000000000000000000000000000000000000": {
+			"localhost:5000/image@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		"localhost/image:5000": {"localhost/image:5000"},
+	} {
+		for _, image := range images {
+			v, err := canonicalReference(image)
+			require.NoError(t, err)
+			assert.Equal(t, canonical, v, image)
+		}
+	}
+
+	_, err := canonicalReference("Not A Reference")
+	require.Error(t, err)
+}
+
+func TestEntrypointOverrideRegistryPort(t *testing.T) {
+	options := Options{EntrypointOverrides: map[string]Image{"localhost:5000/image": {Entrypoint: []string{"/app"}}}}
+	for image, ok := range map[string]bool{
+		"localhost:5000/image":        true,
+		"localhost:5000/image:latest": true,
+		"localhost:5000/image:v1":     false,
+		"localhost/image:5000":        false,
+		"localhost:5001/image":        false,
+	} {
+		_, found := options.entrypointOverride(image)
+		assert.Equal(t, ok, found, image)
+	}
+}
@@ -0,0 +1,143 @@
+package entrypoint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+// SocketResolver is a resolver, e.g. a sidecar of the controller that holds the registry credentials, that looks up
+// images' entrypoint/cmd on the controller's behalf, so that the controller needs no access to the credentials.
+//
+// It is sent a POST of a ResolveRequest as JSON, and responds 200 OK with a ResolveResponse as JSON, 404 Not Found if
+// the image does not exist, 401 Unauthorized or 403 Forbidden if it has no credentials for the image, or 429 Too Many
+// Requests if the registry is rate limiting it. These are returned as ErrNotFound, ErrUnauthorized and ErrRateLimited
+// respectively, and any other response as ErrUnavailable.
+type SocketResolver struct {
+	// SocketPath is the path of the unix socket the resolver listens for HTTP on
+	SocketPath string
+	// Path is the HTTP path of the resolver's endpoint. Defaults to "/resolve".
+	Path string
+	// Timeout limits how long the resolver may take to respond. It is not limited if zero.
+	Timeout time.Duration
+}
+
+// ResolveRequest is the body of a request to a SocketResolver
+type ResolveRequest struct {
+	Image              string `json:"image"`
+	Namespace          string `json:"namespace,omitempty"`
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ImagePullSecrets are the names of the pod's image pull secrets, in Namespace
+	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
+	// Platforms are the platforms to resolve a multi-platform image for in order of preference, e.g. "linux/arm64"
+	Platforms []string `json:"platforms"`
+}
+
+// ResolveResponse is the body of a SocketResolver's response to a ResolveRequest. Entrypoint and Cmd are omitted, or
+// null, if the image does not set them.
+type ResolveResponse struct {
+	Entrypoint []string `json:"entrypoint,omitempty"`
+	Cmd        []string `json:"cmd,omitempty"`
+	StopSignal string   `json:"stopSignal,omitempty"`
+}
+
+// resolverIndex looks images up with Options.Resolver. It returns nil, so the chain falls back to the registry, when
+// no resolver is set, or a SignaturePolicy is, since the resolver's response cannot be verified. Otherwise, the
+// resolver replaces the registry, so its errors are returned rather than falling back.
+type resolverIndex struct{}
+
+func (i resolverIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	r := options.Resolver
+	if r == nil || options.SignaturePolicy != nil {
+		return nil, nil
+	}
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+	request := ResolveRequest{
+		Image:              image,
+		Namespace:          options.Namespace,
+		ServiceAccountName: options.ServiceAccountName,
+		Platforms:          []string{},
+	}
+	for _, secret := range options.ImagePullSecrets {
+		request.ImagePullSecrets = append(request.ImagePullSecrets, secret.Name)
+	}
+	for _, platform := range options.platforms() {
+		request.Platforms = append(request.Platforms, platform.String())
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	path := r.Path
+	if path == "" {
+		path = "/resolve"
+	}
+	// the host is ignored, the request is always sent to the socket
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://resolver"+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", image, ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err := fmt.Errorf("resolver responded %s: %s", resp.Status, bytes.TrimSpace(message))
+		switch resp.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return nil, fmt.Errorf("%s: %w: %w", image, ErrUnauthorized, err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("%s: %w: %w", image, ErrNotFound, err)
+		case http.StatusTooManyRequests:
+			return nil, fmt.Errorf("%s: %w: %w", image, ErrRateLimited, err)
+		}
+		return nil, fmt.Errorf("%s: %w: %w", image, ErrUnavailable, err)
+	}
+	var response ResolveResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("%s: %w: invalid response from resolver: %w", image, ErrUnavailable, err)
+	}
+	return Image{Entrypoint: response.Entrypoint, Cmd: response.Cmd, StopSignal: response.StopSignal, Source: SourceResolver}.normalized(), nil
+}
+
+func (r *SocketResolver) client() *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", r.SocketPath)
+		},
+		// a client is made per lookup, so its connection would never be reused
+		DisableKeepAlives: true,
+	}}
+}
+
+// LookupConfig returns nil, so the chain falls back to the registry, since the resolver only returns the entrypoint/cmd
+func (i resolverIndex) LookupConfig(ctx context.Context, image string, options Options) (*gcrv1.ConfigFile, error) {
+	return nil, nil
+}
+
+// Ping has nothing to check, since the resolver's access to the registry is its own
+func (i resolverIndex) Ping(ctx context.Context, image string, options Options) error {
+	return nil
+}
+
+// Warm has nothing to do, since the resolver index does not cache
+func (i resolverIndex) Warm(ctx context.Context, images []string, options Options) error {
+	return nil
+}
+
+var _ Interface = resolverIndex{}
@@ -0,0 +1,144 @@
+package entrypoint
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newResolver starts a resolver listening on a unix socket that responds to each request with handler
+func newResolver(t *testing.T, handler http.HandlerFunc) *SocketResolver {
+	t.Helper()
+	socketPath := filepath.Join(t.TempDir(), "resolver.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	s := httptest.NewUnstartedServer(handler)
+	s.Listener = listener
+	s.Start()
+	t.Cleanup(s.Close)
+	return &SocketResolver{SocketPath: socketPath}
+}
+
+func TestResolverIndex(t *testing.T) {
+	ctx := context.Background()
+	var requests []ResolveRequest
+	resolver := newResolver(t, func(w http.ResponseWriter, r *http.Request) {
+		var request ResolveRequest
+		if r.Method != http.MethodPost || r.URL.Path != "/resolve" || json.NewDecoder(r.Body).Decode(&request) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, request)
+		switch request.Image {
+		case "app":
+			_ = json.NewEncoder(w).Encode(ResolveResponse{Entrypoint: []string{"/app"}, Cmd: []string{"--serve"}, StopSignal: "SIGQUIT"})
+		case "scratch":
+			_, _ = w.Write([]byte(`{"entrypoint":[],"cmd":null}`))
+		case "private":
+			http.Error(w, "no credentials for private", http.StatusForbidden)
+		case "limited":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "invalid":
+			_, _ = w.Write([]byte(`not json`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	options := Options{
+		Namespace:          "argo",
+		ServiceAccountName: "workflow",
+		ImagePullSecrets:   []apiv1.LocalObjectReference{{Name: "pull-secret"}},
+		PlatformPreferences: []gcrv1.Platform{
+			{OS: "linux", Architecture: "arm64"},
+			{OS: "linux", Architecture: "amd64"},
+		},
+		Resolver: resolver,
+	}
+
+	image, err := resolverIndex{}.Lookup(ctx, "app", options)
+	require.NoError(t, err)
+	assert.Equal(t, &Image{Entrypoint: []string{"/app"}, Cmd: []string{"--serve"}, StopSignal: "SIGQUIT", Source: SourceResolver}, image)
+	assert.Equal(t, []ResolveRequest{{
+		Image:              "app",
+		Namespace:          "argo",
+		ServiceAccountName: "workflow",
+		ImagePullSecrets:   []string{"pull-secret"},
+		Platforms:          []string{"linux/arm64", "linux/amd64"},
+	}}, requests)
+
+	image, err = resolverIndex{}.Lookup(ctx, "scratch", options)
+	require.NoError(t, err)
+	assert.Nil(t, image.Entrypoint)
+	assert.Nil(t, image.Cmd)
+
+	for image, expected := range map[string]error{
+		"private": ErrUnauthorized,
+		"missing": ErrNotFound,
+		"limited": ErrRateLimited,
+		"invalid": ErrUnavailable,
+	} {
+		_, err := resolverIndex{}.Lookup(ctx, image, options)
+		require.ErrorIs(t, err, expected, image)
+	}
+	_, err = resolverIndex{}.Lookup(ctx, "private", options)
+	assert.ErrorContains(t, err, "no credentials for private")
+
+	t.Run("NotSet", func(t *testing.T) {
+		image, err := resolverIndex{}.Lookup(ctx, "app", Options{})
+		require.NoError(t, err)
+		assert.Nil(t, image)
+		image, err = resolverIndex{}.Lookup(ctx, "app", Options{Resolver: resolver, SignaturePolicy: &SignaturePolicy{PublicKeys: []crypto.PublicKey{}}})
+		require.NoError(t, err)
+		assert.Nil(t, image, "the resolver's response cannot be verified")
+	})
+	t.Run("Unavailable", func(t *testing.T) {
+		_, err := resolverIndex{}.Lookup(ctx, "app", Options{Resolver: &SocketResolver{SocketPath: filepath.Join(t.TempDir(), "missing.sock")}})
+		require.ErrorIs(t, err, ErrUnavailable)
+	})
+	t.Run("Timeout", func(t *testing.T) {
+		done := make(chan struct{})
+		slow := newResolver(t, func(w http.ResponseWriter, r *http.Request) { <-done })
+		t.Cleanup(func() { close(done) })
+		slow.Timeout = 10 * time.Millisecond
+		_, err := resolverIndex{}.Lookup(ctx, "app", Options{Resolver: slow})
+		require.ErrorIs(t, err, ErrUnavailable)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("Path", func(t *testing.T) {
+		custom := newResolver(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/entrypoint" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(ResolveResponse{Entrypoint: []string{"/custom"}})
+		})
+		custom.Path = "/v1/entrypoint"
+		image, err := resolverIndex{}.Lookup(ctx, "app", Options{Resolver: custom})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/custom"}, image.Entrypoint)
+	})
+	t.Run("Chain", func(t *testing.T) {
+		// the resolver replaces the registry, which is never asked
+		i := New(fake.NewSimpleClientset(), nil)
+		image, err := i.Lookup(ctx, "app", Options{Resolver: resolver, EntrypointOverrides: map[string]Image{"overridden": {Entrypoint: []string{"/overridden"}}}})
+		require.NoError(t, err)
+		assert.Equal(t, SourceResolver, image.Source)
+		image, err = i.Lookup(ctx, "overridden", Options{Resolver: resolver, EntrypointOverrides: map[string]Image{"overridden": {Entrypoint: []string{"/overridden"}}}})
+		require.NoError(t, err)
+		assert.Equal(t, SourceOverride, image.Source)
+		_, err = i.Lookup(ctx, "missing", Options{Resolver: resolver})
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,128 @@
+package entrypoint
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// ErrSignatureVerificationFailed is returned when Options.SignaturePolicy is set and the image has no signature that
+// the policy verifies
+var ErrSignatureVerificationFailed = errors.New("signature verification failed")
+
+// SignaturePolicy requires images to be signed with cosign before their entrypoint/cmd is returned
+type SignaturePolicy struct {
+	// PublicKeys verify signatures made with `cosign sign --key`. ECDSA and Ed25519 keys are supported. The image must
+	// have a signature that one of them verifies.
+	PublicKeys []crypto.PublicKey
+	// Verify, if set, verifies the image instead of PublicKeys, e.g. a keyless signature against a transparency log
+	// and certificate identity policy. It is given the digest the image's reference resolved to, and the options to
+	// access its registry with. Any error it returns fails verification.
+	Verify func(ctx context.Context, digest name.Digest, opts ...remote.Option) error
+}
+
+const (
+	// cosignSignatureAnnotation is the annotation of a cosign signature layer that holds the base64 signature of the
+	// layer's payload
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// maxSignaturePayloadSize limits how much of a signature payload is read, since payloads are small JSON documents
+	maxSignaturePayloadSize = 1 << 20
+)
+
+// verifySignature verifies that the image digest, which ref resolved to, is signed as policy requires. Signatures are
+// read from the image's cosign signature tag, e.g. sha256-<hex>.sig, in the same repository.
+func verifySignature(ctx context.Context, ref name.Reference, digest gcrv1.Hash, policy *SignaturePolicy, opts ...remote.Option) error {
+	if policy.Verify != nil {
+		if err := policy.Verify(ctx, ref.Context().Digest(digest.String()), opts...); err != nil {
+			return fmt.Errorf("%s: %w: %w", ref, ErrSignatureVerificationFailed, err)
+		}
+		return nil
+	}
+	sigRef := ref.Context().Tag(fmt.Sprintf("%s-%s.sig", digest.Algorithm, digest.Hex))
+	sigImg, err := remote.Image(sigRef, opts...)
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w: the image is not signed", ref, ErrSignatureVerificationFailed)
+	}
+	if err != nil {
+		return err
+	}
+	m, err := sigImg.Manifest()
+	if err != nil {
+		return err
+	}
+	for _, desc := range m.Layers {
+		signature, ok := desc.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		payload, err := signaturePayload(sigImg, desc.Digest)
+		if err != nil {
+			return err
+		}
+		if verifyPayload(payload, signature, digest, policy.PublicKeys) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: %w: none of the image's signatures were made with a trusted key", ref, ErrSignatureVerificationFailed)
+}
+
+func signaturePayload(sigImg gcrv1.Image, digest gcrv1.Hash) ([]byte, error) {
+	layer, err := sigImg.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(io.LimitReader(rc, maxSignaturePayloadSize))
+}
+
+// verifyPayload returns true if one of the keys verifies the base64 signature of the payload, and the payload is a
+// simple signing payload for the digest
+func verifyPayload(payload []byte, signature string, digest gcrv1.Hash, keys []crypto.PublicKey) bool {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	sum := sha256.Sum256(payload)
+	verified := false
+	for _, key := range keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			verified = ecdsa.VerifyASN1(k, sum[:], sig)
+		case ed25519.PublicKey:
+			verified = ed25519.Verify(k, payload, sig)
+		}
+		if verified {
+			break
+		}
+	}
+	if !verified {
+		return false
+	}
+	// the signature must be for this image, not another one signed with the same key
+	var p struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+		} `json:"critical"`
+	}
+	return json.Unmarshal(payload, &p) == nil && p.Critical.Image.DockerManifestDigest == digest.String()
+}
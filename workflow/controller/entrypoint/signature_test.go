@@ -0,0 +1,142 @@
+package entrypoint
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/lru"
+)
+
+// writeImage writes a random image with the entrypoint to the repository, and returns its reference and digest
+func writeImage(t *testing.T, repository, tag string, entrypoint string) (name.Reference, gcrv1.Hash) {
+	t.Helper()
+	ref, err := name.ParseReference(repository+":"+tag, name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err = mutate.Config(img, gcrv1.Config{Entrypoint: []string{entrypoint}})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return ref, digest
+}
+
+// sign writes a cosign signature for the signed digest, made by signPayload, to the signature tag of digest
+func sign(t *testing.T, ref name.Reference, digest gcrv1.Hash, signed gcrv1.Hash, signPayload func(payload []byte) []byte) {
+	t.Helper()
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, ref.Context().String(), signed))
+	layer := static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json")
+	img, err := mutate.Append(mutate.MediaType(empty.Image, types.OCIManifestSchema1), mutate.Addendum{
+		Layer:       layer,
+		Annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signPayload(payload))},
+	})
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref.Context().Tag(fmt.Sprintf("%s-%s.sig", digest.Algorithm, digest.Hex)), img))
+}
+
+func TestLookupSignature(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	repository := strings.TrimPrefix(s.URL, "http://") + "/app"
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signECDSA := func(key *ecdsa.PrivateKey) func(payload []byte) []byte {
+		return func(payload []byte) []byte {
+			sum := sha256.Sum256(payload)
+			sig, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+			require.NoError(t, err)
+			return sig
+		}
+	}
+	policy := &SignaturePolicy{PublicKeys: []crypto.PublicKey{&key.PublicKey}}
+	ctx := context.Background()
+
+	signedRef, signedDigest := writeImage(t, repository, "signed", "/signed")
+	sign(t, signedRef, signedDigest, signedDigest, signECDSA(key))
+	image, err := lookupRemote(ctx, signedRef, Options{SignaturePolicy: policy})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/signed"}, image.Entrypoint)
+
+	unsignedRef, unsignedDigest := writeImage(t, repository, "unsigned", "/unsigned")
+	_, err = lookupRemote(ctx, unsignedRef, Options{SignaturePolicy: policy})
+	require.ErrorIs(t, err, ErrSignatureVerificationFailed)
+	assert.Contains(t, err.Error(), "the image is not signed")
+	// without a policy, the behaviour is unchanged
+	image, err = lookupRemote(ctx, unsignedRef, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/unsigned"}, image.Entrypoint)
+
+	t.Run("UntrustedKey", func(t *testing.T) {
+		other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		_, err = lookupRemote(ctx, signedRef, Options{SignaturePolicy: &SignaturePolicy{PublicKeys: []crypto.PublicKey{&other.PublicKey}}})
+		require.ErrorIs(t, err, ErrSignatureVerificationFailed)
+		assert.Contains(t, err.Error(), "none of the image's signatures were made with a trusted key")
+	})
+	t.Run("SignatureForAnotherImage", func(t *testing.T) {
+		// a valid signature of the signed image, copied to the unsigned image's signature tag
+		sign(t, unsignedRef, unsignedDigest, signedDigest, signECDSA(key))
+		_, err := lookupRemote(ctx, unsignedRef, Options{SignaturePolicy: policy})
+		require.ErrorIs(t, err, ErrSignatureVerificationFailed)
+	})
+	t.Run("Ed25519", func(t *testing.T) {
+		public, private, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		ref, digest := writeImage(t, repository, "ed25519", "/ed25519")
+		sign(t, ref, digest, digest, func(payload []byte) []byte { return ed25519.Sign(private, payload) })
+		image, err := lookupRemote(ctx, ref, Options{SignaturePolicy: &SignaturePolicy{PublicKeys: []crypto.PublicKey{&key.PublicKey, public}}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/ed25519"}, image.Entrypoint)
+	})
+	t.Run("Verify", func(t *testing.T) {
+		var verified name.Digest
+		keyless := &SignaturePolicy{Verify: func(ctx context.Context, digest name.Digest, opts ...remote.Option) error {
+			verified = digest
+			if digest.DigestStr() != signedDigest.String() {
+				return fmt.Errorf("no matching certificate identity")
+			}
+			return nil
+		}}
+		_, err := lookupRemote(ctx, signedRef, Options{SignaturePolicy: keyless})
+		require.NoError(t, err)
+		assert.Equal(t, repository+"@"+signedDigest.String(), verified.String())
+		_, err = lookupRemote(ctx, unsignedRef, Options{SignaturePolicy: keyless})
+		require.ErrorIs(t, err, ErrSignatureVerificationFailed)
+		assert.Contains(t, err.Error(), "no matching certificate identity")
+	})
+	t.Run("NotCachedOrLocal", func(t *testing.T) {
+		delegate := &countingIndex{image: &Image{Entrypoint: []string{"/app"}}}
+		index := &cacheIndex{lru.New(8), chainIndex{localIndex{}, delegate}}
+		local := &fakeLocalImageService{images: map[string]gcrv1.Config{"app": {Entrypoint: []string{"/local"}}}}
+		options := Options{SignaturePolicy: policy, LocalImages: local}
+		for range 2 {
+			image, err := index.Lookup(ctx, "app", options)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"/app"}, image.Entrypoint)
+		}
+		assert.Equal(t, 2, delegate.lookups, "each lookup is verified")
+		assert.Zero(t, local.lookups, "local images cannot be verified")
+	})
+}
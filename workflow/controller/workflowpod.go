@@ -400,10 +400,10 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec = *patchedPodSpec
 	}
 
+	resolutionDisabled := entrypoint.ResolutionDisabled(woc.wf.ObjectMeta) || entrypoint.ResolutionDisabled(pod.ObjectMeta)
 	for i, c := range pod.Spec.Containers {
 		if c.Name != common.WaitContainerName {
-			// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
-			if len(c.Command) == 0 {
+			if entrypoint.NeedsLookup(c) && !resolutionDisabled {
 				x, err := woc.controller.entrypoint.Lookup(ctx, c.Image, entrypoint.Options{
 					Namespace: woc.wf.Namespace, ServiceAccountName: woc.execWf.Spec.ServiceAccountName, ImagePullSecrets: woc.execWf.Spec.ImagePullSecrets,
 				})
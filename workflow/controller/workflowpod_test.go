@@ -679,6 +679,32 @@ func Test_createWorkflowPod_emissary(t *testing.T) {
 		assert.Equal(t, cmd, pod.Spec.Containers[1].Command)
 		assert.Equal(t, []string{"foo"}, pod.Spec.Containers[1].Args)
 	})
+	t.Run("NoCommandWithCmdOnlyImageIndex", func(t *testing.T) {
+		woc := newWoc()
+		pod, err := woc.createWorkflowPod(context.Background(), "", []apiv1.Container{{Image: "my-shell-image"}}, &wfv1.Template{}, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		// the image's cmd is the whole command, so the emissary runs it as is
+		cmd := append(append(emissaryCmd, woc.getExecutorLogOpts()...), "--")
+		assert.Equal(t, cmd, pod.Spec.Containers[1].Command)
+		assert.Equal(t, []string{"/bin/sh", "-c", "echo hi"}, pod.Spec.Containers[1].Args)
+	})
+	t.Run("NoCommandWithResolutionDisabled", func(t *testing.T) {
+		disabled := map[string]string{common.AnnotationKeyEntrypointResolution: "disabled"}
+		cmd := append(append(emissaryCmd, newWoc().getExecutorLogOpts()...), "--")
+
+		woc := newWoc()
+		pod, err := woc.createWorkflowPod(context.Background(), "", []apiv1.Container{{Image: "my-image", Args: []string{"foo"}}}, &wfv1.Template{Metadata: wfv1.Metadata{Annotations: disabled}}, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		// the image's entrypoint is not looked up, so the args are the command
+		assert.Equal(t, cmd, pod.Spec.Containers[1].Command)
+		assert.Equal(t, []string{"foo"}, pod.Spec.Containers[1].Args)
+
+		woc = newWoc()
+		woc.wf.Annotations = disabled
+		pod, err = woc.createWorkflowPod(context.Background(), "", []apiv1.Container{{Image: "docker/whalesay:nope", Args: []string{"foo"}}}, &wfv1.Template{}, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, cmd, pod.Spec.Containers[1].Command)
+	})
 	t.Run("CommandFromPodSpecPatch", func(t *testing.T) {
 		woc := newWoc()
 		podSpec := &apiv1.PodSpec{}
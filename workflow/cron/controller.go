@@ -3,6 +3,7 @@ package cron
 import (
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -31,6 +33,7 @@ import (
 	wfctx "github.com/argoproj/argo-workflows/v3/util/context"
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
@@ -44,6 +47,7 @@ type Controller struct {
 	cron                 *cronFacade
 	keyLock              sync.KeyLock
 	wfClientset          versioned.Interface
+	kubeClient           kubernetes.Interface
 	wfLister             util.WorkflowLister
 	cronWfInformer       informers.GenericInformer
 	wftmplInformer       wfextvv1alpha1.WorkflowTemplateInformer
@@ -54,6 +58,15 @@ type Controller struct {
 	metrics              *metrics.Metrics
 	eventRecorderManager events.EventRecorderManager
 	cronWorkflowWorkers  int
+	entrypoint           entrypoint.Interface
+	// mutators are applied to every Workflow before it is submitted
+	mutators []util.WorkflowMutator
+	// timezones are the default timezones of CronWorkflows, keyed by namespace
+	timezones map[string]string
+	// defaultTimezoneUTC makes CronWorkflows without a timezone or a namespace default run in UTC
+	defaultTimezoneUTC bool
+	missedDeadlines    *missedDeadlines
+	checkedSpecs       *checkedSpecs
 }
 
 const (
@@ -62,6 +75,8 @@ const (
 
 var (
 	cronSyncPeriod = env.LookupEnvDurationOr("CRON_SYNC_PERIOD", 10*time.Second)
+	// validateCronEntrypoint enables checking that the entrypoint template's image can be resolved from its registry
+	validateCronEntrypoint = os.Getenv("CRON_VALIDATE_ENTRYPOINT") == "true"
 )
 
 func init() {
@@ -73,10 +88,17 @@ func init() {
 	log.WithField("cronSyncPeriod", cronSyncPeriod).Info("cron config")
 }
 
-func NewCronController(ctx context.Context, wfclientset versioned.Interface, dynamicInterface dynamic.Interface, namespace string, managedNamespace string, instanceId string, metrics *metrics.Metrics,
-	eventRecorderManager events.EventRecorderManager, cronWorkflowWorkers int, wftmplInformer wfextvv1alpha1.WorkflowTemplateInformer, cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, wfDefaults *v1alpha1.Workflow) *Controller {
+func NewCronController(ctx context.Context, wfclientset versioned.Interface, kubeclientset kubernetes.Interface, dynamicInterface dynamic.Interface, namespace string, managedNamespace string, instanceId string, metrics *metrics.Metrics,
+	eventRecorderManager events.EventRecorderManager, cronWorkflowWorkers int, wftmplInformer wfextvv1alpha1.WorkflowTemplateInformer, cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, wfDefaults *v1alpha1.Workflow, entrypointIndex entrypoint.Interface) *Controller {
+	if !validateCronEntrypoint {
+		entrypointIndex = nil
+	} else if entrypointIndex != nil {
+		// a rate limited lookup is retried on every sync, so back off rather than keep the registry rate limiting us
+		entrypointIndex = entrypoint.WithRateLimitBackoff(entrypointIndex, 10*time.Second, 5*time.Minute)
+	}
 	return &Controller{
 		wfClientset:          wfclientset,
+		kubeClient:           kubeclientset,
 		namespace:            namespace,
 		managedNamespace:     managedNamespace,
 		instanceId:           instanceId,
@@ -90,7 +112,42 @@ func NewCronController(ctx context.Context, wfclientset versioned.Interface, dyn
 		wftmplInformer:       wftmplInformer,
 		cwftmplInformer:      cwftmplInformer,
 		cronWorkflowWorkers:  cronWorkflowWorkers,
+		entrypoint:           entrypointIndex,
+		missedDeadlines:      newMissedDeadlines(),
+		checkedSpecs:         newCheckedSpecs(),
+	}
+}
+
+// AddWorkflowMutator registers a mutator that is applied to every Workflow the controller submits, after it is built
+// from the CronWorkflow and before it is validated and created. It must be called before Run.
+func (cc *Controller) AddWorkflowMutator(mutator util.WorkflowMutator) {
+	cc.mutators = append(cc.mutators, mutator)
+}
+
+// SetNamespaceTimezones sets the default timezone of CronWorkflows in each namespace, keyed by namespace, for
+// CronWorkflows that do not set one. It must be called before Run.
+func (cc *Controller) SetNamespaceTimezones(timezones map[string]string) {
+	cc.timezones = timezones
+}
+
+// SetDefaultTimezoneUTC makes CronWorkflows that set no timezone, and whose namespace has no default, run in UTC
+// rather than the controller's local time. It must be called before Run.
+func (cc *Controller) SetDefaultTimezoneUTC(utc bool) {
+	cc.defaultTimezoneUTC = utc
+}
+
+// newCronWfOperationCtx returns the operation context for the CronWorkflow, with the namespace's default timezone, or
+// UTC if it has none and SetDefaultTimezoneUTC is set, for its schedules to fire in if it does not set one
+func (cc *Controller) newCronWfOperationCtx(cronWf *v1alpha1.CronWorkflow) *cronWfOperationCtx {
+	woc := newCronWfOperationCtx(cronWf, cc.wfClientset, cc.kubeClient, cc.metrics, cc.wftmplInformer, cc.cwftmplInformer, cc.wfDefaults, cc.eventRecorderManager.Get(cronWf.Namespace), cc.entrypoint)
+	woc.mutators = cc.mutators
+	woc.missedDeadlines = cc.missedDeadlines
+	woc.checkedSpecs = cc.checkedSpecs
+	woc.defaultTimezone = cc.timezones[cronWf.Namespace]
+	if woc.defaultTimezone == "" && cc.defaultTimezoneUTC {
+		woc.defaultTimezone = "UTC"
 	}
+	return woc
 }
 
 func (cc *Controller) Run(ctx context.Context) {
@@ -160,6 +217,11 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 	if !exists {
 		logCtx.Infof("Deleting '%s'", key)
 		cc.cron.Delete(key)
+		cc.missedDeadlines.forget(key)
+		cc.checkedSpecs.forget(key)
+		if namespace, name, err := cache.SplitMetaNamespaceKey(key); err == nil && cc.metrics != nil {
+			cc.metrics.CronWfDeleted(name, namespace)
+		}
 		return true
 	}
 
@@ -177,13 +239,14 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 	}
 	ctx = wfctx.InjectObjectMeta(ctx, &cronWf.ObjectMeta)
 
-	cronWorkflowOperationCtx := newCronWfOperationCtx(cronWf, cc.wfClientset, cc.metrics, cc.wftmplInformer, cc.cwftmplInformer, cc.wfDefaults)
+	cronWorkflowOperationCtx := cc.newCronWfOperationCtx(cronWf)
 
 	err = cronWorkflowOperationCtx.validateCronWorkflow(ctx)
 	if err != nil {
 		logCtx.WithError(err).Error("invalid cron workflow")
 		return true
 	}
+	cronWorkflowOperationCtx.checkSpec(ctx)
 
 	wfWasRun, err := cronWorkflowOperationCtx.runOutstandingWorkflows(ctx)
 	if err != nil {
@@ -197,7 +260,7 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 	// The job is currently scheduled, remove it and re add it.
 	cc.cron.Delete(key)
 
-	for _, schedule := range cronWf.Spec.GetSchedulesWithTimezone(ctx) {
+	for _, schedule := range cronWorkflowOperationCtx.scheduleSpec().GetSchedulesWithTimezone(ctx) {
 		lastScheduledTimeFunc, err := cc.cron.AddJob(key, schedule, cronWorkflowOperationCtx)
 		if err != nil {
 			logCtx.WithError(err).Error("could not schedule CronWorkflow")
@@ -205,6 +268,12 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 		}
 		cronWorkflowOperationCtx.scheduledTimeFunc = lastScheduledTimeFunc
 	}
+	if window, err := cronWorkflowOperationCtx.scheduleWindow(); err != nil {
+		logCtx.WithError(err).Error("could not schedule CronWorkflow")
+		return true
+	} else if window != nil {
+		cronWorkflowOperationCtx.scheduledTimeFunc = cc.cron.AddScheduledJob(key, window, cronWorkflowOperationCtx)
+	}
 
 	logCtx.Infof("CronWorkflow %s added", key)
 
@@ -295,7 +364,7 @@ func (cc *Controller) syncCronWorkflow(ctx context.Context, cronWf *v1alpha1.Cro
 	cc.keyLock.Lock(key)
 	defer cc.keyLock.Unlock(key)
 
-	cwoc := newCronWfOperationCtx(cronWf, cc.wfClientset, cc.metrics, cc.wftmplInformer, cc.cwftmplInformer, cc.wfDefaults)
+	cwoc := cc.newCronWfOperationCtx(cronWf)
 	err := cwoc.enforceHistoryLimit(ctx, workflows)
 	if err != nil {
 		return err
@@ -304,6 +373,14 @@ func (cc *Controller) syncCronWorkflow(ctx context.Context, cronWf *v1alpha1.Cro
 	if err != nil {
 		return err
 	}
+	err = cwoc.terminateStuckWorkflows(ctx, workflows)
+	if err != nil {
+		return err
+	}
+	err = cwoc.deleteIfStoppedExpired(ctx)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
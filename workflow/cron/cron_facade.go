@@ -48,12 +48,18 @@ func (f *cronFacade) Delete(key string) {
 }
 
 func (f *cronFacade) AddJob(key, schedule string, cwoc *cronWfOperationCtx) (ScheduledTimeFunc, error) {
-	f.mu.Lock()
-	defer f.mu.Unlock()
-	entryID, err := f.cron.AddJob(schedule, cwoc)
+	cronSchedule, err := cron.ParseStandard(schedule)
 	if err != nil {
 		return nil, err
 	}
+	return f.AddScheduledJob(key, cronSchedule, cwoc), nil
+}
+
+// AddScheduledJob is AddJob for a schedule that is not a cron expression, e.g. a schedule window
+func (f *cronFacade) AddScheduledJob(key string, schedule cron.Schedule, cwoc *cronWfOperationCtx) ScheduledTimeFunc {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	entryID := f.cron.Schedule(schedule, cwoc)
 	f.entryIDs[key] = append(f.entryIDs[key], entryID)
 
 	// Return a function to return the last scheduled time.
@@ -70,7 +76,7 @@ func (f *cronFacade) AddJob(key, schedule string, cwoc *cronWfOperationCtx) (Sch
 			}
 		}
 		return t
-	}, nil
+	}
 }
 
 func (f *cronFacade) Load(key string) ([]*cronWfOperationCtx, error) {
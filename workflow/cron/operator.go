@@ -2,9 +2,15 @@ package cron
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Knetic/govaluate"
@@ -15,10 +21,13 @@ import (
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
 
 	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
-
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	typed "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
@@ -30,6 +39,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/informer"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
@@ -37,6 +47,9 @@ import (
 
 const (
 	variablePrefix string = `cronworkflow`
+	// neverFiresLookahead is how far ahead a schedule must fire for it to not be reported as never firing. Schedules
+	// such as `0 0 29 2 *` only fire every four years.
+	neverFiresLookahead = 5 * 366 * 24 * time.Hour
 )
 
 type cronWfOperationCtx struct {
@@ -44,6 +57,7 @@ type cronWfOperationCtx struct {
 	name            string
 	cronWf          *v1alpha1.CronWorkflow
 	wfClientset     versioned.Interface
+	kubeClient      kubernetes.Interface
 	wfClient        typed.WorkflowInterface
 	wfDefaults      *v1alpha1.Workflow
 	cronWfIf        typed.CronWorkflowInterface
@@ -51,18 +65,120 @@ type cronWfOperationCtx struct {
 	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer
 	log             *log.Entry
 	metrics         *metrics.Metrics
+	eventRecorder   record.EventRecorder
+	// entrypoint, if set, is used to check that the image of the entrypoint template can be resolved
+	entrypoint entrypoint.Interface
+	// mutators are applied to the Workflow before it is submitted
+	mutators []util.WorkflowMutator
+	// defaultTimezone is the timezone of the CronWorkflow's namespace, used if the CronWorkflow does not set one
+	defaultTimezone string
+	// clock is the source of the current time for scheduling decisions
+	clock clock.PassiveClock
 	// scheduledTimeFunc returns the last scheduled time when it is called
 	scheduledTimeFunc ScheduledTimeFunc
+	// runMu serializes Run, which is invoked once per schedule when several schedules fire at the same time
+	runMu sync.Mutex
+	// lastRunTime is the scheduled time of the last Run, used to only run once for simultaneous schedules
+	lastRunTime time.Time
+	// persistedActivePhases are the status.activePhases last read from the API server, so that a merge patch can
+	// remove the entries that are no longer active
+	persistedActivePhases map[string]v1alpha1.WorkflowPhase
+	// skipNextCleared is set once the run skip-next asked to skip has been skipped, so that persistUpdate removes it
+	skipNextCleared bool
+	// missedDeadlines, if set, is shared by the controller's operation contexts so that each missed execution is only
+	// reported once, rather than on every sync
+	missedDeadlines *missedDeadlines
+	// checkedSpecs, if set, is shared by the controller's operation contexts so that the entrypoint and schedules are
+	// only checked again once the spec changes, rather than on every sync
+	checkedSpecs *checkedSpecs
+}
+
+// missedDeadlines records the latest missed execution reported for each CronWorkflow, keyed by namespace/name
+type missedDeadlines struct {
+	lock  sync.Mutex
+	times map[string]time.Time
+}
+
+func newMissedDeadlines() *missedDeadlines {
+	return &missedDeadlines{times: map[string]time.Time{}}
+}
+
+// report returns true if missedExecutionTime has not been reported for key yet, and records it. Missed executions are
+// found after the last scheduled time, so an execution at or before the last one reported has already been reported.
+func (m *missedDeadlines) report(key string, missedExecutionTime time.Time) bool {
+	if m == nil {
+		return true
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if last, ok := m.times[key]; ok && !missedExecutionTime.After(last) {
+		return false
+	}
+	m.times[key] = missedExecutionTime
+	return true
+}
+
+// forget removes the record of the CronWorkflow, once it is deleted
+func (m *missedDeadlines) forget(key string) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.times, key)
+}
+
+// checkedSpecs records the hash of the spec each CronWorkflow was last checked for, keyed by namespace/name. The
+// CronWorkflow has no status subresource, so its generation is bumped by status updates too and cannot be used instead.
+type checkedSpecs struct {
+	lock   sync.Mutex
+	hashes map[string]string
+}
+
+func newCheckedSpecs() *checkedSpecs {
+	return &checkedSpecs{hashes: map[string]string{}}
 }
 
-func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface,
+// changed returns true if the spec with hash has not been checked for key yet
+func (c *checkedSpecs) changed(key, hash string) bool {
+	if c == nil {
+		return true
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.hashes[key] != hash
+}
+
+// record records that the spec with hash has been checked for key
+func (c *checkedSpecs) record(key, hash string) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.hashes[key] = hash
+}
+
+// forget removes the record of the CronWorkflow, once it is deleted
+func (c *checkedSpecs) forget(key string) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.hashes, key)
+}
+
+func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, kubeClient kubernetes.Interface,
 	metrics *metrics.Metrics, wftmplInformer wfextvv1alpha1.WorkflowTemplateInformer,
-	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, wfDefaults *v1alpha1.Workflow,
+	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, wfDefaults *v1alpha1.Workflow, eventRecorder record.EventRecorder,
+	entrypointIndex entrypoint.Interface,
 ) *cronWfOperationCtx {
-	return &cronWfOperationCtx{
+	woc := &cronWfOperationCtx{
 		name:            cronWorkflow.Name,
 		cronWf:          cronWorkflow,
 		wfClientset:     wfClientset,
+		kubeClient:      kubeClient,
 		wfClient:        wfClientset.ArgoprojV1alpha1().Workflows(cronWorkflow.Namespace),
 		wfDefaults:      wfDefaults,
 		cronWfIf:        wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
@@ -72,21 +188,35 @@ func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset vers
 			"workflow":  cronWorkflow.Name,
 			"namespace": cronWorkflow.Namespace,
 		}),
-		metrics: metrics,
-		// inferScheduledTime returns an inferred scheduled time based on the current time and only works if it is called
-		// within 59 seconds of the scheduled time. Here it acts as a placeholder until it is replaced by a similar
-		// function that returns the last scheduled time deterministically from the cron engine. Since we are only able
-		// to generate the latter function after the job is scheduled, there is a tiny chance that the job is run before
-		// the deterministic function is supplanted. If that happens, we use the infer function as the next-best thing
-		scheduledTimeFunc: inferScheduledTime,
+		metrics:               metrics,
+		eventRecorder:         eventRecorder,
+		entrypoint:            entrypointIndex,
+		clock:                 clock.RealClock{},
+		persistedActivePhases: maps.Clone(cronWorkflow.Status.ActivePhases),
 	}
+	// inferScheduledTime returns an inferred scheduled time based on the current time and only works if it is called
+	// within 59 seconds of the scheduled time. Here it acts as a placeholder until it is replaced by a similar
+	// function that returns the last scheduled time deterministically from the cron engine. Since we are only able
+	// to generate the latter function after the job is scheduled, there is a tiny chance that the job is run before
+	// the deterministic function is supplanted. If that happens, we use the infer function as the next-best thing
+	woc.scheduledTimeFunc = woc.inferScheduledTime
+	return woc
 }
 
 // Run handles the running of a cron workflow
-// It fits the github.com/robfig/cron.Job interface
+// It fits the github.com/robfig/cron.Job interface. Every schedule of the CronWorkflow is registered with the same
+// operation context, so when several schedules fire at the same time only the first Run submits a Workflow.
 func (woc *cronWfOperationCtx) Run() {
 	ctx := context.Background()
-	woc.run(ctx, woc.scheduledTimeFunc())
+	woc.runMu.Lock()
+	defer woc.runMu.Unlock()
+	scheduledTime := woc.scheduledTimeFunc()
+	if scheduledTime.Equal(woc.lastRunTime) {
+		woc.log.Infof("%s has already run for %s", woc.name, scheduledTime)
+		return
+	}
+	woc.lastRunTime = scheduledTime
+	woc.run(ctx, scheduledTime)
 }
 
 func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Time) {
@@ -104,17 +234,13 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		return
 	}
 
-	completed, err := woc.checkStopingCondition()
-	if err != nil {
-		woc.reportCronWorkflowError(ctx, v1alpha1.ConditionTypeSpecError, fmt.Sprintf("failed to check CronWorkflow '%s' stopping condition: %s", woc.cronWf.Name, err))
-		return
-	} else if completed {
-		woc.setAsCompleted()
+	if completed, reason := woc.checkStopingCondition(); completed {
+		woc.setAsCompleted(reason)
 	}
 
 	proceed, err := woc.enforceRuntimePolicy(ctx)
 	if err != nil {
-		woc.reportCronWorkflowError(ctx, v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("run policy error: %s", err))
+		woc.reportSubmissionError(ctx, "run policy error", err)
 		return
 	} else if !proceed {
 		return
@@ -122,7 +248,36 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 
 	woc.metrics.CronWfTrigger(ctx, woc.name, woc.cronWf.Namespace)
 
-	wf := common.ConvertCronWorkflowToWorkflowWithProperties(woc.cronWf, getChildWorkflowName(woc.cronWf.Name, scheduledRuntime), scheduledRuntime)
+	// The run is attributed to the schedule that fires at the scheduled time. If none does, e.g. because the scheduled
+	// time was inferred, it is attributed to all of them as a comma separated list.
+	schedule, i, matched := woc.scheduleSpec().MatchScheduleAt(ctx, scheduledRuntime)
+	runSchedule := woc.cronWf.Spec.GetScheduleString()
+	if matched {
+		runSchedule = woc.cronWf.Spec.GetSchedulesWithTimezone(ctx)[i]
+	} else {
+		schedule = runSchedule
+	}
+	wf, err := common.BuildWorkflow(woc.cronWf, scheduledRuntime, schedule)
+	if err != nil {
+		woc.reportCronWorkflowError(ctx, v1alpha1.ConditionTypeSpecError, fmt.Sprintf("Failed to build Workflow: %s", err))
+		return
+	}
+	if matched {
+		wf.Annotations[common.AnnotationKeyCronWfSchedule] = runSchedule
+	} else {
+		// the annotation is reserved, but the CronWorkflow may predate that
+		delete(wf.Annotations, common.AnnotationKeyCronWfSchedule)
+	}
+
+	if err := util.MutateWorkflow(wf, woc.mutators...); err != nil {
+		woc.reportSubmissionError(ctx, "Failed to mutate Workflow", err)
+		return
+	}
+
+	if err := woc.validateTemplatedEntrypoint(ctx, wf); err != nil {
+		woc.reportSubmissionError(ctx, "Failed to submit Workflow", err)
+		return
+	}
 
 	runWf, err := util.SubmitWorkflow(ctx, woc.wfClient, woc.wfClientset, woc.cronWf.Namespace, wf, woc.wfDefaults, &v1alpha1.SubmitOpts{})
 	if err != nil {
@@ -130,14 +285,20 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		if errors.IsAlreadyExists(err) {
 			return
 		}
-		woc.reportCronWorkflowError(ctx, v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to submit Workflow: %s", err))
+		woc.reportSubmissionError(ctx, "Failed to submit Workflow", err)
 		return
 	}
 
-	woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(wf, runWf))
+	woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonScheduled, fmt.Sprintf("Scheduled Workflow %s", runWf.Name))
+	woc.cronWf.Status.AddActive(getWorkflowObjectReference(wf, runWf))
+	woc.cronWf.Status.SetActivePhase(runWf.UID, runWf.Status.Phase)
+	woc.metrics.CronWfActive(woc.name, woc.cronWf.Namespace, int64(len(woc.cronWf.Status.Active)))
 	woc.cronWf.Status.Phase = v1alpha1.ActivePhase
-	woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+	// a scheduled time that was inferred rather than taken from the cron engine may not be on a fire boundary
+	woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: woc.cronWf.Spec.TruncateToScheduleResolution(scheduledRuntime)}
+	woc.cronWf.Status.LastRunSchedule = runSchedule
 	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
+	woc.cronWf.Status.SubmissionErrorRetryable = false
 }
 
 func (woc *cronWfOperationCtx) validateCronWorkflow(ctx context.Context) error {
@@ -165,12 +326,169 @@ func getWorkflowObjectReference(wf *v1alpha1.Workflow, runWf *v1alpha1.Workflow)
 	}
 }
 
+// checkSpec runs validateEntrypoint and checkNeverFires if the spec, with its effective timezone, has changed since
+// they were last run. A lookup of the entrypoint that may succeed if retried is retried on the next sync.
+func (woc *cronWfOperationCtx) checkSpec(ctx context.Context) {
+	key := woc.cronWf.Namespace + "/" + woc.cronWf.Name
+	data, err := json.Marshal(woc.scheduleSpec())
+	if err != nil {
+		woc.log.WithError(err).Warn("failed to marshal spec")
+		return
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	if !woc.checkedSpecs.changed(key, hash) {
+		return
+	}
+	checked := woc.validateEntrypoint(ctx)
+	woc.checkNeverFires(ctx)
+	if checked {
+		woc.checkedSpecs.record(key, hash)
+	}
+}
+
+// checkNeverFires sets or clears ConditionTypeNeverFires, depending on whether any of the schedules never fires, and
+// persists the conditions if they changed. Schedules that fail to parse are reported by validateCronWorkflow instead.
+func (woc *cronWfOperationCtx) checkNeverFires(ctx context.Context) {
+	conditions := slices.Clone(woc.cronWf.Status.Conditions)
+	unschedulable, err := woc.scheduleSpec().UnschedulableSchedules(ctx, woc.now(), neverFiresLookahead)
+	if err != nil {
+		return
+	}
+	if len(unschedulable) > 0 {
+		woc.log.WithField("schedules", unschedulable).Warn("schedules never fire")
+		woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+			Type:    v1alpha1.ConditionTypeNeverFires,
+			Message: fmt.Sprintf("schedules never fire: %s", strings.Join(unschedulable, ", ")),
+			Status:  v1.ConditionTrue,
+		})
+	} else {
+		woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeNeverFires)
+	}
+	if slices.Equal(conditions, woc.cronWf.Status.Conditions) {
+		return
+	}
+	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"conditions": woc.cronWf.Status.Conditions}})
+}
+
+// validateEntrypoint checks that the entrypoint/cmd of the entrypoint template's container image can be resolved, so
+// that a bad image or registry is reported before the first scheduled run rather than when it fails. It sets or clears
+// ConditionTypeSubmissionError and persists the conditions if they changed. It is a no-op unless an index is set,
+// because resolving the image needs access to the registry. Images templated with {{cronworkflow.*}} variables are
+// only known when a Workflow is built, so they are looked up by run instead. It returns false if the lookup failed but
+// may succeed if retried, e.g. because the registry rate limited it.
+func (woc *cronWfOperationCtx) validateEntrypoint(ctx context.Context) bool {
+	if woc.entrypoint == nil {
+		return true
+	}
+	spec := &woc.cronWf.Spec.WorkflowSpec
+	if meta := woc.cronWf.Spec.WorkflowMetadata; meta != nil && entrypoint.ResolutionDisabled(*meta) {
+		return true
+	}
+	tmpl := entrypointTemplate(spec)
+	if tmpl == nil || strings.Contains(tmpl.Container.Image, "{{") {
+		return true
+	}
+	conditions := slices.Clone(woc.cronWf.Status.Conditions)
+	retryable := woc.cronWf.Status.SubmissionErrorRetryable
+	err := woc.lookupEntrypoint(ctx, spec, tmpl)
+	if err != nil {
+		woc.log.WithError(err).Warn("failed to look-up entrypoint/cmd for image")
+		woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+			Type:    v1alpha1.ConditionTypeSubmissionError,
+			Message: fmt.Sprintf("failed to look-up entrypoint/cmd for image %q: %v", tmpl.Container.Image, err),
+			Status:  v1.ConditionTrue,
+		})
+		// a rate limit goes away once the index has backed off
+		woc.cronWf.Status.SubmissionErrorRetryable = entrypoint.IsRateLimited(err)
+	} else {
+		woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
+		woc.cronWf.Status.SubmissionErrorRetryable = false
+	}
+	if !slices.Equal(conditions, woc.cronWf.Status.Conditions) || retryable != woc.cronWf.Status.SubmissionErrorRetryable {
+		woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"conditions": woc.cronWf.Status.Conditions, "submissionErrorRetryable": woc.cronWf.Status.SubmissionErrorRetryable}})
+	}
+	return err == nil || !isRetryableSubmissionError(err)
+}
+
+// validateTemplatedEntrypoint checks that the entrypoint/cmd of the entrypoint template's container image in wf can
+// be resolved, if the image was templated when wf was built, so that a Workflow is not submitted with an image that
+// does not exist. It is a no-op unless an index is set.
+func (woc *cronWfOperationCtx) validateTemplatedEntrypoint(ctx context.Context, wf *v1alpha1.Workflow) error {
+	if woc.entrypoint == nil {
+		return nil
+	}
+	if entrypoint.ResolutionDisabled(wf.ObjectMeta) {
+		return nil
+	}
+	tmpl := entrypointTemplate(&wf.Spec)
+	if tmpl == nil || strings.Contains(tmpl.Container.Image, "{{") {
+		return nil
+	}
+	if original := entrypointTemplate(&woc.cronWf.Spec.WorkflowSpec); original != nil && original.Container.Image == tmpl.Container.Image {
+		return nil
+	}
+	if err := woc.lookupEntrypoint(ctx, &wf.Spec, tmpl); err != nil {
+		return fmt.Errorf("failed to look-up entrypoint/cmd for image %q: %w", tmpl.Container.Image, err)
+	}
+	return nil
+}
+
+// entrypointTemplate returns spec's entrypoint template if it is a container template whose image's entrypoint/cmd
+// must be looked up, or nil otherwise. It is nil if resolution is disabled by the template's or the Workflow's pod
+// metadata, as the controller would not look it up.
+func entrypointTemplate(spec *v1alpha1.WorkflowSpec) *v1alpha1.Template {
+	i := slices.IndexFunc(spec.Templates, func(t v1alpha1.Template) bool { return t.Name == spec.Entrypoint })
+	if i < 0 || spec.Templates[i].Container == nil || !entrypoint.NeedsLookup(*spec.Templates[i].Container) {
+		return nil
+	}
+	if entrypoint.ResolutionDisabled(v1.ObjectMeta{Annotations: spec.Templates[i].Metadata.Annotations}) ||
+		(spec.PodMetadata != nil && entrypoint.ResolutionDisabled(v1.ObjectMeta{Annotations: spec.PodMetadata.Annotations})) {
+		return nil
+	}
+	return &spec.Templates[i]
+}
+
+func (woc *cronWfOperationCtx) lookupEntrypoint(ctx context.Context, spec *v1alpha1.WorkflowSpec, tmpl *v1alpha1.Template) error {
+	serviceAccountName := spec.ServiceAccountName
+	if tmpl.ServiceAccountName != "" {
+		serviceAccountName = tmpl.ServiceAccountName
+	}
+	_, err := woc.entrypoint.Lookup(ctx, tmpl.Container.Image, entrypoint.Options{
+		Namespace:          woc.cronWf.Namespace,
+		ServiceAccountName: serviceAccountName,
+		ImagePullSecrets:   spec.ImagePullSecrets,
+	})
+	return err
+}
+
 func (woc *cronWfOperationCtx) persistUpdate(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": woc.cronWf.Status, "metadata": map[string]interface{}{"annotations": woc.cronWf.Annotations, "labels": woc.cronWf.Labels}})
+	data, err := json.Marshal(woc.cronWf.Status)
+	if err != nil {
+		woc.log.WithError(err).Error("failed to marshall cron workflow status")
+		return
+	}
+	status := map[string]interface{}{}
+	if err := json.Unmarshal(data, &status); err != nil {
+		woc.log.WithError(err).Error("failed to unmarshall cron workflow status")
+		return
+	}
+	status["activePhases"] = woc.activePhasesPatch()
+	// a merge patch only clears the flag if it is explicitly false
+	status["submissionErrorRetryable"] = woc.cronWf.Status.SubmissionErrorRetryable
+	annotations := map[string]interface{}{}
+	for k, v := range woc.cronWf.Annotations {
+		annotations[k] = v
+	}
+	if woc.skipNextCleared {
+		// a merge patch only removes the annotation if it is explicitly null
+		annotations[v1alpha1.AnnotationKeySkipNext] = nil
+	}
+	woc.patch(ctx, map[string]interface{}{"status": status, "metadata": map[string]interface{}{"annotations": annotations, "labels": woc.cronWf.Labels}})
 }
 
 func (woc *cronWfOperationCtx) persistCurrentWorkflowStatus(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active, "succeeded": woc.cronWf.Status.Succeeded, "failed": woc.cronWf.Status.Failed, "phase": woc.cronWf.Status.Phase}})
+	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active, "succeeded": woc.cronWf.Status.Succeeded, "failed": woc.cronWf.Status.Failed, "phase": woc.cronWf.Status.Phase, "stoppedAt": woc.cronWf.Status.StoppedAt, "stoppedReason": woc.cronWf.Status.StoppedReason, "released": woc.cronWf.Status.Released, "conditions": woc.cronWf.Status.Conditions, "submissionErrorRetryable": woc.cronWf.Status.SubmissionErrorRetryable, "activePhases": woc.activePhasesPatch()}, "metadata": map[string]interface{}{"labels": woc.cronWf.Labels}})
 }
 
 func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]interface{}) {
@@ -185,6 +503,7 @@ func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]inter
 			return !errorsutil.IsTransientErr(err), err
 		}
 		woc.cronWf = cronWf
+		woc.persistedActivePhases = maps.Clone(cronWf.Status.ActivePhases)
 		return true, nil
 	})
 	if err != nil {
@@ -193,6 +512,41 @@ func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]inter
 	}
 }
 
+// activePhasesPatch returns the merge patch of status.activePhases. Entries that were persisted but are no longer
+// active are set to null, because a merge patch merges maps rather than replacing them.
+func (woc *cronWfOperationCtx) activePhasesPatch() map[string]interface{} {
+	patch := map[string]interface{}{}
+	for uid := range woc.persistedActivePhases {
+		patch[uid] = nil
+	}
+	for uid, phase := range woc.cronWf.Status.ActivePhases {
+		patch[uid] = phase
+	}
+	if len(patch) == 0 {
+		// rather than creating an empty map
+		return nil
+	}
+	return patch
+}
+
+// scheduleSpec returns the CronWorkflow's spec with its effective timezone, i.e. the namespace's default if it does not
+// set one, to compute when its schedules fire. The default is never written to the CronWorkflow itself, so that it does
+// not leak into the Workflows' annotations or the status, and changing it is not mistaken for a new schedule.
+func (woc *cronWfOperationCtx) scheduleSpec() *v1alpha1.CronWorkflowSpec {
+	spec := woc.cronWf.Spec
+	spec.Timezone = spec.ResolveTimezone(woc.defaultTimezone)
+	return &spec
+}
+
+// scheduleWindow returns the schedule for Spec.ScheduleWindow in the effective timezone, see
+// CronWorkflow.GetScheduleWindow
+func (woc *cronWfOperationCtx) scheduleWindow() (cron.Schedule, error) {
+	if woc.cronWf.Spec.ScheduleWindow == nil {
+		return nil, nil
+	}
+	return v1alpha1.ParseScheduleWindow(*woc.cronWf.Spec.ScheduleWindow, woc.scheduleSpec().Timezone, string(woc.cronWf.UID))
+}
+
 // TODO: refactor shouldExecute in steps.go
 func shouldExecute(when string) (bool, error) {
 	if when == "" {
@@ -215,7 +569,22 @@ func shouldExecute(when string) (bool, error) {
 	return boolRes, nil
 }
 
-func evalWhen(cron *v1alpha1.CronWorkflow) (bool, error) {
+func evalWhen(cron *v1alpha1.CronWorkflow, data map[string]string) (bool, error) {
+	return evalWhenAt(cron, data, nil)
+}
+
+// WhenFilter returns a filter for CronWorkflowSpec.NextRunTimes that evaluates the CronWorkflow's when expression as if
+// it were each time, i.e. now() returns that time. The other variables, e.g. cronworkflow.lastScheduledTime and
+// cronworkflow.succeeded, are from the CronWorkflow's current status, and are not updated for the earlier times, and
+// data is used for cronworkflow.data.
+func WhenFilter(cron *v1alpha1.CronWorkflow, data map[string]string) v1alpha1.RunTimeFilter {
+	return func(t time.Time) (bool, error) {
+		return evalWhenAt(cron, data, func() time.Time { return t })
+	}
+}
+
+// evalWhenAt evaluates the when expression, with now() replaced by now if it is set
+func evalWhenAt(cron *v1alpha1.CronWorkflow, data map[string]string, now func() time.Time) (bool, error) {
 	if cron.Spec.When == "" {
 		return true, nil
 	}
@@ -232,6 +601,23 @@ func evalWhen(cron *v1alpha1.CronWorkflow) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	for key, value := range data {
+		addSetField("data."+key, value)
+	}
+	if now != nil {
+		env["now"] = now
+	}
+	// keys missing from the data resolve to empty, so that a flag can be removed without breaking the expression
+	err = template.Validate(cron.Spec.When, func(tag string) error {
+		tag = strings.TrimSpace(tag)
+		if _, ok := env[tag]; !ok && strings.HasPrefix(tag, variablePrefix+".data.") {
+			env[tag] = ""
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
 	newWhenStr, err := t.Replace(env, false)
 	if err != nil {
 		return false, err
@@ -242,68 +628,213 @@ func evalWhen(cron *v1alpha1.CronWorkflow) (bool, error) {
 	return shouldExecute(newCron.Spec.When)
 }
 
+// getWhenData returns the data of the ConfigMap referenced by Spec.WhenData, if any
+func (woc *cronWfOperationCtx) getWhenData(ctx context.Context) (map[string]string, error) {
+	ref := woc.cronWf.Spec.WhenData
+	if ref == nil {
+		return nil, nil
+	}
+	cm, err := woc.kubeClient.CoreV1().ConfigMaps(woc.cronWf.Namespace).Get(ctx, ref.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get when data ConfigMap %q: %w", ref.Name, err)
+	}
+	return cm.Data, nil
+}
+
+// isFrozen returns true if the ConfigMap key referenced by Spec.Freeze is true. A missing ConfigMap is an error unless
+// the reference is optional, in which case it is not frozen.
+func (woc *cronWfOperationCtx) isFrozen(ctx context.Context) (bool, error) {
+	ref := woc.cronWf.Spec.Freeze
+	if ref == nil {
+		return false, nil
+	}
+	cm, err := woc.kubeClient.CoreV1().ConfigMaps(woc.cronWf.Namespace).Get(ctx, ref.Name, v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) && ref.Optional != nil && *ref.Optional {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get freeze ConfigMap %q: %w", ref.Name, err)
+	}
+	return woc.cronWf.Spec.IsFrozen(cm.Data), nil
+}
+
 func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context) (bool, error) {
 	if woc.cronWf.Spec.Suspend {
 		woc.log.Infof("%s is suspended, skipping execution", woc.name)
+		woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonSuspended)
 		return false, nil
 	}
 
 	if woc.cronWf.Status.Phase == v1alpha1.StoppedPhase {
 		woc.log.Infof("CronWorkflow %s is marked as stopped since it achieved the stopping condition", woc.cronWf.Name)
+		woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonStopped)
 		return false, nil
 	}
 
-	canProceed, err := evalWhen(woc.cronWf)
-	if err != nil || !canProceed {
-		return canProceed, err
+	frozen, err := woc.isFrozen(ctx)
+	if err != nil {
+		return false, err
+	} else if frozen {
+		woc.log.Infof("%s is frozen by ConfigMap %q, skipping execution", woc.name, woc.cronWf.Spec.Freeze.Name)
+		woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonSkipped, fmt.Sprintf("Run skipped because scheduling is frozen by ConfigMap %q", woc.cronWf.Spec.Freeze.Name))
+		woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonFrozen)
+		return false, nil
+	}
+
+	// Active Workflows count towards MaxRuns, so that it is not exceeded while the last of them are still running
+	status := woc.cronWf.Status
+	if maxRuns := woc.cronWf.Spec.MaxRuns; maxRuns != nil && status.Succeeded+status.Failed+int64(len(status.Active)) >= *maxRuns {
+		woc.log.Infof("%s has already started its maxRuns of %d Workflows so it was not run", woc.name, *maxRuns)
+		woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonSkipped, "Run skipped because maxRuns Workflows have already been started")
+		woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonMaxRuns)
+		return false, nil
+	}
+
+	data, err := woc.getWhenData(ctx)
+	if err != nil {
+		return false, err
+	}
+	canProceed, err := evalWhen(woc.cronWf, data)
+	if err != nil {
+		return false, err
+	} else if !canProceed {
+		woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonSkipped, "Run skipped because the when expression is false")
+		woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonWhen)
+		return false, nil
 	}
 
+	replace := false
 	if woc.cronWf.Spec.ConcurrencyPolicy != "" {
 		switch woc.cronWf.Spec.ConcurrencyPolicy {
 		case v1alpha1.AllowConcurrent, "":
 			// Do nothing
 		case v1alpha1.ForbidConcurrent:
-			if len(woc.cronWf.Status.Active) > 0 {
+			if len(woc.cronWf.Status.ConcurrencyActive()) > 0 {
 				woc.metrics.CronWfPolicy(ctx, woc.name, woc.cronWf.Namespace, v1alpha1.ForbidConcurrent)
+				woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonConcurrencyPolicy)
 				woc.log.Infof("%s has 'ConcurrencyPolicy: Forbid' and has an active Workflow so it was not run", woc.name)
+				woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonSkipped, "Run skipped because of 'ConcurrencyPolicy: Forbid' and an active Workflow")
 				return false, nil
 			}
 		case v1alpha1.ReplaceConcurrent:
-			if len(woc.cronWf.Status.Active) > 0 {
+			if len(woc.cronWf.Status.ConcurrencyActive()) > 0 {
 				woc.metrics.CronWfPolicy(ctx, woc.name, woc.cronWf.Namespace, v1alpha1.ReplaceConcurrent)
-				woc.log.Infof("%s has 'ConcurrencyPolicy: Replace' and has active Workflows", woc.name)
-				err := woc.terminateOutstandingWorkflows(ctx)
+				replaceable, err := woc.activeWorkflowsReplaceable(ctx)
 				if err != nil {
 					return false, err
+				} else if !replaceable {
+					woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonConcurrencyPolicy)
+					woc.log.Infof("%s has 'ConcurrencyPolicy: Replace' and an active Workflow within the replace grace period so it was not run", woc.name)
+					woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonSkipped, "Run skipped because of 'ConcurrencyPolicy: Replace' and an active Workflow within the replace grace period")
+					return false, nil
 				}
+				replace = true
 			}
 		default:
 			return false, fmt.Errorf("invalid ConcurrencyPolicy: %s", woc.cronWf.Spec.ConcurrencyPolicy)
 		}
 	}
+
+	// skip-next is checked last, so that it is only cleared by a run that would otherwise have been submitted
+	if woc.cronWf.ShouldSkipNext() {
+		woc.log.Infof("%s has the %s annotation, skipping execution", woc.name, v1alpha1.AnnotationKeySkipNext)
+		woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonSkipped, fmt.Sprintf("Run skipped because of the %s annotation", v1alpha1.AnnotationKeySkipNext))
+		woc.metrics.CronWfSkipped(ctx, woc.name, woc.cronWf.Namespace, metrics.CronWorkflowSkipReasonSkipNext)
+		woc.cronWf.ClearSkipNext()
+		woc.skipNextCleared = true
+		return false, nil
+	}
+
+	if replace {
+		woc.log.Infof("%s has 'ConcurrencyPolicy: Replace' and has active Workflows", woc.name)
+		err = woc.terminateOutstandingWorkflows(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
 	return true, nil
 }
 
-func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context) error {
-	for _, wfObjectRef := range woc.cronWf.Status.Active {
-		woc.log.Infof("stopping '%s'", wfObjectRef.Name)
-		err := util.TerminateWorkflow(ctx, woc.wfClient, wfObjectRef.Name)
+// activeWorkflowsReplaceable returns false if any of the active Workflows that count towards the concurrency policy
+// started within the replace grace period. Workflows that no longer exist may be replaced.
+func (woc *cronWfOperationCtx) activeWorkflowsReplaceable(ctx context.Context) (bool, error) {
+	if woc.cronWf.Spec.ReplaceGracePeriod == nil {
+		return true, nil
+	}
+	for _, wfObjectRef := range woc.cronWf.Status.ConcurrencyActive() {
+		wf, err := woc.wfClient.Get(ctx, wfObjectRef.Name, v1.GetOptions{})
 		if err != nil {
 			if errors.IsNotFound(err) {
-				woc.log.Warnf("workflow %q not found when trying to terminate outstanding workflows", wfObjectRef.Name)
-				continue
-			}
-			alreadyShutdownErr, ok := err.(util.AlreadyShutdownError)
-			if ok {
-				woc.log.Warn(alreadyShutdownErr.Error())
 				continue
 			}
-			return fmt.Errorf("error stopping workflow %s: %e", wfObjectRef.Name, err)
+			return false, fmt.Errorf("failed to get active Workflow '%s': %w", wfObjectRef.Name, err)
+		}
+		start := wf.Status.StartedAt.Time
+		if start.IsZero() {
+			start = wf.CreationTimestamp.Time
+		}
+		if !woc.cronWf.Spec.ShouldReplace(start, woc.now()) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// terminateOutstandingWorkflows terminates the active workflows that count towards the concurrency policy, so not those
+// released by suspending the CronWorkflow
+func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context) error {
+	for _, wfObjectRef := range woc.cronWf.Status.ConcurrencyActive() {
+		if err := woc.terminateWorkflow(ctx, wfObjectRef); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// terminateStuckWorkflows terminates the active workflows that have exceeded the CronWorkflow's active deadline. They
+// stay active until they are fulfilled, so workflows that are already shutting down are skipped.
+func (woc *cronWfOperationCtx) terminateStuckWorkflows(ctx context.Context, workflows []v1alpha1.Workflow) error {
+	if woc.cronWf.Spec.ActiveDeadlineSeconds == nil {
+		return nil
+	}
+	starts := make(map[types.UID]time.Time, len(workflows))
+	for _, wf := range workflows {
+		if wf.Spec.Shutdown != "" {
+			continue
+		}
+		start := wf.Status.StartedAt.Time
+		if start.IsZero() {
+			start = wf.CreationTimestamp.Time
+		}
+		starts[wf.UID] = start
+	}
+	for _, wfObjectRef := range woc.cronWf.StuckActive(woc.now(), starts) {
+		woc.recordEvent(corev1.EventTypeWarning, v1alpha1.CronWorkflowEventReasonActiveDeadlineExceeded, fmt.Sprintf("Terminating Workflow %s because it exceeded the active deadline", wfObjectRef.Name))
+		if err := woc.terminateWorkflow(ctx, wfObjectRef); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (woc *cronWfOperationCtx) terminateWorkflow(ctx context.Context, wfObjectRef corev1.ObjectReference) error {
+	woc.log.Infof("stopping '%s'", wfObjectRef.Name)
+	err := util.TerminateWorkflow(ctx, woc.wfClient, wfObjectRef.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			woc.log.Warnf("workflow %q not found when trying to terminate outstanding workflows", wfObjectRef.Name)
+			return nil
+		}
+		alreadyShutdownErr, ok := err.(util.AlreadyShutdownError)
+		if ok {
+			woc.log.Warn(alreadyShutdownErr.Error())
+			return nil
+		}
+		return fmt.Errorf("error stopping workflow %s: %e", wfObjectRef.Name, err)
+	}
+	return nil
+}
+
 func (woc *cronWfOperationCtx) runOutstandingWorkflows(ctx context.Context) (bool, error) {
 	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
 	if err != nil {
@@ -323,36 +854,56 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun(ctx context.Conte
 	}
 	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
 	if woc.cronWf.Status.LastScheduledTime != nil {
-		for _, schedule := range woc.cronWf.Spec.GetSchedulesWithTimezone(ctx) {
-			var now time.Time
-			var cronSchedule cron.Schedule
-			now = time.Now()
-			cronSchedule, err := cron.ParseStandard(schedule)
-			if err != nil {
-				return time.Time{}, err
-			}
+		spec := woc.scheduleSpec()
+		schedules := spec.GetSchedules(ctx)
+		cronSchedules, err := parsedSchedules(ctx, spec)
+		if err != nil {
+			return time.Time{}, err
+		}
+		for i, cronSchedule := range cronSchedules {
+			now := woc.now()
 
-			var missedExecutionTime time.Time
-			nextScheduledRunTime := cronSchedule.Next(woc.cronWf.Status.LastScheduledTime.Time)
-			// Workflow should have ran
-			for nextScheduledRunTime.Before(now) {
-				missedExecutionTime = nextScheduledRunTime
-				nextScheduledRunTime = cronSchedule.Next(missedExecutionTime)
-			}
+			missedExecutionTime := lastScheduledTimeBefore(cronSchedule, woc.cronWf.Status.LastScheduledTime.Time, now)
 
 			// We missed the latest execution time
 			if !missedExecutionTime.IsZero() {
 				// if missedExecutionTime is within StartDeadlineSeconds, We are still within the deadline window, run the Workflow
-				if woc.cronWf.Spec.StartingDeadlineSeconds != nil && now.Before(missedExecutionTime.Add(time.Duration(*woc.cronWf.Spec.StartingDeadlineSeconds)*time.Second)) {
+				deadline, ok := spec.StartingDeadlineForSchedule(schedules[i])
+				if !ok && spec.StartingDeadlineFraction != nil {
+					deadline, ok = spec.EffectiveStartingDeadline(missedExecutionTime), true
+				}
+				if !ok {
+					// without a deadline missed executions are never run, which is expected rather than worth a warning
+					woc.log.Infof("%s missed an execution at %s and has no StartingDeadline, so it was not run", woc.cronWf.Name, missedExecutionTime.Format("Mon Jan _2 15:04:05 2006"))
+					continue
+				}
+				if now.Before(missedExecutionTime.Add(deadline)) {
 					woc.log.Infof("%s missed an execution at %s and is within StartingDeadline", woc.cronWf.Name, missedExecutionTime.Format("Mon Jan _2 15:04:05 2006"))
 					return missedExecutionTime, nil
 				}
+				if !woc.missedDeadlines.report(woc.cronWf.Namespace+"/"+woc.cronWf.Name, missedExecutionTime) {
+					continue
+				}
+				lateness := v1alpha1.Lateness(missedExecutionTime, now)
+				woc.recordEvent(corev1.EventTypeWarning, v1alpha1.CronWorkflowEventReasonMissedDeadline, fmt.Sprintf("Missed an execution at %s outside of StartingDeadline: it is %s late and the deadline is %s", missedExecutionTime.Format("Mon Jan _2 15:04:05 2006"), lateness, deadline))
 			}
 		}
 	}
 	return time.Time{}, nil
 }
 
+// lastScheduledTimeBefore returns the latest time the schedule fired after since and before now, or the zero time if
+// it has not fired. It steps forward with Next so that ambiguous and nonexistent wall-clock times around DST transitions
+// resolve exactly as they do when scheduling: a time that occurs twice fires at both instants and a time that does not
+// exist is skipped.
+func lastScheduledTimeBefore(schedule cron.Schedule, since, now time.Time) time.Time {
+	var last time.Time
+	for next := schedule.Next(since); next.Before(now); next = schedule.Next(next) {
+		last = next
+	}
+	return last
+}
+
 type fulfilledWfsPhase struct {
 	fulfilled bool
 	phase     v1alpha1.WorkflowPhase
@@ -368,48 +919,94 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 		}
 		if !woc.cronWf.Status.HasActiveUID(wf.UID) && !wf.Status.Fulfilled() {
 			updated = true
-			woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(&wf, &wf))
+			woc.cronWf.Status.AddActive(getWorkflowObjectReference(&wf, &wf))
+		}
+		if !wf.Status.Fulfilled() && woc.cronWf.Status.SetActivePhase(wf.UID, wf.Status.Phase) {
+			updated = true
 		}
 	}
 
 	for _, objectRef := range woc.cronWf.Status.Active {
 		if fulfilled, found := currentWfsFulfilled[objectRef.UID]; !found || fulfilled.fulfilled {
 			updated = true
-			woc.removeFromActiveList(objectRef.UID)
+			woc.cronWf.Status.RemoveActive(objectRef.UID)
 			if found && fulfilled.fulfilled {
-				woc.updateWfPhaseCounter(fulfilled.phase)
-				completed, err := woc.checkStopingCondition()
-				if err != nil {
-					return fmt.Errorf("failed to check CronWorkflow '%s' stopping condition: %s", woc.cronWf.Name, err)
-				} else if completed {
-					woc.setAsCompleted()
-				}
+				woc.updateWfPhaseCounter(ctx, fulfilled.phase)
 			}
 		}
 	}
 
+	if woc.cronWf.ReleaseActiveIfSuspended() {
+		updated = true
+	}
+
+	if woc.releaseOrphanedActive(ctx, workflows) {
+		updated = true
+	}
+
+	// The stop expression may depend on time rather than on the counters, so it is evaluated on every reconcile rather
+	// than only when a child workflow completes. It is evaluated once all completions have been counted.
+	if woc.cronWf.Status.Phase != v1alpha1.StoppedPhase {
+		conditions := slices.Clone(woc.cronWf.Status.Conditions)
+		if completed, reason := woc.checkStopingCondition(); completed {
+			updated = true
+			woc.setAsCompleted(reason)
+		}
+		if !slices.Equal(conditions, woc.cronWf.Status.Conditions) {
+			updated = true
+		}
+	}
+
 	if updated {
 		woc.persistCurrentWorkflowStatus(ctx)
 	}
+	if woc.metrics != nil {
+		woc.metrics.CronWfActive(woc.name, woc.cronWf.Namespace, int64(len(woc.cronWf.Status.Active)))
+	}
 
 	return nil
 }
 
-func (woc *cronWfOperationCtx) removeFromActiveList(uid types.UID) {
-	var newActive []corev1.ObjectReference
-	for _, ref := range woc.cronWf.Status.Active {
-		if ref.UID != uid {
-			newActive = append(newActive, ref)
+// releaseOrphanedActive releases the active Workflows whose schedule has been removed, so that they run to completion
+// but no longer count towards the ConcurrencyPolicy of the remaining schedules, and sets or clears
+// ConditionTypeOrphanedActive. It returns true if the status changed.
+func (woc *cronWfOperationCtx) releaseOrphanedActive(ctx context.Context, workflows []v1alpha1.Workflow) bool {
+	schedules := make(map[types.UID]string, len(workflows))
+	names := make(map[types.UID]string, len(workflows))
+	for _, wf := range workflows {
+		schedules[wf.UID] = wf.Annotations[common.AnnotationKeyCronWfSchedule]
+		names[wf.UID] = wf.Name
+	}
+	conditions := slices.Clone(woc.cronWf.Status.Conditions)
+	released := len(woc.cronWf.Status.Released)
+	orphaned := woc.cronWf.OrphanedActiveUIDs(ctx, schedules)
+	var orphanedNames []string
+	for _, uid := range orphaned {
+		orphanedNames = append(orphanedNames, names[uid])
+		if !slices.Contains(woc.cronWf.Status.Released, uid) {
+			woc.cronWf.Status.Released = append(slices.Clone(woc.cronWf.Status.Released), uid)
 		}
 	}
-	woc.cronWf.Status.Active = newActive
+	if len(orphaned) > 0 {
+		woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+			Type:    v1alpha1.ConditionTypeOrphanedActive,
+			Message: fmt.Sprintf("the schedules of active Workflows %s were removed, so they no longer count towards the concurrency policy", strings.Join(orphanedNames, ", ")),
+			Status:  v1.ConditionTrue,
+		})
+	} else {
+		woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeOrphanedActive)
+	}
+	return released != len(woc.cronWf.Status.Released) || !slices.Equal(conditions, woc.cronWf.Status.Conditions)
 }
 
 func (woc *cronWfOperationCtx) enforceHistoryLimit(ctx context.Context, workflows []v1alpha1.Workflow) error {
 	woc.log.Debugf("Enforcing history limit for '%s'", woc.cronWf.Name)
 
+	erroredLimit := woc.cronWf.Spec.ErroredJobsHistoryLimit
+	separateErrored := erroredLimit != nil && *erroredLimit >= 0
 	var successfulWorkflows []v1alpha1.Workflow
 	var failedWorkflows []v1alpha1.Workflow
+	var erroredWorkflows []v1alpha1.Workflow
 	for _, wf := range workflows {
 		if wf.Labels[common.LabelKeyCronWorkflow] != woc.cronWf.Name {
 			continue
@@ -417,6 +1014,8 @@ func (woc *cronWfOperationCtx) enforceHistoryLimit(ctx context.Context, workflow
 		if wf.Status.Fulfilled() {
 			if wf.Status.Successful() {
 				successfulWorkflows = append(successfulWorkflows, wf)
+			} else if separateErrored && wf.Status.Phase == v1alpha1.WorkflowError {
+				erroredWorkflows = append(erroredWorkflows, wf)
 			} else {
 				failedWorkflows = append(failedWorkflows, wf)
 			}
@@ -440,6 +1039,13 @@ func (woc *cronWfOperationCtx) enforceHistoryLimit(ctx context.Context, workflow
 	if err != nil {
 		return fmt.Errorf("unable to delete Failed Workflows of CronWorkflow '%s': %s", woc.cronWf.Name, err)
 	}
+
+	if separateErrored {
+		err = woc.deleteOldestWorkflows(ctx, erroredWorkflows, int(*erroredLimit))
+		if err != nil {
+			return fmt.Errorf("unable to delete Errored Workflows of CronWorkflow '%s': %s", woc.cronWf.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -467,23 +1073,75 @@ func (woc *cronWfOperationCtx) deleteOldestWorkflows(ctx context.Context, jobLis
 }
 
 func (woc *cronWfOperationCtx) reportCronWorkflowError(ctx context.Context, conditionType v1alpha1.ConditionType, errString string) {
-	woc.log.WithField("conditionType", conditionType).Error(errString)
-	woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+	woc.reportCronWorkflowCondition(ctx, v1alpha1.Condition{
 		Type:    conditionType,
 		Message: errString,
 		Status:  v1.ConditionTrue,
 	})
+}
+
+// reportSubmissionError reports a ConditionTypeSubmissionError classified by whether retrying may help, which is
+// recorded in Status.SubmissionErrorRetryable. Errors that are not retryable are also recorded as a warning event, as
+// they will not go away until the spec is fixed.
+func (woc *cronWfOperationCtx) reportSubmissionError(ctx context.Context, message string, err error) {
+	errString := fmt.Sprintf("%s: %s", message, err)
+	retryable := isRetryableSubmissionError(err)
+	woc.cronWf.Status.SubmissionErrorRetryable = retryable
+	woc.reportCronWorkflowCondition(ctx, v1alpha1.Condition{
+		Type:    v1alpha1.ConditionTypeSubmissionError,
+		Message: errString,
+		Status:  v1.ConditionTrue,
+	})
+	if !retryable {
+		woc.recordEvent(corev1.EventTypeWarning, v1alpha1.CronWorkflowEventReasonSubmissionFailed, errString)
+	}
+}
+
+// reportCronWorkflowCondition sets the condition and records it in the metrics. A submission error is not counted in
+// Status.Failed, because no Workflow was run, so it does not count towards MaxRuns or the StopStrategy.
+func (woc *cronWfOperationCtx) reportCronWorkflowCondition(ctx context.Context, condition v1alpha1.Condition) {
+	conditionType := condition.Type
+	woc.log.WithField("conditionType", conditionType).Error(condition.Message)
+	woc.cronWf.Status.Conditions.UpsertCondition(condition)
 	if conditionType == v1alpha1.ConditionTypeSpecError {
 		woc.metrics.CronWorkflowSpecError(ctx)
 	} else {
-		if conditionType == v1alpha1.ConditionTypeSubmissionError {
-			woc.cronWf.Status.Failed++
-		}
 		woc.metrics.CronWorkflowSubmissionError(ctx)
 	}
 }
 
-func (woc *cronWfOperationCtx) updateWfPhaseCounter(phase v1alpha1.WorkflowPhase) {
+// isRetryableSubmissionError reports whether submitting the Workflow again may succeed, e.g. after a conflict or a
+// transient API error or a registry rate limit, as opposed to an invalid or forbidden Workflow, which will be rejected
+// every time
+func isRetryableSubmissionError(err error) bool {
+	return errors.IsConflict(err) || errorsutil.IsTransientErrQuiet(err) || entrypoint.IsRateLimited(err)
+}
+
+// now returns the current time from the clock
+func (woc *cronWfOperationCtx) now() time.Time {
+	return woc.clock.Now()
+}
+
+func (woc *cronWfOperationCtx) recordEvent(eventType string, reason v1alpha1.CronWorkflowEventReason, message string) {
+	if woc.eventRecorder == nil {
+		return
+	}
+	// the CronWorkflow returned by a patch has no kind, so reference it explicitly
+	ref := &corev1.ObjectReference{
+		Kind:            workflow.CronWorkflowKind,
+		APIVersion:      v1alpha1.SchemeGroupVersion.String(),
+		Name:            woc.cronWf.Name,
+		Namespace:       woc.cronWf.Namespace,
+		UID:             woc.cronWf.UID,
+		ResourceVersion: woc.cronWf.ResourceVersion,
+	}
+	woc.eventRecorder.Event(ref, eventType, string(reason), message)
+}
+
+func (woc *cronWfOperationCtx) updateWfPhaseCounter(ctx context.Context, phase v1alpha1.WorkflowPhase) {
+	if woc.metrics != nil {
+		woc.metrics.CronWfCompleted(ctx, woc.name, woc.cronWf.Namespace, phase)
+	}
 	switch phase {
 	case v1alpha1.WorkflowError, v1alpha1.WorkflowFailed:
 		woc.cronWf.Status.Failed++
@@ -499,6 +1157,7 @@ func expressionEnv(cron *v1alpha1.CronWorkflow, addSetField func(name string, va
 	addSetField("annotations", cron.Labels)
 	addSetField("failed", cron.Status.Failed)
 	addSetField("succeeded", cron.Status.Succeeded)
+	addSetField("failureRate", failureRate(cron.Status))
 
 	labelsStr, err := json.Marshal(&cron.Labels)
 	if err != nil {
@@ -525,8 +1184,48 @@ func expressionEnv(cron *v1alpha1.CronWorkflow, addSetField func(name string, va
 	return nil
 }
 
-func (woc *cronWfOperationCtx) checkStopingCondition() (bool, error) {
-	if woc.cronWf.Spec.StopStrategy == nil {
+// failureRate returns the ratio of failed to completed child workflows, or 0 if none have completed yet
+func failureRate(status v1alpha1.CronWorkflowStatus) float64 {
+	completed := status.Failed + status.Succeeded
+	if completed == 0 {
+		return 0
+	}
+	return float64(status.Failed) / float64(completed)
+}
+
+// checkStopingCondition returns true and the reason if the CronWorkflow must stop scheduling. If the stop expression
+// fails to evaluate, it fails open: the CronWorkflow keeps scheduling and ConditionTypeStopExpressionError is set until
+// the expression evaluates again.
+func (woc *cronWfOperationCtx) checkStopingCondition() (bool, string) {
+	if woc.maxRunsReached() {
+		status := woc.cronWf.Status
+		return true, fmt.Sprintf("maxRuns %d reached (failed: %d, succeeded: %d)",
+			*woc.cronWf.Spec.MaxRuns, status.Failed, status.Succeeded)
+	}
+	stop, err := EvaluateStop(woc.cronWf)
+	if err != nil {
+		woc.log.WithError(err).Warn("failed to evaluate stop expression, continuing to schedule")
+		woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+			Type:    v1alpha1.ConditionTypeStopExpressionError,
+			Message: err.Error(),
+			Status:  v1.ConditionTrue,
+		})
+		return false, ""
+	}
+	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeStopExpressionError)
+	if !stop {
+		return false, ""
+	}
+	status := woc.cronWf.Status
+	return true, fmt.Sprintf("stop strategy expression %q is true (failed: %d, succeeded: %d, failureRate: %.2f)",
+		woc.cronWf.Spec.StopStrategy.Expression, status.Failed, status.Succeeded, failureRate(status))
+}
+
+// EvaluateStop returns whether the CronWorkflow's StopStrategy expression is true for its current status, or false if
+// it has no StopStrategy. It returns an error if the expression cannot be evaluated, e.g. because it uses a variable
+// that is nil, which callers should not treat as a reason to stop.
+func EvaluateStop(cron *v1alpha1.CronWorkflow) (bool, error) {
+	if cron.Spec.StopStrategy == nil {
 		return false, nil
 	}
 	prefixedEnv := make(map[string]interface{})
@@ -535,19 +1234,32 @@ func (woc *cronWfOperationCtx) checkStopingCondition() (bool, error) {
 	}
 	env := make(map[string]interface{})
 	env[variablePrefix] = prefixedEnv
-	err := expressionEnv(woc.cronWf, addSetField)
-	if err != nil {
+	if err := expressionEnv(cron, addSetField); err != nil {
 		return false, err
 	}
-
-	suspend, err := argoexpr.EvalBool(woc.cronWf.Spec.StopStrategy.Expression, env)
+	stop, err := argoexpr.EvalBool(cron.Spec.StopStrategy.Expression, env)
 	if err != nil {
 		return false, fmt.Errorf("failed to evaluate stop expression: %w", err)
 	}
-	return suspend, nil
+	return stop, nil
+}
+
+// maxRunsReached returns true if Spec.MaxRuns Workflows have completed
+func (woc *cronWfOperationCtx) maxRunsReached() bool {
+	maxRuns := woc.cronWf.Spec.MaxRuns
+	return maxRuns != nil && woc.cronWf.Status.Succeeded+woc.cronWf.Status.Failed >= *maxRuns
 }
 
-func (woc *cronWfOperationCtx) setAsCompleted() {
+func (woc *cronWfOperationCtx) setAsCompleted(reason string) {
+	if woc.cronWf.Status.Phase != v1alpha1.StoppedPhase {
+		if woc.maxRunsReached() {
+			woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonStopped, "Stopped scheduling because maxRuns was reached")
+		} else {
+			woc.recordEvent(corev1.EventTypeNormal, v1alpha1.CronWorkflowEventReasonStopped, "Stopped scheduling because the stop strategy expression is true")
+		}
+		woc.cronWf.Status.StoppedAt = &v1.Time{Time: woc.now()}
+		woc.cronWf.Status.StoppedReason = reason
+	}
 	woc.cronWf.Status.Phase = v1alpha1.StoppedPhase
 	if woc.cronWf.Labels == nil {
 		woc.cronWf.Labels = map[string]string{}
@@ -555,17 +1267,31 @@ func (woc *cronWfOperationCtx) setAsCompleted() {
 	woc.cronWf.Labels[common.LabelKeyCronWorkflowCompleted] = "true"
 }
 
-func inferScheduledTime() time.Time {
+// deleteIfStoppedExpired deletes the CronWorkflow once it has been stopped for longer than Spec.DeleteAfterStopped
+func (woc *cronWfOperationCtx) deleteIfStoppedExpired(ctx context.Context) error {
+	deleteAfter := woc.cronWf.Spec.DeleteAfterStopped
+	stoppedAt := woc.cronWf.Status.StoppedAt
+	if deleteAfter == nil || stoppedAt == nil || woc.cronWf.Status.Phase != v1alpha1.StoppedPhase {
+		return nil
+	}
+	if woc.now().Before(stoppedAt.Add(deleteAfter.Duration)) {
+		return nil
+	}
+	woc.log.Infof("Deleting %s since it was stopped at %s", woc.name, stoppedAt.Format(time.RFC3339))
+	err := woc.cronWfIf.Delete(ctx, woc.cronWf.Name, v1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (woc *cronWfOperationCtx) inferScheduledTime() time.Time {
 	// Infer scheduled runtime by getting current time and zeroing out current seconds and nanoseconds
 	// This works because the finest possible scheduled runtime is a minute. It is unlikely to ever be used, since this
 	// function is quickly supplanted by a deterministic function from the cron engine.
-	now := time.Now().UTC()
+	now := woc.now().UTC()
 	scheduledTime := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), 0, 0, now.Location())
 
 	log.Infof("inferred scheduled time: %s", scheduledTime)
 	return scheduledTime
 }
-
-func getChildWorkflowName(cronWorkflowName string, scheduledRuntime time.Time) string {
-	return fmt.Sprintf("%s-%d", cronWorkflowName, scheduledRuntime.Unix())
-}
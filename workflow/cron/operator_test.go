@@ -3,13 +3,24 @@ package cron
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	testingclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -17,6 +28,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/humanize"
 	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
+	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
@@ -77,6 +90,7 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 	// StartingDeadlineSeconds is after the current second, so cron should be run
 	cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(35))
 	woc := &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -84,11 +98,12 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
 	require.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	assert.Equal(t, woc.inferScheduledTime().Unix(), missedExecutionTime.Unix())
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(25))
 	woc = &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -115,6 +130,7 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 	// StartingDeadlineSeconds is after the current second, so cron should be run
 	cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(35))
 	woc = &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -123,11 +139,12 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun(ctx)
 	require.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	assert.Equal(t, woc.inferScheduledTime().Unix(), missedExecutionTime.Unix())
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(25))
 	woc = &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -193,6 +210,7 @@ func TestRunOutstandingWorkflowsAcrossTimezones(t *testing.T) {
 
 	cronWf.Status.LastScheduledTime = &v1.Time{Time: time.Now().Add(-24*time.Hour + -1*time.Minute)}
 	woc := &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -200,7 +218,7 @@ func TestRunOutstandingWorkflowsAcrossTimezones(t *testing.T) {
 	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
 	require.NoError(t, err)
 	// The missedExecutionTime should be the current complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix()+60)
+	assert.Equal(t, woc.inferScheduledTime().Unix(), missedExecutionTime.Unix()+60)
 
 	// We are assuming local time is not Auckland here
 	locHere := time.Now().Local().Location()
@@ -210,6 +228,7 @@ func TestRunOutstandingWorkflowsAcrossTimezones(t *testing.T) {
 
 	cronWf.Status.LastScheduledTime = &v1.Time{Time: time.Now().Add(-24*time.Hour + -1*time.Minute)}
 	woc = &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -263,14 +282,15 @@ func TestCronWorkflowConditionSubmissionError(t *testing.T) {
 	testMetrics, err := metrics.New(context.Background(), telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
 	require.NoError(t, err)
 	woc := &cronWfOperationCtx{
-		wfClientset:       cs,
-		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
-		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
-		cronWf:            &cronWf,
-		log:               logrus.WithFields(logrus.Fields{}),
-		metrics:           testMetrics,
-		scheduledTimeFunc: inferScheduledTime,
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
 	}
+	woc.scheduledTimeFunc = woc.inferScheduledTime
 	woc.Run()
 
 	assert.Len(t, woc.cronWf.Status.Conditions, 1)
@@ -320,6 +340,7 @@ func TestSpecError(t *testing.T) {
 	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
 	require.NoError(t, err)
 	woc := &cronWfOperationCtx{
+		clock:       clock.RealClock{},
 		wfClientset: cs,
 		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
 		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
@@ -344,22 +365,25 @@ func TestScheduleTimeParam(t *testing.T) {
 	cs := fake.NewSimpleClientset()
 	testMetrics, _ := metrics.New(context.Background(), telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
 	woc := &cronWfOperationCtx{
-		wfClientset:       cs,
-		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
-		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
-		cronWf:            &cronWf,
-		log:               logrus.WithFields(logrus.Fields{}),
-		metrics:           testMetrics,
-		scheduledTimeFunc: inferScheduledTime,
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
 	}
+	woc.scheduledTimeFunc = woc.inferScheduledTime
 	woc.Run()
 	wsl, err := cs.ArgoprojV1alpha1().Workflows("").List(context.Background(), v1.ListOptions{})
 	require.NoError(t, err)
 	assert.Equal(t, 1, wsl.Items.Len())
 	wf := wsl.Items[0]
 	assert.NotNil(t, wf)
-	assert.Len(t, wf.GetAnnotations(), 1)
+	assert.Len(t, wf.GetAnnotations(), 4)
 	assert.NotEmpty(t, wf.GetAnnotations()[common.AnnotationKeyCronWfScheduledTime])
+	assert.NotEmpty(t, wf.GetAnnotations()[common.AnnotationKeyCronWfUID])
+	assert.Equal(t, "* * * * *", wf.GetAnnotations()[common.AnnotationKeyCronWfSchedule])
 }
 
 const lastUsedSchedule = `apiVersion: argoproj.io/v1alpha1
@@ -397,14 +421,15 @@ func TestLastUsedSchedule(t *testing.T) {
 	testMetrics, err := metrics.New(context.Background(), telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
 	require.NoError(t, err)
 	woc := &cronWfOperationCtx{
-		wfClientset:       cs,
-		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
-		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
-		cronWf:            &cronWf,
-		log:               logrus.WithFields(logrus.Fields{}),
-		metrics:           testMetrics,
-		scheduledTimeFunc: inferScheduledTime,
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
 	}
+	woc.scheduledTimeFunc = woc.inferScheduledTime
 
 	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
 	require.NoError(t, err)
@@ -474,6 +499,7 @@ func TestMissedScheduleAfterCronScheduleWithForbid(t *testing.T) {
 	t.Run("ForbiddenWithMissedScheduleAfterCron", func(t *testing.T) {
 		cronWf.Spec.StartingDeadlineSeconds = nil
 		woc := &cronWfOperationCtx{
+			clock:  clock.RealClock{},
 			cronWf: &cronWf,
 			log:    logrus.WithFields(logrus.Fields{}),
 		}
@@ -527,22 +553,25 @@ func TestMultipleSchedules(t *testing.T) {
 	testMetrics, err := metrics.New(context.Background(), telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
 	require.NoError(t, err)
 	woc := &cronWfOperationCtx{
-		wfClientset:       cs,
-		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
-		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
-		cronWf:            &cronWf,
-		log:               logrus.WithFields(logrus.Fields{}),
-		metrics:           testMetrics,
-		scheduledTimeFunc: inferScheduledTime,
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
 	}
+	woc.scheduledTimeFunc = woc.inferScheduledTime
 	woc.Run()
 	wsl, err := cs.ArgoprojV1alpha1().Workflows("").List(context.Background(), v1.ListOptions{})
 	require.NoError(t, err)
 	assert.Equal(t, 1, wsl.Items.Len())
 	wf := wsl.Items[0]
 	assert.NotNil(t, wf)
-	assert.Len(t, wf.GetAnnotations(), 1)
+	assert.Len(t, wf.GetAnnotations(), 4)
 	assert.NotEmpty(t, wf.GetAnnotations()[common.AnnotationKeyCronWfScheduledTime])
+	assert.NotEmpty(t, wf.GetAnnotations()[common.AnnotationKeyCronWfUID])
+	assert.Equal(t, "* * * * *", wf.GetAnnotations()[common.AnnotationKeyCronWfSchedule])
 }
 
 var specErrWithScheduleAndSchedules = `
@@ -590,6 +619,7 @@ func TestSpecErrorWithScheduleAndSchedules(t *testing.T) {
 	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
 	require.NoError(t, err)
 	woc := &cronWfOperationCtx{
+		clock:       clock.RealClock{},
 		wfClientset: cs,
 		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
 		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
@@ -651,6 +681,7 @@ func TestSpecErrorWithValidAndInvalidSchedules(t *testing.T) {
 	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
 	require.NoError(t, err)
 	woc := &cronWfOperationCtx{
+		clock:       clock.RealClock{},
 		wfClientset: cs,
 		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
 		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
@@ -692,6 +723,7 @@ func TestRunOutstandingWorkflowsWithMultipleSchedules(t *testing.T) {
 	startingDeadlineSeconds := int64(35)
 	cronWf.Spec.StartingDeadlineSeconds = &startingDeadlineSeconds
 	woc := &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -699,12 +731,13 @@ func TestRunOutstandingWorkflowsWithMultipleSchedules(t *testing.T) {
 	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
 	require.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	assert.Equal(t, woc.inferScheduledTime().Unix(), missedExecutionTime.Unix())
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	startingDeadlineSeconds = int64(25)
 	cronWf.Spec.StartingDeadlineSeconds = &startingDeadlineSeconds
 	woc = &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -732,6 +765,7 @@ func TestRunOutstandingWorkflowsWithMultipleSchedules(t *testing.T) {
 	startingDeadlineSeconds = int64(35)
 	cronWf.Spec.StartingDeadlineSeconds = &startingDeadlineSeconds
 	woc = &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -740,12 +774,13 @@ func TestRunOutstandingWorkflowsWithMultipleSchedules(t *testing.T) {
 	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun(ctx)
 	require.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	assert.Equal(t, woc.inferScheduledTime().Unix(), missedExecutionTime.Unix())
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	startingDeadlineSeconds = int64(25)
 	cronWf.Spec.StartingDeadlineSeconds = &startingDeadlineSeconds
 	woc = &cronWfOperationCtx{
+		clock:  clock.RealClock{},
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
@@ -766,34 +801,34 @@ func TestEvaluateWhen(t *testing.T) {
 	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
 
 	cronWf.Spec.When = "{{= cronworkflow.lastScheduledTime == nil || ( (now() - cronworkflow.lastScheduledTime).Seconds() > 30) }}"
-	result, err := evalWhen(&cronWf)
+	result, err := evalWhen(&cronWf, nil)
 	require.NoError(t, err)
 	assert.True(t, result)
 
 	cronWf.Spec.When = "{{= cronworkflow.lastScheduledTime == nil && ( (now() - cronworkflow.lastScheduledTime).Seconds() < 30) }}"
-	result, err = evalWhen(&cronWf)
+	result, err = evalWhen(&cronWf, nil)
 	require.NoError(t, err)
 	assert.False(t, result)
 
 	cronWf.Spec.When = "{{= cronworkflow.lastScheduledTime != nil }}"
-	result, err = evalWhen(&cronWf)
+	result, err = evalWhen(&cronWf, nil)
 	require.NoError(t, err)
 	assert.True(t, result)
 
 	cronWf.Status.LastScheduledTime = nil
 	cronWf.Spec.When = "{{= cronworkflow.lastScheduledTime == nil }}"
-	result, err = evalWhen(&cronWf)
+	result, err = evalWhen(&cronWf, nil)
 	require.NoError(t, err)
 	assert.True(t, result)
 
 	cronWf.Status.LastScheduledTime = &v1.Time{Time: time.Now().Add(time.Minute * -30)}
 	cronWf.Spec.When = "{{= (now() - cronworkflow.lastScheduledTime).Minutes() >= 30 }}"
-	result, err = evalWhen(&cronWf)
+	result, err = evalWhen(&cronWf, nil)
 	require.NoError(t, err)
 	assert.True(t, result)
 
 	cronWf.Spec.When = "{{= (now() - cronworkflow.lastScheduledTime).Minutes() <  50 }}"
-	result, err = evalWhen(&cronWf)
+	result, err = evalWhen(&cronWf, nil)
 	require.NoError(t, err)
 	assert.True(t, result)
 }
@@ -808,7 +843,1219 @@ func TestEvaluateWhenUnresolvedOutside(t *testing.T) {
 
 	cronWf.Status.LastScheduledTime = &v1.Time{Time: time.Now().Add(time.Minute * -30)}
 	cronWf.Spec.When = "{{= (now() - cronworkflow.lastScheduledTime).Minutes() >= 30 }}"
-	result, err := evalWhen(&cronWf)
+	result, err := evalWhen(&cronWf, nil)
+	require.NoError(t, err)
+	assert.True(t, result)
+}
+
+func TestEvaluateWhenData(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.WhenData = &corev1.LocalObjectReference{Name: "flags"}
+	ctx := context.Background()
+	woc := &cronWfOperationCtx{
+		clock:  clock.RealClock{},
+		cronWf: &cronWf,
+		kubeClient: kubefake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{Name: "flags", Namespace: cronWf.Namespace},
+			Data:       map[string]string{"enabled": "true"},
+		}),
+	}
+	data, err := woc.getWhenData(ctx)
+	require.NoError(t, err)
+
+	cronWf.Spec.When = "{{cronworkflow.data.enabled}} == true"
+	result, err := evalWhen(&cronWf, data)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	cronWf.Spec.When = "{{= cronworkflow.data.enabled == 'true' }}"
+	result, err = evalWhen(&cronWf, data)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	// missing keys are empty
+	cronWf.Spec.When = "'{{cronworkflow.data.missing}}' == ''"
+	result, err = evalWhen(&cronWf, data)
 	require.NoError(t, err)
 	assert.True(t, result)
+
+	cronWf.Spec.WhenData.Name = "missing"
+	_, err = woc.getWhenData(ctx)
+	require.ErrorContains(t, err, `failed to get when data ConfigMap "missing"`)
+}
+
+func TestWhenFilter(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Schedules = []string{"0 * * * *"}
+	cronWf.Spec.Timezone = "UTC"
+	// only every other hour passes
+	cronWf.Spec.When = "{{= now().Hour() % 2 == 0 }}"
+	from := time.Date(2024, time.June, 1, 10, 30, 0, 0, time.UTC)
+	at := func(hour int) time.Time { return time.Date(2024, time.June, 1, hour, 0, 0, 0, time.UTC) }
+
+	times, err := cronWf.Spec.NextRunTimes(context.Background(), from, 3, WhenFilter(&cronWf, nil))
+	require.NoError(t, err)
+	require.Len(t, times, 3)
+	for i, want := range []time.Time{at(12), at(14), at(16)} {
+		assert.True(t, want.Equal(times[i]), "got %v, want %v", times[i], want)
+	}
+
+	cronWf.Spec.When = "{{= cronworkflow.data.enabled == 'true' && now().Hour() % 2 == 1 }}"
+	times, err = cronWf.Spec.NextRunTimes(context.Background(), from, 2, WhenFilter(&cronWf, map[string]string{"enabled": "true"}))
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.True(t, at(11).Equal(times[0]))
+	assert.True(t, at(13).Equal(times[1]))
+}
+
+func TestFreeze(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Freeze = &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "deploy"}, Key: "freeze"}
+	ctx := context.Background()
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	flags := &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: "deploy", Namespace: cronWf.Namespace},
+		Data:       map[string]string{"freeze": "true"},
+	}
+	kubeClient := kubefake.NewSimpleClientset(flags)
+	woc := &cronWfOperationCtx{clock: clock.RealClock{}, cronWf: &cronWf, kubeClient: kubeClient, log: logrus.WithFields(logrus.Fields{}), metrics: testMetrics}
+
+	proceed, err := woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.False(t, proceed, "frozen")
+
+	flags.Data["freeze"] = "false"
+	_, err = kubeClient.CoreV1().ConfigMaps(cronWf.Namespace).Update(ctx, flags, v1.UpdateOptions{})
+	require.NoError(t, err)
+	proceed, err = woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.True(t, proceed, "unfrozen")
+
+	cronWf.Spec.Freeze.Name = "missing"
+	_, err = woc.enforceRuntimePolicy(ctx)
+	require.ErrorContains(t, err, `failed to get freeze ConfigMap "missing"`)
+	cronWf.Spec.Freeze.Optional = ptr.To(true)
+	proceed, err = woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.True(t, proceed, "an optional ConfigMap that is missing does not freeze")
+}
+
+func TestSkipNext(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Annotations = map[string]string{v1alpha1.AnnotationKeySkipNext: "true"}
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	recorder := record.NewFakeRecorder(16)
+	woc := &cronWfOperationCtx{
+		clock:         clock.RealClock{},
+		cronWf:        &cronWf,
+		cronWfIf:      cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		log:           logrus.WithFields(logrus.Fields{}),
+		metrics:       testMetrics,
+		eventRecorder: recorder,
+	}
+
+	// a run that is skipped for another reason does not consume skip-next
+	woc.cronWf.Spec.ConcurrencyPolicy = v1alpha1.ForbidConcurrent
+	woc.cronWf.Status.Active = []corev1.ObjectReference{{Name: "active", UID: "active"}}
+	proceed, err := woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.False(t, proceed)
+	<-recorder.Events
+	assert.True(t, woc.cronWf.ShouldSkipNext())
+	woc.cronWf.Status.Active = nil
+
+	proceed, err = woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.False(t, proceed, "skipped")
+	assert.Equal(t, "Normal Skipped Run skipped because of the cronworkflows.argoproj.io/skip-next annotation", <-recorder.Events)
+	assert.False(t, woc.cronWf.ShouldSkipNext())
+
+	// the annotation is removed with the rest of the run's changes, which are not lost
+	woc.cronWf.SetSchedule("* * * * *")
+	woc.persistUpdate(ctx)
+	stored, err := cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace).Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, stored.Annotations, v1alpha1.AnnotationKeySkipNext)
+	assert.Equal(t, "* * * * *", stored.GetLatestSchedule())
+
+	proceed, err = woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.True(t, proceed, "only the next run is skipped")
+}
+
+func TestEnforceHistoryLimitErrored(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.SuccessfulJobsHistoryLimit = ptr.To(int32(1))
+	cronWf.Spec.FailedJobsHistoryLimit = ptr.To(int32(1))
+	ctx := context.Background()
+	finished := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	var workflows []v1alpha1.Workflow
+	for i, phase := range []v1alpha1.WorkflowPhase{
+		v1alpha1.WorkflowSucceeded, v1alpha1.WorkflowFailed, v1alpha1.WorkflowError,
+		v1alpha1.WorkflowSucceeded, v1alpha1.WorkflowError, v1alpha1.WorkflowFailed,
+		v1alpha1.WorkflowError, v1alpha1.WorkflowRunning,
+	} {
+		workflows = append(workflows, v1alpha1.Workflow{
+			ObjectMeta: v1.ObjectMeta{Name: fmt.Sprintf("%s-%d", strings.ToLower(string(phase)), i), Labels: map[string]string{common.LabelKeyCronWorkflow: cronWf.Name}},
+			Status:     v1alpha1.WorkflowStatus{Phase: phase, FinishedAt: v1.Time{Time: finished.Add(time.Duration(i) * time.Minute)}},
+		})
+	}
+	remaining := func(erroredLimit *int32) []string {
+		cs := fake.NewSimpleClientset()
+		for i := range workflows {
+			_, err := cs.ArgoprojV1alpha1().Workflows("").Create(ctx, &workflows[i], v1.CreateOptions{})
+			require.NoError(t, err)
+		}
+		cronWf.Spec.ErroredJobsHistoryLimit = erroredLimit
+		woc := &cronWfOperationCtx{clock: clock.RealClock{}, cronWf: &cronWf, wfClient: cs.ArgoprojV1alpha1().Workflows(""), log: logrus.WithFields(logrus.Fields{})}
+		require.NoError(t, woc.enforceHistoryLimit(ctx, slices.Clone(workflows)))
+		list, err := cs.ArgoprojV1alpha1().Workflows("").List(ctx, v1.ListOptions{})
+		require.NoError(t, err)
+		var names []string
+		for _, wf := range list.Items {
+			names = append(names, wf.Name)
+		}
+		slices.Sort(names)
+		return names
+	}
+
+	assert.Equal(t, []string{"error-6", "running-7", "succeeded-3"}, remaining(nil), "errors count as failures")
+	assert.Equal(t, []string{"error-4", "error-6", "failed-5", "running-7", "succeeded-3"}, remaining(ptr.To(int32(2))))
+	assert.Equal(t, []string{"failed-5", "running-7", "succeeded-3"}, remaining(ptr.To(int32(0))))
+}
+
+func TestStopStrategyFailureRate(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Expression: "cronworkflow.failureRate > 0.5"}
+	woc := &cronWfOperationCtx{clock: clock.RealClock{}, cronWf: &cronWf}
+
+	for _, tt := range []struct {
+		failed, succeeded int64
+		rate              float64
+		stop              bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 0.5, false},
+		{2, 0, 1.0, true},
+	} {
+		cronWf.Status.Failed = tt.failed
+		cronWf.Status.Succeeded = tt.succeeded
+		assert.InDelta(t, tt.rate, failureRate(cronWf.Status), 0.0001)
+		stop, reason := woc.checkStopingCondition()
+		assert.Equal(t, tt.stop, stop)
+		if tt.stop {
+			assert.Equal(t, `stop strategy expression "cronworkflow.failureRate > 0.5" is true (failed: 2, succeeded: 0, failureRate: 1.00)`, reason)
+		} else {
+			assert.Empty(t, reason)
+		}
+	}
+}
+
+func TestStopExpressionError(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	woc := &cronWfOperationCtx{clock: clock.RealClock{}, cronWf: &cronWf, log: logrus.WithFields(logrus.Fields{})}
+
+	// lastScheduledTime is unexpectedly nil
+	cronWf.Status.LastScheduledTime = nil
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Expression: "cronworkflow.lastScheduledTime.Unix() > 0"}
+	_, err := EvaluateStop(&cronWf)
+	require.ErrorContains(t, err, "failed to evaluate stop expression")
+	stop, reason := woc.checkStopingCondition()
+	assert.False(t, stop, "fails open")
+	assert.Empty(t, reason)
+	require.Len(t, cronWf.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ConditionTypeStopExpressionError, cronWf.Status.Conditions[0].Type)
+	assert.Contains(t, cronWf.Status.Conditions[0].Message, "failed to evaluate stop expression")
+
+	// a type error
+	cronWf.Spec.StopStrategy.Expression = "cronworkflow.failed > 'many'"
+	stop, _ = woc.checkStopingCondition()
+	assert.False(t, stop)
+	require.Len(t, cronWf.Status.Conditions, 1)
+
+	// the condition is cleared once the expression evaluates
+	cronWf.Spec.StopStrategy.Expression = "cronworkflow.failed > 0"
+	cronWf.Status.Failed = 1
+	stop, _ = woc.checkStopingCondition()
+	assert.True(t, stop)
+	assert.Empty(t, cronWf.Status.Conditions)
+}
+
+func TestMaxRuns(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.MaxRuns = ptr.To(int64(2))
+	ctx := context.Background()
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{clock: clock.RealClock{}, cronWf: &cronWf, log: logrus.WithFields(logrus.Fields{}), metrics: testMetrics}
+
+	cronWf.Status.Succeeded = 1
+	stop, reason := woc.checkStopingCondition()
+	assert.False(t, stop)
+	assert.Empty(t, reason)
+	proceed, err := woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.True(t, proceed)
+
+	// the second run is still active, so no more may be started
+	cronWf.Status.Active = []corev1.ObjectReference{{Name: "active"}}
+	proceed, err = woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.False(t, proceed)
+
+	cronWf.Status.Active = nil
+	cronWf.Status.Failed = 1
+	stop, reason = woc.checkStopingCondition()
+	assert.True(t, stop)
+	assert.Equal(t, "maxRuns 2 reached (failed: 1, succeeded: 1)", reason)
+}
+
+func TestSimultaneousSchedulesWithForbid(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(multipleSchedulesWf), &cronWf)
+	cronWf.Spec.Schedules = []string{"0 * * * *", "* * * * *"}
+	cronWf.Spec.ConcurrencyPolicy = v1alpha1.ForbidConcurrent
+	cronWf.Spec.WorkflowMetadata = &v1.ObjectMeta{Annotations: map[string]string{"schedule": "{{cronworkflow.schedule}}"}}
+	scheduledTime := time.Date(2020, 2, 28, 20, 0, 0, 0, time.Local)
+
+	cs := fake.NewSimpleClientset()
+	testMetrics, err := metrics.New(context.Background(), telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		clock:             clock.RealClock{},
+		wfClientset:       cs,
+		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:            &cronWf,
+		log:               logrus.WithFields(logrus.Fields{}),
+		metrics:           testMetrics,
+		scheduledTimeFunc: func() time.Time { return scheduledTime },
+	}
+	// both schedules fire at 20:00, so the job runs once per schedule
+	woc.Run()
+	woc.Run()
+
+	wsl, err := cs.ArgoprojV1alpha1().Workflows("").List(context.Background(), v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wsl.Items, 1)
+	assert.Equal(t, "0 * * * *", wsl.Items[0].Annotations["schedule"])
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+
+	// a run is attributed to the schedule that fires at its scheduled time, or to all of them if none does
+	woc.cronWf.Spec.ConcurrencyPolicy = v1alpha1.AllowConcurrent
+	for _, tt := range []struct {
+		scheduledTime time.Time
+		schedule      string
+	}{
+		{scheduledTime.Add(time.Minute), "* * * * *"},
+		{scheduledTime.Add(time.Minute + time.Second), "0 * * * *,* * * * *"},
+	} {
+		scheduledTime = tt.scheduledTime
+		woc.Run()
+		wf, err := cs.ArgoprojV1alpha1().Workflows("").Get(context.Background(), fmt.Sprintf("%s-%d", cronWf.Name, tt.scheduledTime.Unix()), v1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, tt.schedule, wf.Annotations["schedule"])
+		assert.Equal(t, tt.schedule, woc.cronWf.Status.LastRunSchedule)
+	}
+}
+
+func TestLastRunSchedule(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(multipleSchedulesWf), &cronWf)
+	cronWf.Spec.Schedules = []string{"0 * * * *", "CRON_TZ=UTC 30 * * * *"}
+	cronWf.Spec.Timezone = "Asia/Tokyo"
+	scheduledTime := time.Date(2020, 2, 28, 20, 0, 0, 0, time.UTC)
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		clock:             clock.RealClock{},
+		wfClientset:       cs,
+		wfClient:          cs.ArgoprojV1alpha1().Workflows(cronWf.Namespace),
+		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:            &cronWf,
+		log:               logrus.WithFields(logrus.Fields{}),
+		metrics:           testMetrics,
+		scheduledTimeFunc: func() time.Time { return scheduledTime },
+	}
+	persisted := func() *v1alpha1.CronWorkflowStatus {
+		cronWf, err := woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+		require.NoError(t, err)
+		return &cronWf.Status
+	}
+
+	woc.Run()
+	assert.Equal(t, "CRON_TZ=Asia/Tokyo 0 * * * *", persisted().LastRunSchedule)
+	assert.True(t, scheduledTime.Equal(persisted().LastScheduledTime.Time))
+
+	scheduledTime = scheduledTime.Add(30 * time.Minute)
+	woc.Run()
+	assert.Equal(t, "CRON_TZ=UTC 30 * * * *", persisted().LastRunSchedule)
+}
+
+func TestTimeBasedStopStrategy(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Expression: "now() > date('2020-01-01')"}
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	woc := &cronWfOperationCtx{
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+	}
+	// no child workflows have completed
+	require.NoError(t, woc.reconcileActiveWfs(ctx, nil))
+
+	persisted, err := cs.ArgoprojV1alpha1().CronWorkflows("argo").Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.StoppedPhase, persisted.Status.Phase)
+	assert.Equal(t, "true", persisted.Labels[common.LabelKeyCronWorkflowCompleted])
+	assert.Equal(t, `stop strategy expression "now() > date('2020-01-01')" is true (failed: 0, succeeded: 0, failureRate: 0.00)`, persisted.Status.GetStoppedReason())
+}
+
+func TestCronWorkflowEvents(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.ConcurrencyPolicy = v1alpha1.ForbidConcurrent
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Expression: "cronworkflow.succeeded >= 1"}
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset()
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	recorder := record.NewFakeRecorder(16)
+	woc := &cronWfOperationCtx{
+		clock:         clock.RealClock{},
+		wfClientset:   cs,
+		wfClient:      cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:      cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:        &cronWf,
+		log:           logrus.WithFields(logrus.Fields{}),
+		metrics:       testMetrics,
+		eventRecorder: recorder,
+	}
+
+	woc.run(ctx, time.Now())
+	assert.Contains(t, <-recorder.Events, "Normal Scheduled Scheduled Workflow hello-world-")
+
+	woc.run(ctx, time.Now().Add(time.Minute))
+	assert.Equal(t, "Normal Skipped Run skipped because of 'ConcurrencyPolicy: Forbid' and an active Workflow", <-recorder.Events)
+
+	cronWf.Status.Succeeded = 1
+	woc.setAsCompleted("")
+	woc.setAsCompleted("")
+	assert.Equal(t, "Normal Stopped Stopped scheduling because the stop strategy expression is true", <-recorder.Events)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestLastScheduledTimeBefore(t *testing.T) {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+
+	t.Run("FallBack", func(t *testing.T) {
+		// 01:30 occurs twice on 2020-11-01, first in PDT and then in PST
+		schedule, err := v1alpha1.ParseCronSchedule("CRON_TZ=America/Los_Angeles 30 1 * * *")
+		require.NoError(t, err)
+		firstRun := time.Date(2020, 11, 1, 8, 30, 0, 0, time.UTC)
+		secondRun := time.Date(2020, 11, 1, 9, 30, 0, 0, time.UTC)
+		assert.Equal(t, firstRun, schedule.Next(time.Date(2020, 11, 1, 0, 0, 0, 0, loc)).UTC())
+		assert.Equal(t, secondRun, schedule.Next(firstRun).UTC())
+
+		now := time.Date(2020, 11, 1, 1, 45, 0, 0, loc).Add(time.Hour)
+		assert.Equal(t, secondRun, lastScheduledTimeBefore(schedule, firstRun, now).UTC())
+		assert.True(t, lastScheduledTimeBefore(schedule, secondRun, now).IsZero())
+		assert.Equal(t, secondRun, lastScheduledTimeBefore(schedule, time.Date(2020, 10, 31, 12, 0, 0, 0, loc), now).UTC())
+	})
+
+	t.Run("SpringForward", func(t *testing.T) {
+		// 02:30 does not exist on 2020-03-08
+		schedule, err := v1alpha1.ParseCronSchedule("CRON_TZ=America/Los_Angeles 30 2 * * *")
+		require.NoError(t, err)
+		since := time.Date(2020, 3, 7, 2, 30, 0, 0, loc)
+		assert.True(t, lastScheduledTimeBefore(schedule, since, time.Date(2020, 3, 8, 4, 0, 0, 0, loc)).IsZero())
+		assert.Equal(t, time.Date(2020, 3, 9, 2, 30, 0, 0, loc), schedule.Next(since))
+	})
+}
+
+func TestShouldOutstandingWorkflowsBeRunWithClock(t *testing.T) {
+	ctx := context.Background()
+	clock := testingclock.NewFakePassiveClock(time.Date(2021, 2, 19, 10, 29, 30, 0, time.UTC))
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: v1.ObjectMeta{Name: "hello-world"},
+		Spec:       v1alpha1.CronWorkflowSpec{Schedules: []string{"* * * * *"}, StartingDeadlineSeconds: ptr.To(int64(35))},
+		Status:     v1alpha1.CronWorkflowStatus{LastScheduledTime: &v1.Time{Time: time.Date(2021, 2, 19, 10, 28, 0, 0, time.UTC)}},
+	}
+	cronWf.SetSchedule(cronWf.Spec.GetScheduleWithTimezoneString())
+	woc := &cronWfOperationCtx{
+		cronWf: cronWf,
+		log:    logrus.WithFields(logrus.Fields{}),
+		clock:  clock,
+	}
+
+	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 2, 19, 10, 29, 0, 0, time.UTC), missedExecutionTime.UTC())
+
+	// the deadline has passed by the time the clock moves on
+	recorder := record.NewFakeRecorder(16)
+	woc.eventRecorder = recorder
+	clock.SetTime(clock.Now().Add(10 * time.Second))
+	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun(ctx)
+	require.NoError(t, err)
+	assert.True(t, missedExecutionTime.IsZero())
+	assert.Equal(t, "Warning MissedDeadline Missed an execution at Fri Feb 19 10:29:00 2021 outside of StartingDeadline: it is 40s late and the deadline is 35s", <-recorder.Events)
+
+	// each missed execution is only reported once
+	woc.missedDeadlines = newMissedDeadlines()
+	for range 2 {
+		_, err = woc.shouldOutstandingWorkflowsBeRun(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "Warning MissedDeadline Missed an execution at Fri Feb 19 10:29:00 2021 outside of StartingDeadline: it is 40s late and the deadline is 35s", <-recorder.Events)
+	assert.Empty(t, recorder.Events)
+	clock.SetTime(clock.Now().Add(time.Minute))
+	_, err = woc.shouldOutstandingWorkflowsBeRun(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Warning MissedDeadline Missed an execution at Fri Feb 19 10:30:00 2021 outside of StartingDeadline: it is 40s late and the deadline is 35s", <-recorder.Events)
+
+	// without a deadline, missed executions are not run and not warned about
+	clock.SetTime(clock.Now().Add(time.Minute))
+	woc.cronWf.Spec.StartingDeadlineSeconds = nil
+	_, err = woc.shouldOutstandingWorkflowsBeRun(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestShouldOutstandingWorkflowsBeRunScheduleStartingDeadline(t *testing.T) {
+	ctx := context.Background()
+	clock := testingclock.NewFakePassiveClock(time.Date(2021, 2, 19, 10, 5, 0, 0, time.UTC))
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: v1.ObjectMeta{Name: "hello-world"},
+		Spec: v1alpha1.CronWorkflowSpec{
+			Schedules:                       []string{"*/2 * * * *", "0 10 * * *"},
+			StartingDeadlineSeconds:         ptr.To(int64(30)),
+			ScheduleStartingDeadlineSeconds: map[string]int64{"0 10 * * *": 600},
+		},
+		Status: v1alpha1.CronWorkflowStatus{LastScheduledTime: &v1.Time{Time: time.Date(2021, 2, 19, 9, 59, 0, 0, time.UTC)}},
+	}
+	cronWf.SetSchedule(cronWf.Spec.GetScheduleWithTimezoneString())
+	woc := &cronWfOperationCtx{
+		cronWf: cronWf,
+		log:    logrus.WithFields(logrus.Fields{}),
+		clock:  clock,
+	}
+
+	// the 10:04 run of the first schedule is past its deadline, but the 10:00 run of the second is not
+	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 2, 19, 10, 0, 0, 0, time.UTC), missedExecutionTime.UTC())
+
+	clock.SetTime(time.Date(2021, 2, 19, 10, 11, 0, 0, time.UTC))
+	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun(ctx)
+	require.NoError(t, err)
+	assert.True(t, missedExecutionTime.IsZero())
+}
+
+func TestTerminateStuckWorkflows(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2021, 2, 19, 12, 0, 0, 0, time.UTC)
+	fresh := v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{Name: "fresh", UID: "fresh-uid"},
+		Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning, StartedAt: v1.Time{Time: now.Add(-time.Minute)}},
+	}
+	stuck := v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{Name: "stuck", UID: "stuck-uid"},
+		Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning, StartedAt: v1.Time{Time: now.Add(-2 * time.Hour)}},
+	}
+	pending := v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{Name: "pending", UID: "pending-uid", CreationTimestamp: v1.Time{Time: now.Add(-2 * time.Hour)}},
+	}
+	cs := fake.NewSimpleClientset(&fresh, &stuck, &pending)
+	recorder := record.NewFakeRecorder(16)
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: v1.ObjectMeta{Name: "hello-world"},
+		Spec:       v1alpha1.CronWorkflowSpec{ActiveDeadlineSeconds: ptr.To(int64(3600))},
+	}
+	for _, wf := range []*v1alpha1.Workflow{&fresh, &stuck, &pending} {
+		cronWf.Status.AddActive(getWorkflowObjectReference(wf, wf))
+	}
+	woc := &cronWfOperationCtx{
+		wfClient:      cs.ArgoprojV1alpha1().Workflows(""),
+		cronWf:        cronWf,
+		log:           logrus.WithFields(logrus.Fields{}),
+		clock:         testingclock.NewFakePassiveClock(now),
+		eventRecorder: recorder,
+	}
+
+	require.NoError(t, woc.terminateStuckWorkflows(ctx, []v1alpha1.Workflow{fresh, stuck, pending}))
+	for name, shutdown := range map[string]v1alpha1.ShutdownStrategy{"fresh": "", "stuck": v1alpha1.ShutdownStrategyTerminate, "pending": v1alpha1.ShutdownStrategyTerminate} {
+		wf, err := cs.ArgoprojV1alpha1().Workflows("").Get(ctx, name, v1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, shutdown, wf.Spec.Shutdown, name)
+	}
+	assert.Len(t, recorder.Events, 2)
+	assert.Len(t, cronWf.Status.Active, 3, "terminated workflows stay active until they are fulfilled")
+
+	stuck.Spec.Shutdown = v1alpha1.ShutdownStrategyTerminate
+	require.NoError(t, woc.terminateStuckWorkflows(ctx, []v1alpha1.Workflow{fresh, stuck}))
+	assert.Len(t, recorder.Events, 2, "workflows that are shutting down are not terminated again")
+}
+
+func TestDeleteIfStoppedExpired(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.DeleteAfterStopped = &v1.Duration{Duration: time.Hour}
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	clock := testingclock.NewFakePassiveClock(time.Date(2021, 2, 19, 10, 0, 0, 0, time.UTC))
+	woc := &cronWfOperationCtx{
+		name:     cronWf.Name,
+		cronWfIf: cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:   &cronWf,
+		log:      logrus.WithFields(logrus.Fields{}),
+		clock:    clock,
+	}
+
+	// not stopped yet
+	require.NoError(t, woc.deleteIfStoppedExpired(ctx))
+
+	woc.setAsCompleted("")
+	require.NotNil(t, cronWf.Status.StoppedAt)
+	assert.Equal(t, clock.Now(), cronWf.Status.StoppedAt.Time)
+
+	clock.SetTime(clock.Now().Add(59 * time.Minute))
+	require.NoError(t, woc.deleteIfStoppedExpired(ctx))
+	_, err := woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+
+	clock.SetTime(clock.Now().Add(time.Minute))
+	require.NoError(t, woc.deleteIfStoppedExpired(ctx))
+	_, err = woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+type fakeEntrypointIndex struct {
+	err    error
+	images []string
+}
+
+func (f *fakeEntrypointIndex) Lookup(ctx context.Context, image string, options entrypoint.Options) (*entrypoint.Image, error) {
+	f.images = append(f.images, image)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &entrypoint.Image{Entrypoint: []string{"cowsay"}}, nil
+}
+
+func (f *fakeEntrypointIndex) LookupConfig(ctx context.Context, image string, options entrypoint.Options) (*gcrv1.ConfigFile, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &gcrv1.ConfigFile{Config: gcrv1.Config{Entrypoint: []string{"cowsay"}}}, nil
+}
+
+func (f *fakeEntrypointIndex) Ping(ctx context.Context, image string, options entrypoint.Options) error {
+	return f.err
+}
+
+func (f *fakeEntrypointIndex) Warm(ctx context.Context, images []string, options entrypoint.Options) error {
+	return nil
+}
+
+func TestValidateEntrypoint(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.WorkflowSpec.Templates[0].Container.Command = nil
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	index := &fakeEntrypointIndex{err: fmt.Errorf("MANIFEST_UNKNOWN")}
+	woc := &cronWfOperationCtx{
+		clock:      clock.RealClock{},
+		cronWfIf:   cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:     &cronWf,
+		log:        logrus.WithFields(logrus.Fields{}),
+		entrypoint: index,
+	}
+
+	woc.validateEntrypoint(ctx)
+	persisted, err := woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, persisted.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ConditionTypeSubmissionError, persisted.Status.Conditions[0].Type)
+	assert.Equal(t, `failed to look-up entrypoint/cmd for image "docker/whalesay:latest": MANIFEST_UNKNOWN`, persisted.Status.Conditions[0].Message)
+	assert.Zero(t, persisted.Status.Failed)
+
+	index.err = fmt.Errorf("docker/whalesay:latest: %w: 429 Too Many Requests", entrypoint.ErrRateLimited)
+	woc.validateEntrypoint(ctx)
+	persisted, err = woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, persisted.Status.SubmissionErrorRetryable)
+
+	index.err = nil
+	woc.validateEntrypoint(ctx)
+	persisted, err = woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, persisted.Status.Conditions)
+	assert.False(t, persisted.Status.SubmissionErrorRetryable)
+}
+
+func TestValidateEntrypointResolutionDisabled(t *testing.T) {
+	disabled := map[string]string{common.AnnotationKeyEntrypointResolution: "disabled"}
+	for name, disable := range map[string]func(cronWf *v1alpha1.CronWorkflow){
+		"Template": func(cronWf *v1alpha1.CronWorkflow) {
+			cronWf.Spec.WorkflowSpec.Templates[0].Metadata.Annotations = disabled
+		},
+		"PodMetadata": func(cronWf *v1alpha1.CronWorkflow) {
+			cronWf.Spec.WorkflowSpec.PodMetadata = &v1alpha1.Metadata{Annotations: disabled}
+		},
+		"WorkflowMetadata": func(cronWf *v1alpha1.CronWorkflow) {
+			cronWf.Spec.WorkflowMetadata = &v1.ObjectMeta{Annotations: disabled}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var cronWf v1alpha1.CronWorkflow
+			v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+			cronWf.Spec.WorkflowSpec.Templates[0].Container.Command = nil
+			disable(&cronWf)
+
+			ctx := context.Background()
+			cs := fake.NewSimpleClientset(&cronWf)
+			index := &fakeEntrypointIndex{err: fmt.Errorf("MANIFEST_UNKNOWN")}
+			woc := &cronWfOperationCtx{
+				clock:      clock.RealClock{},
+				cronWfIf:   cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+				cronWf:     &cronWf,
+				log:        logrus.WithFields(logrus.Fields{}),
+				entrypoint: index,
+			}
+
+			woc.validateEntrypoint(ctx)
+			assert.Empty(t, index.images, "the image is not looked up")
+			assert.Empty(t, woc.cronWf.Status.Conditions)
+		})
+	}
+}
+
+func TestCheckNeverFires(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Schedules = []string{"* * * * *", "0 0 30 2 *", "0 0 31 4 *"}
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	woc := &cronWfOperationCtx{
+		clock:    clock.RealClock{},
+		cronWfIf: cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:   &cronWf,
+		log:      logrus.WithFields(logrus.Fields{}),
+	}
+
+	woc.checkNeverFires(ctx)
+	persisted, err := woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, persisted.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ConditionTypeNeverFires, persisted.Status.Conditions[0].Type)
+	assert.Equal(t, "schedules never fire: 0 0 30 2 *, 0 0 31 4 *", persisted.Status.Conditions[0].Message)
+
+	woc.cronWf.Spec.Schedules = []string{"* * * * *", "0 0 29 2 *"}
+	woc.checkNeverFires(ctx)
+	persisted, err = woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, persisted.Status.Conditions)
+}
+
+func TestCheckSpec(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.WorkflowSpec.Templates[0].Container.Command = nil
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	index := &fakeEntrypointIndex{err: fmt.Errorf("MANIFEST_UNKNOWN")}
+	woc := &cronWfOperationCtx{
+		clock:        clock.RealClock{},
+		cronWfIf:     cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:       &cronWf,
+		log:          logrus.WithFields(logrus.Fields{}),
+		entrypoint:   index,
+		checkedSpecs: newCheckedSpecs(),
+	}
+
+	woc.checkSpec(ctx)
+	woc.checkSpec(ctx)
+	assert.Len(t, index.images, 1, "the spec is only checked again once it changes")
+	require.Len(t, woc.cronWf.Status.Conditions, 1)
+
+	// a status update does not change the spec
+	woc.cronWf.Status.Active = []corev1.ObjectReference{{Name: "active"}}
+	woc.checkSpec(ctx)
+	assert.Len(t, index.images, 1)
+
+	woc.cronWf.Spec.Schedules = []string{"0 0 30 2 *"}
+	woc.checkSpec(ctx)
+	assert.Len(t, index.images, 2)
+	require.Len(t, woc.cronWf.Status.Conditions, 2)
+	assert.Equal(t, v1alpha1.ConditionTypeNeverFires, woc.cronWf.Status.Conditions[1].Type)
+
+	// a lookup that may succeed is retried on the next sync
+	woc.cronWf.Spec.Schedules = []string{"* * * * *"}
+	index.err = fmt.Errorf("docker/whalesay:latest: %w: 429 Too Many Requests", entrypoint.ErrRateLimited)
+	woc.checkSpec(ctx)
+	woc.checkSpec(ctx)
+	assert.Len(t, index.images, 4)
+	index.err = nil
+	woc.checkSpec(ctx)
+	woc.checkSpec(ctx)
+	assert.Len(t, index.images, 5)
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+
+	woc.checkedSpecs.forget(cronWf.Namespace + "/" + cronWf.Name)
+	woc.checkSpec(ctx)
+	assert.Len(t, index.images, 6)
+}
+
+func TestValidateEntrypointRateLimited(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.WorkflowSpec.Templates[0].Container.Command = nil
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	index := &fakeEntrypointIndex{err: fmt.Errorf("docker/whalesay:latest: %w: 429 Too Many Requests", entrypoint.ErrRateLimited)}
+	woc := &cronWfOperationCtx{
+		clock:      clock.RealClock{},
+		cronWfIf:   cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:     &cronWf,
+		log:        logrus.WithFields(logrus.Fields{}),
+		entrypoint: entrypoint.WithRateLimitBackoff(index, time.Minute, time.Hour),
+	}
+
+	// the registry keeps rate limiting, but is only asked once while backing off
+	for range 3 {
+		woc.validateEntrypoint(ctx)
+	}
+	assert.Len(t, index.images, 1)
+	persisted, err := woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, persisted.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ConditionTypeSubmissionError, persisted.Status.Conditions[0].Type)
+	assert.Contains(t, persisted.Status.Conditions[0].Message, "rate limited by the registry")
+	assert.True(t, persisted.Status.SubmissionErrorRetryable)
+	assert.True(t, isRetryableSubmissionError(fmt.Errorf("failed to look-up entrypoint/cmd: %w", index.err)))
+}
+
+func TestValidateTemplatedEntrypoint(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.WorkflowSpec.Templates[0].Container.Command = nil
+	cronWf.Spec.WorkflowSpec.Templates[0].Container.Image = "myapp:{{cronworkflow.scheduledDate}}"
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	index := &fakeEntrypointIndex{err: fmt.Errorf("MANIFEST_UNKNOWN")}
+	woc := &cronWfOperationCtx{
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
+		entrypoint:  index,
+	}
+
+	// the image is only known once the workflow is built
+	woc.validateEntrypoint(ctx)
+	assert.Empty(t, index.images)
+
+	woc.run(ctx, time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, []string{"myapp:2024-06-01"}, index.images)
+	require.Len(t, cronWf.Status.Conditions, 1)
+	assert.Equal(t, `Failed to submit Workflow: failed to look-up entrypoint/cmd for image "myapp:2024-06-01": MANIFEST_UNKNOWN`, cronWf.Status.Conditions[0].Message)
+	wfs, err := cs.ArgoprojV1alpha1().Workflows("").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, wfs.Items)
+
+	index.err = nil
+	woc.run(ctx, time.Date(2024, time.June, 2, 0, 0, 0, 0, time.UTC))
+	wfs, err = cs.ArgoprojV1alpha1().Workflows("").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wfs.Items, 1)
+	assert.Equal(t, "myapp:2024-06-02", wfs.Items[0].Spec.Templates[0].Container.Image)
+}
+
+func TestWorkflowMutators(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	mutateErr := fmt.Errorf("team label is not allowed")
+	woc := &cronWfOperationCtx{
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
+		mutators: []util.WorkflowMutator{
+			func(wf *v1alpha1.Workflow) error {
+				wf.Labels["example.com/team"] = "platform"
+				return nil
+			},
+			func(wf *v1alpha1.Workflow) error {
+				return mutateErr
+			},
+		},
+	}
+
+	woc.run(ctx, time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	require.Len(t, cronWf.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ConditionTypeSubmissionError, cronWf.Status.Conditions[0].Type)
+	assert.Equal(t, "Failed to mutate Workflow: team label is not allowed", cronWf.Status.Conditions[0].Message)
+	wfs, err := cs.ArgoprojV1alpha1().Workflows("").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, wfs.Items)
+
+	mutateErr = nil
+	woc.run(ctx, time.Date(2024, time.June, 2, 0, 0, 0, 0, time.UTC))
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+	wfs, err = cs.ArgoprojV1alpha1().Workflows("").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wfs.Items, 1)
+	assert.Equal(t, "platform", wfs.Items[0].Labels["example.com/team"])
+}
+
+func TestIsRetryableSubmissionError(t *testing.T) {
+	gr := schema.GroupResource{Group: "argoproj.io", Resource: "workflows"}
+	assert.True(t, isRetryableSubmissionError(apierr.NewConflict(gr, "my-wf", fmt.Errorf("object has been modified"))))
+	assert.True(t, isRetryableSubmissionError(apierr.NewServiceUnavailable("unavailable")))
+	assert.True(t, isRetryableSubmissionError(apierr.NewForbidden(gr, "my-wf", fmt.Errorf("exceeded quota: pods"))))
+	assert.False(t, isRetryableSubmissionError(apierr.NewInvalid(v1alpha1.WorkflowSchemaGroupVersionKind.GroupKind(), "my-wf", nil)))
+	assert.False(t, isRetryableSubmissionError(apierr.NewForbidden(gr, "my-wf", fmt.Errorf("not allowed"))))
+}
+
+func TestReportSubmissionError(t *testing.T) {
+	ctx := context.Background()
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	recorder := record.NewFakeRecorder(16)
+	woc := &cronWfOperationCtx{
+		clock:         clock.RealClock{},
+		cronWf:        &v1alpha1.CronWorkflow{},
+		log:           logrus.WithFields(logrus.Fields{}),
+		metrics:       testMetrics,
+		eventRecorder: recorder,
+	}
+	gr := schema.GroupResource{Group: "argoproj.io", Resource: "workflows"}
+
+	woc.reportSubmissionError(ctx, "Failed to submit Workflow", apierr.NewConflict(gr, "my-wf", fmt.Errorf("object has been modified")))
+	require.Len(t, woc.cronWf.Status.Conditions, 1)
+	assert.True(t, woc.cronWf.Status.SubmissionErrorRetryable)
+	assert.Empty(t, recorder.Events)
+
+	woc.reportSubmissionError(ctx, "Failed to submit Workflow", apierr.NewForbidden(gr, "my-wf", fmt.Errorf("not allowed")))
+	require.Len(t, woc.cronWf.Status.Conditions, 1)
+	assert.False(t, woc.cronWf.Status.SubmissionErrorRetryable)
+	assert.Contains(t, <-recorder.Events, "Warning SubmissionFailed Failed to submit Workflow: ")
+	assert.Zero(t, woc.cronWf.Status.Failed, "a Workflow that was not submitted did not fail")
+}
+
+func TestReplaceGracePeriod(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.ConcurrencyPolicy = v1alpha1.ReplaceConcurrent
+	cronWf.Spec.ReplaceGracePeriod = &v1.Duration{Duration: 10 * time.Minute}
+	started := time.Date(2024, time.June, 1, 10, 0, 0, 0, time.UTC)
+	running := &v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{Name: "running", Namespace: "argo", UID: "running-uid"},
+		Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning, StartedAt: v1.Time{Time: started}},
+	}
+	cronWf.Status.Active = []corev1.ObjectReference{{Name: running.Name, UID: running.UID}}
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf, running)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	clock := testingclock.NewFakePassiveClock(started.Add(10*time.Minute - time.Second))
+	woc := &cronWfOperationCtx{
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
+		clock:       clock,
+	}
+
+	proceed, err := woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.False(t, proceed, "the active workflow is within the grace period")
+	wf, err := woc.wfClient.Get(ctx, running.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, wf.Spec.Shutdown)
+
+	clock.SetTime(started.Add(10 * time.Minute))
+	proceed, err = woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.True(t, proceed, "the grace period has passed")
+	wf, err = woc.wfClient.Get(ctx, running.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ShutdownStrategyTerminate, wf.Spec.Shutdown)
+}
+
+func TestSuspendPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		policy   v1alpha1.SuspendPolicy
+		released bool
+	}{
+		{"", false},
+		{v1alpha1.SuspendPolicyDrainActive, false},
+		{v1alpha1.SuspendPolicyImmediate, true},
+	} {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			var cronWf v1alpha1.CronWorkflow
+			v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+			cronWf.Spec.ConcurrencyPolicy = v1alpha1.ForbidConcurrent
+			cronWf.Spec.SuspendPolicy = tt.policy
+			cronWf.Spec.Suspend = true
+			running := v1alpha1.Workflow{
+				ObjectMeta: v1.ObjectMeta{Name: "running", UID: "running-uid"},
+				Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning},
+			}
+			cronWf.Status.Active = []corev1.ObjectReference{{Name: running.Name, UID: running.UID}}
+
+			ctx := context.Background()
+			cs := fake.NewSimpleClientset(&cronWf)
+			testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+			require.NoError(t, err)
+			woc := &cronWfOperationCtx{
+				clock:       clock.RealClock{},
+				wfClientset: cs,
+				wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+				cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+				cronWf:      &cronWf,
+				log:         logrus.WithFields(logrus.Fields{}),
+				metrics:     testMetrics,
+			}
+			require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{running}))
+			persisted, err := cs.ArgoprojV1alpha1().CronWorkflows("argo").Get(ctx, cronWf.Name, v1.GetOptions{})
+			require.NoError(t, err)
+			// the active workflow is still tracked either way
+			assert.Len(t, persisted.Status.Active, 1)
+			assert.Equal(t, tt.released, len(persisted.Status.Released) == 1)
+
+			// once resumed, the active workflow only blocks the next run if it was not released
+			woc.cronWf.Spec.Suspend = false
+			proceed, err := woc.enforceRuntimePolicy(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.released, proceed)
+
+			running.Status.Phase = v1alpha1.WorkflowSucceeded
+			require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{running}))
+			assert.Empty(t, woc.cronWf.Status.Active)
+			assert.Empty(t, woc.cronWf.Status.Released)
+			assert.Equal(t, int64(1), woc.cronWf.Status.Succeeded)
+		})
+	}
+}
+
+func TestActivePhases(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	pending := v1alpha1.Workflow{ObjectMeta: v1.ObjectMeta{Name: "pending", UID: "pending-uid"}}
+	running := v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{Name: "running", UID: "running-uid"},
+		Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning},
+	}
+	cronWf.Status.Active = []corev1.ObjectReference{{Name: running.Name, UID: running.UID}}
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		clock:       clock.RealClock{},
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
+	}
+	persisted := func() map[string]v1alpha1.WorkflowPhase {
+		cronWf, err := cs.ArgoprojV1alpha1().CronWorkflows("argo").Get(ctx, cronWf.Name, v1.GetOptions{})
+		require.NoError(t, err)
+		return cronWf.Status.ActivePhases
+	}
+
+	require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{pending, running}))
+	expected := map[string]v1alpha1.WorkflowPhase{"pending-uid": v1alpha1.WorkflowPending, "running-uid": v1alpha1.WorkflowRunning}
+	assert.Equal(t, expected, woc.cronWf.Status.ActivePhases)
+	assert.Equal(t, expected, persisted())
+
+	pending.Status.Phase = v1alpha1.WorkflowRunning
+	running.Status.Phase = v1alpha1.WorkflowSucceeded
+	require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{pending, running}))
+	expected = map[string]v1alpha1.WorkflowPhase{"pending-uid": v1alpha1.WorkflowRunning}
+	assert.Equal(t, expected, woc.cronWf.Status.ActivePhases)
+	assert.Equal(t, expected, persisted(), "the completed workflow's phase is removed")
+
+	pending.Status.Phase = v1alpha1.WorkflowFailed
+	require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{pending, running}))
+	assert.Empty(t, woc.cronWf.Status.ActivePhases)
+	assert.Empty(t, persisted())
+	assert.Empty(t, woc.cronWf.Status.Active)
+}
+
+func TestRemovedScheduleWithActive(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(multipleSchedulesWf), &cronWf)
+	cronWf.Spec.Schedules = []string{"0 * * * *", "30 * * * *"}
+	cronWf.Spec.Timezone = "UTC"
+	cronWf.Spec.ConcurrencyPolicy = v1alpha1.ForbidConcurrent
+	scheduledTime := time.Date(2020, 2, 28, 20, 30, 0, 0, time.UTC)
+
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		clock:             clock.RealClock{},
+		wfClientset:       cs,
+		wfClient:          cs.ArgoprojV1alpha1().Workflows(cronWf.Namespace),
+		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:            &cronWf,
+		log:               logrus.WithFields(logrus.Fields{}),
+		metrics:           testMetrics,
+		scheduledTimeFunc: func() time.Time { return scheduledTime },
+	}
+	woc.Run()
+	wfs, err := woc.wfClient.List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wfs.Items, 1)
+	running := wfs.Items[0]
+	assert.Equal(t, "CRON_TZ=UTC 30 * * * *", running.Annotations[common.AnnotationKeyCronWfSchedule])
+	running.Status.Phase = v1alpha1.WorkflowRunning
+
+	// while its schedule remains, the active workflow blocks the next run
+	require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{running}))
+	proceed, err := woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.False(t, proceed)
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+
+	woc.cronWf.Spec.Schedules = []string{"0 * * * *"}
+	woc.cronWf, err = woc.cronWfIf.Update(ctx, woc.cronWf, v1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{running}))
+	persisted, err := woc.cronWfIf.Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	// it is still tracked until it completes, but no longer blocks the remaining schedule
+	assert.Len(t, persisted.Status.Active, 1)
+	assert.Equal(t, []types.UID{running.UID}, persisted.Status.Released)
+	require.Len(t, persisted.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ConditionTypeOrphanedActive, persisted.Status.Conditions[0].Type)
+	assert.Equal(t, "the schedules of active Workflows "+running.Name+" were removed, so they no longer count towards the concurrency policy", persisted.Status.Conditions[0].Message)
+	proceed, err = woc.enforceRuntimePolicy(ctx)
+	require.NoError(t, err)
+	assert.True(t, proceed)
+
+	running.Status.Phase = v1alpha1.WorkflowSucceeded
+	require.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{running}))
+	assert.Empty(t, woc.cronWf.Status.Active)
+	assert.Empty(t, woc.cronWf.Status.Released)
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+	assert.Equal(t, int64(1), woc.cronWf.Status.Succeeded)
+}
+
+func TestNamespaceTimezones(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Timezone = ""
+	cs := fake.NewSimpleClientset(&cronWf)
+	cc := &Controller{
+		wfClientset:          cs,
+		eventRecorderManager: events.NewEventRecorderManager(kubefake.NewSimpleClientset()),
+	}
+	cc.SetNamespaceTimezones(map[string]string{"argo": "Asia/Tokyo"})
+
+	woc := cc.newCronWfOperationCtx(cronWf.DeepCopy())
+	woc.log = logrus.WithFields(logrus.Fields{})
+	assert.Equal(t, "Asia/Tokyo", woc.scheduleSpec().Timezone)
+	assert.Equal(t, []string{"CRON_TZ=Asia/Tokyo * * * * *"}, woc.scheduleSpec().GetSchedulesWithTimezone(context.Background()))
+	// the default is not written to the CronWorkflow, so it is not recorded as part of its schedule
+	assert.Empty(t, woc.cronWf.Spec.Timezone)
+	assert.Equal(t, "* * * * *", woc.cronWf.Spec.GetScheduleWithTimezoneString())
+	woc.patch(context.Background(), map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]string{"patched": "true"}}})
+	assert.Equal(t, "true", woc.cronWf.Labels["patched"])
+	assert.Equal(t, "Asia/Tokyo", woc.scheduleSpec().Timezone)
+	assert.Empty(t, woc.cronWf.Spec.Timezone)
+
+	// the CronWorkflow's own timezone takes precedence
+	cronWf.Spec.Timezone = "Europe/Paris"
+	assert.Equal(t, "Europe/Paris", cc.newCronWfOperationCtx(cronWf.DeepCopy()).scheduleSpec().Timezone)
+
+	// other namespaces use the controller's local time
+	cronWf.Spec.Timezone = ""
+	cronWf.Namespace = "other"
+	assert.Empty(t, cc.newCronWfOperationCtx(cronWf.DeepCopy()).scheduleSpec().Timezone)
+}
+
+func TestDefaultTimezoneUTC(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Timezone = ""
+	cronWf.Spec.Schedules = []string{"0 9 * * *"}
+	cc := &Controller{
+		wfClientset:          fake.NewSimpleClientset(),
+		eventRecorderManager: events.NewEventRecorderManager(kubefake.NewSimpleClientset()),
+	}
+	cc.SetNamespaceTimezones(map[string]string{"tokyo": "Asia/Tokyo"})
+	cc.SetDefaultTimezoneUTC(true)
+
+	woc := cc.newCronWfOperationCtx(cronWf.DeepCopy())
+	assert.Equal(t, "UTC", woc.scheduleSpec().Timezone)
+	assert.Empty(t, woc.cronWf.Spec.Timezone)
+	// fire times are in UTC, whatever the controller's local time is
+	next, err := woc.scheduleSpec().NextRunTime(context.Background(), time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, time.Date(2024, time.June, 1, 9, 0, 0, 0, time.UTC).Equal(next), next)
+
+	// the namespace default and the CronWorkflow's own timezone take precedence
+	tokyo := cronWf.DeepCopy()
+	tokyo.Namespace = "tokyo"
+	assert.Equal(t, "Asia/Tokyo", cc.newCronWfOperationCtx(tokyo).scheduleSpec().Timezone)
+	paris := cronWf.DeepCopy()
+	paris.Spec.Timezone = "Europe/Paris"
+	assert.Equal(t, "Europe/Paris", cc.newCronWfOperationCtx(paris).scheduleSpec().Timezone)
+
+	cc.SetDefaultTimezoneUTC(false)
+	assert.Empty(t, cc.newCronWfOperationCtx(cronWf.DeepCopy()).scheduleSpec().Timezone, "the controller's local time")
 }
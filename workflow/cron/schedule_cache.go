@@ -0,0 +1,30 @@
+package cron
+
+import (
+	"context"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/utils/lru"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// parsedScheduleCache holds the parsed schedules of CronWorkflows so that they are not parsed again on every
+// reconcile. It is keyed by the schedules with their timezone rather than by ScheduleFingerprint, because the order of
+// the schedules matters: a run is attributed to the first one that fires.
+var parsedScheduleCache = lru.New(1024)
+
+// parsedSchedules returns spec.ParsedSchedules, from the cache if they have been parsed before
+func parsedSchedules(ctx context.Context, spec *v1alpha1.CronWorkflowSpec) ([]cron.Schedule, error) {
+	key := strings.Join(spec.GetSchedulesWithTimezone(ctx), "\n")
+	if v, ok := parsedScheduleCache.Get(key); ok {
+		return v.([]cron.Schedule), nil
+	}
+	schedules, err := spec.ParsedSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parsedScheduleCache.Add(key, schedules)
+	return schedules, nil
+}
@@ -0,0 +1,52 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestParsedSchedules(t *testing.T) {
+	ctx := context.Background()
+	spec := &v1alpha1.CronWorkflowSpec{Schedules: []string{"0 * * * *", "30 2 * * *"}, Timezone: "Asia/Tokyo"}
+	schedules, err := parsedSchedules(ctx, spec)
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	cached, err := parsedSchedules(ctx, spec)
+	require.NoError(t, err)
+	assert.Same(t, schedules[0], cached[0])
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 2, 30, 0, 0, tokyo), schedules[1].Next(time.Date(2024, 1, 1, 0, 0, 0, 0, tokyo)))
+
+	// reordering the schedules is a different entry, since it changes which schedule a run is attributed to
+	reordered, err := parsedSchedules(ctx, &v1alpha1.CronWorkflowSpec{Schedules: []string{"30 2 * * *", "0 * * * *"}, Timezone: "Asia/Tokyo"})
+	require.NoError(t, err)
+	assert.NotSame(t, schedules[0], reordered[1])
+
+	_, err = parsedSchedules(ctx, &v1alpha1.CronWorkflowSpec{Schedules: []string{"0 * * *"}})
+	require.Error(t, err)
+}
+
+func BenchmarkParsedSchedules(b *testing.B) {
+	ctx := context.Background()
+	spec := &v1alpha1.CronWorkflowSpec{Schedules: []string{"0 * * * *", "*/5 9-17 * * MON-FRI", "@daily"}, Timezone: "America/Los_Angeles"}
+	b.Run("Parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_, _ = spec.ParsedSchedules(ctx)
+		}
+	})
+	b.Run("Cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_, _ = parsedSchedules(ctx, spec)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"context"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// CronWorkflowSkipReason is why a CronWorkflow's schedule fired but no Workflow was submitted
+type CronWorkflowSkipReason string
+
+const (
+	CronWorkflowSkipReasonSuspended         CronWorkflowSkipReason = "Suspended"
+	CronWorkflowSkipReasonStopped           CronWorkflowSkipReason = "Stopped"
+	CronWorkflowSkipReasonWhen              CronWorkflowSkipReason = "When"
+	CronWorkflowSkipReasonConcurrencyPolicy CronWorkflowSkipReason = "ConcurrencyPolicy"
+	CronWorkflowSkipReasonMaxRuns           CronWorkflowSkipReason = "MaxRuns"
+	CronWorkflowSkipReasonFrozen            CronWorkflowSkipReason = "Frozen"
+	CronWorkflowSkipReasonSkipNext          CronWorkflowSkipReason = "SkipNext"
+)
+
+func addCronWfOutcomeCounters(_ context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentCronworkflowsSkippedTotal)
+	if err != nil {
+		return err
+	}
+	return m.CreateBuiltinInstrument(telemetry.InstrumentCronworkflowsCompletedTotal)
+}
+
+func (m *Metrics) CronWfSkipped(ctx context.Context, name, namespace string, reason CronWorkflowSkipReason) {
+	m.AddInt(ctx, telemetry.InstrumentCronworkflowsSkippedTotal.Name(), 1, telemetry.InstAttribs{
+		{Name: telemetry.AttribCronWFName, Value: name},
+		{Name: telemetry.AttribCronWFNamespace, Value: namespace},
+		{Name: telemetry.AttribCronWFSkipReason, Value: string(reason)},
+	})
+}
+
+// CronWfCompleted counts a completed Workflow of a CronWorkflow. Errored Workflows are counted as failed, as they are
+// in the CronWorkflow's status, and other phases are not counted.
+func (m *Metrics) CronWfCompleted(ctx context.Context, name, namespace string, phase wfv1.WorkflowPhase) {
+	var outcome wfv1.WorkflowPhase
+	switch phase {
+	case wfv1.WorkflowError, wfv1.WorkflowFailed:
+		outcome = wfv1.WorkflowFailed
+	case wfv1.WorkflowSucceeded:
+		outcome = wfv1.WorkflowSucceeded
+	default:
+		return
+	}
+	m.AddInt(ctx, telemetry.InstrumentCronworkflowsCompletedTotal.Name(), 1, telemetry.InstAttribs{
+		{Name: telemetry.AttribCronWFName, Value: name},
+		{Name: telemetry.AttribCronWFNamespace, Value: namespace},
+		{Name: telemetry.AttribCronWFOutcome, Value: string(outcome)},
+	})
+}
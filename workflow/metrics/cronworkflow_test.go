@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func TestCronWorkflowMetrics(t *testing.T) {
+	m, te, err := createTestMetrics(&telemetry.Config{}, Callbacks{})
+	require.NoError(t, err)
+	ctx := context.Background()
+	cronAttribs := func(kv ...attribute.KeyValue) *attribute.Set {
+		s := attribute.NewSet(append(kv, attribute.String(telemetry.AttribCronWFName, "my-cron"), attribute.String(telemetry.AttribCronWFNamespace, "argo"))...)
+		return &s
+	}
+
+	m.CronWfSkipped(ctx, "my-cron", "argo", CronWorkflowSkipReasonWhen)
+	m.CronWfSkipped(ctx, "my-cron", "argo", CronWorkflowSkipReasonWhen)
+	m.CronWfSkipped(ctx, "my-cron", "argo", CronWorkflowSkipReasonConcurrencyPolicy)
+	val, err := te.GetInt64CounterValue(telemetry.InstrumentCronworkflowsSkippedTotal.Name(), cronAttribs(attribute.String(telemetry.AttribCronWFSkipReason, "When")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+
+	m.CronWfCompleted(ctx, "my-cron", "argo", wfv1.WorkflowSucceeded)
+	m.CronWfCompleted(ctx, "my-cron", "argo", wfv1.WorkflowError)
+	m.CronWfCompleted(ctx, "my-cron", "argo", wfv1.WorkflowFailed)
+	m.CronWfCompleted(ctx, "my-cron", "argo", wfv1.WorkflowRunning)
+	val, err = te.GetInt64CounterValue(telemetry.InstrumentCronworkflowsCompletedTotal.Name(), cronAttribs(attribute.String(telemetry.AttribCronWFOutcome, "Failed")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+	val, err = te.GetInt64CounterValue(telemetry.InstrumentCronworkflowsCompletedTotal.Name(), cronAttribs(attribute.String(telemetry.AttribCronWFOutcome, "Succeeded")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), val)
+
+	m.CronWfActive("my-cron", "argo", 3)
+	val, err = te.GetInt64GaugeValue(telemetry.InstrumentCronworkflowsActive.Name(), cronAttribs())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), val)
+	m.CronWfDeleted("my-cron", "argo")
+	_, err = te.GetInt64GaugeValue(telemetry.InstrumentCronworkflowsActive.Name(), cronAttribs())
+	require.Error(t, err)
+}
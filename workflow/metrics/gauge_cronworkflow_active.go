@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+type cronWfKey struct {
+	name      string
+	namespace string
+}
+
+// cronWfActiveGauge holds the last reported active count of each CronWorkflow, as there is no single place to list
+// them all from when the gauge is observed
+type cronWfActiveGauge struct {
+	mutex  sync.Mutex
+	active map[cronWfKey]int64
+	gauge  *telemetry.Instrument
+}
+
+func addCronWfActiveGauge(_ context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentCronworkflowsActive)
+	if err != nil {
+		return err
+	}
+	m.cronWfActive = &cronWfActiveGauge{
+		active: make(map[cronWfKey]int64),
+		gauge:  m.GetInstrument(telemetry.InstrumentCronworkflowsActive.Name()),
+	}
+	return m.cronWfActive.gauge.RegisterCallback(m.Metrics, m.cronWfActive.update)
+}
+
+// CronWfActive records the number of active Workflows of a CronWorkflow
+func (m *Metrics) CronWfActive(name, namespace string, active int64) {
+	if m.cronWfActive == nil {
+		return
+	}
+	m.cronWfActive.mutex.Lock()
+	defer m.cronWfActive.mutex.Unlock()
+	m.cronWfActive.active[cronWfKey{name: name, namespace: namespace}] = active
+}
+
+// CronWfDeleted stops reporting the active Workflows of a deleted CronWorkflow
+func (m *Metrics) CronWfDeleted(name, namespace string) {
+	if m.cronWfActive == nil {
+		return
+	}
+	m.cronWfActive.mutex.Lock()
+	defer m.cronWfActive.mutex.Unlock()
+	delete(m.cronWfActive.active, cronWfKey{name: name, namespace: namespace})
+}
+
+func (g *cronWfActiveGauge) update(_ context.Context, o metric.Observer) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	for key, val := range g.active {
+		g.gauge.ObserveInt(o, val, telemetry.InstAttribs{
+			{Name: telemetry.AttribCronWFName, Value: key.name},
+			{Name: telemetry.AttribCronWFNamespace, Value: key.namespace},
+		})
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ type Metrics struct {
 	callbacks         Callbacks
 	realtimeMutex     sync.Mutex
 	realtimeWorkflows map[string][]realtimeTracker
+	cronWfActive      *cronWfActiveGauge
 }
 
 func New(ctx context.Context, serviceName, prometheusName string, config *telemetry.Config, callbacks Callbacks, extraOpts ...metricsdk.Option) (*Metrics, error) {
@@ -46,6 +47,8 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 		addWorkflowPhaseGauge,
 		addCronWfTriggerCounter,
 		addCronWfPolicyCounter,
+		addCronWfOutcomeCounters,
+		addCronWfActiveGauge,
 		addWorkflowPhaseCounter,
 		addWorkflowTemplateCounter,
 		addWorkflowTemplateHistogram,
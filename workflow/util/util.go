@@ -173,6 +173,20 @@ func IsWorkflowCompleted(wf *wfv1.Workflow) bool {
 	return false
 }
 
+// WorkflowMutator customizes a Workflow before it is submitted, e.g. to inject organization-wide labels or a
+// securityContext. Returning an error aborts the submission.
+type WorkflowMutator func(*wfv1.Workflow) error
+
+// MutateWorkflow applies the mutators to wf in order, stopping at the first error
+func MutateWorkflow(wf *wfv1.Workflow, mutators ...WorkflowMutator) error {
+	for _, mutate := range mutators {
+		if err := mutate(wf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SubmitWorkflow validates and submits a single workflow and overrides some of the fields of the workflow
 func SubmitWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, wfClientset wfclientset.Interface, namespace string, wf *wfv1.Workflow, wfDefaults *wfv1.Workflow, opts *wfv1.SubmitOpts) (*wfv1.Workflow, error) {
 	err := ApplySubmitOpts(wf, opts)
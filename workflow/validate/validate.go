@@ -6,13 +6,13 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"golang.org/x/exp/maps"
 
-	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
@@ -370,9 +370,22 @@ func ValidateClusterWorkflowTemplate(wftmplGetter templateresolution.WorkflowTem
 	return ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, wfDefaults, opts)
 }
 
+// ValidateCronWorkflowList validates each CronWorkflow in the list, rather than stopping at the first that is invalid.
+// The errors are keyed by the CronWorkflow's namespace/name, and only invalid CronWorkflows have an entry.
+func ValidateCronWorkflowList(ctx context.Context, wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, list *wfv1.CronWorkflowList, wfDefaults *wfv1.Workflow) map[string]error {
+	errs := map[string]error{}
+	for i := range list.Items {
+		cronWf := &list.Items[i]
+		if err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cronWf, wfDefaults); err != nil {
+			errs[cronWf.Namespace+"/"+cronWf.Name] = err
+		}
+	}
+	return errs
+}
+
 // ValidateCronWorkflow validates a CronWorkflow
 func ValidateCronWorkflow(ctx context.Context, wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, cronWf *wfv1.CronWorkflow, wfDefaults *wfv1.Workflow) error {
-	if len(cronWf.Spec.Schedules) > 0 && cronWf.Spec.Schedule != "" {
+	if cronWf.Spec.HasBothSchedules() {
 		return fmt.Errorf("cron workflow cant be configured with both Spec.Schedule and Spec.Schedules")
 	}
 	// CronWorkflows have fewer max chars allowed in their name because when workflows are created from them, they
@@ -382,12 +395,30 @@ func ValidateCronWorkflow(ctx context.Context, wftmplGetter templateresolution.W
 		return fmt.Errorf("cron workflow name %q must not be more than 52 characters long (currently %d)", cronWf.Name, len(cronWf.Name))
 	}
 
+	if invalid := cronWf.Spec.InvalidTimezones(); len(invalid) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "unknown timezones: %s", strings.Join(invalid, ", "))
+	}
+
 	for _, schedule := range cronWf.Spec.GetSchedules(ctx) {
-		if _, err := cron.ParseStandard(schedule); err != nil {
+		if _, err := wfv1.ParseCronSchedule(schedule); err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "cron schedule %s is malformed: %s", schedule, err)
 		}
 	}
 
+	if cronWf.Spec.ScheduleWindow != nil {
+		if cronWf.Spec.ScheduleCount() > 0 {
+			return errors.Errorf(errors.CodeBadRequest, "scheduleWindow may not be used with schedule or schedules")
+		}
+		if _, err := cronWf.GetScheduleWindow(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "scheduleWindow is malformed: %s", err)
+		}
+	}
+
+	if cronWf.Spec.ScheduleMode() == wfv1.ScheduleModeAmbiguous {
+		// the other ambiguous combinations are rejected above
+		return errors.Errorf(errors.CodeBadRequest, "@every schedules may not be combined with cron schedules, as they fire at an interval rather than at fixed times")
+	}
+
 	switch cronWf.Spec.ConcurrencyPolicy {
 	case wfv1.AllowConcurrent, wfv1.ForbidConcurrent, wfv1.ReplaceConcurrent, "":
 		// Do nothing
@@ -395,10 +426,71 @@ func ValidateCronWorkflow(ctx context.Context, wftmplGetter templateresolution.W
 		return errors.Errorf(errors.CodeBadRequest, "'%s' is not a valid concurrencyPolicy", cronWf.Spec.ConcurrencyPolicy)
 	}
 
+	switch cronWf.Spec.SuspendPolicy {
+	case wfv1.SuspendPolicyDrainActive, wfv1.SuspendPolicyImmediate, "":
+		// Do nothing
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "'%s' is not a valid suspendPolicy", cronWf.Spec.SuspendPolicy)
+	}
+
 	if cronWf.Spec.StartingDeadlineSeconds != nil && *cronWf.Spec.StartingDeadlineSeconds < 0 {
 		return errors.Errorf(errors.CodeBadRequest, "startingDeadlineSeconds must be positive")
 	}
 
+	if cronWf.Spec.ReplaceGracePeriod != nil && cronWf.Spec.ReplaceGracePeriod.Duration < 0 {
+		return errors.Errorf(errors.CodeBadRequest, "replaceGracePeriod must not be negative")
+	}
+
+	if fraction := cronWf.Spec.StartingDeadlineFraction; fraction != nil {
+		if cronWf.Spec.StartingDeadlineSeconds != nil {
+			return errors.Errorf(errors.CodeBadRequest, "startingDeadlineFraction may not be used with startingDeadlineSeconds")
+		}
+		if f, err := fraction.Float64(); err != nil || f <= 0 || f > 1 {
+			return errors.Errorf(errors.CodeBadRequest, "startingDeadlineFraction must be greater than 0 and at most 1")
+		}
+	}
+
+	if cronWf.Spec.ActiveDeadlineSeconds != nil && *cronWf.Spec.ActiveDeadlineSeconds < 0 {
+		return errors.Errorf(errors.CodeBadRequest, "activeDeadlineSeconds must be positive")
+	}
+
+	if freeze := cronWf.Spec.Freeze; freeze != nil && (freeze.Name == "" || freeze.Key == "") {
+		return errors.Errorf(errors.CodeBadRequest, "freeze must have a ConfigMap name and key")
+	}
+
+	if cronWf.Spec.MaxRuns != nil && *cronWf.Spec.MaxRuns < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "maxRuns must be at least 1")
+	}
+
+	schedules := cronWf.Spec.GetSchedules(ctx)
+	for schedule, seconds := range cronWf.Spec.ScheduleStartingDeadlineSeconds {
+		// entries match equivalent schedules, as they do when the deadline is looked up
+		normalized := wfv1.NormalizeCronSchedule(schedule)
+		if !slices.ContainsFunc(schedules, func(s string) bool { return wfv1.NormalizeCronSchedule(s) == normalized }) {
+			return errors.Errorf(errors.CodeBadRequest, "scheduleStartingDeadlineSeconds has an entry for %q, which is not one of the schedules", schedule)
+		}
+		if seconds < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "scheduleStartingDeadlineSeconds for %q must be positive", schedule)
+		}
+	}
+
+	if md := cronWf.Spec.WorkflowMetadata; md != nil {
+		for _, key := range common.ReservedCronWorkflowLabels {
+			if _, ok := md.Labels[key]; ok {
+				return errors.Errorf(errors.CodeBadRequest, "workflowMetadata may not set the label %q, which is reserved for the controller", key)
+			}
+		}
+		for _, key := range common.ReservedCronWorkflowAnnotations {
+			if _, ok := md.Annotations[key]; ok {
+				return errors.Errorf(errors.CodeBadRequest, "workflowMetadata may not set the annotation %q, which is reserved for the controller", key)
+			}
+		}
+	}
+
+	if cronWf.Spec.DeleteAfterStopped != nil && cronWf.Spec.DeleteAfterStopped.Duration < 0 {
+		return errors.Errorf(errors.CodeBadRequest, "deleteAfterStopped must be positive")
+	}
+
 	wf := common.ConvertCronWorkflowToWorkflow(cronWf)
 
 	err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, wfDefaults, ValidateOpts{})
@@ -2,12 +2,16 @@ package validate
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -2856,6 +2860,131 @@ func TestMaxLengthName(t *testing.T) {
 	require.EqualError(t, err, "cron workflow name \"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\" must not be more than 52 characters long (currently 60)")
 }
 
+func TestCronWorkflowUnsupportedScheduleOperators(t *testing.T) {
+	for schedule, op := range map[string]string{"0 9 * * 1#2": "#", "0 0 L * *": "L"} {
+		cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{Schedules: []string{schedule}}}
+		err := ValidateCronWorkflow(context.Background(), wftmplGetter, cwftmplGetter, cwf, nil)
+		require.EqualError(t, err, "cron schedule "+schedule+" is malformed: the '"+op+"' operator is not supported")
+	}
+}
+
+func TestCronWorkflowScheduleStartingDeadlineSeconds(t *testing.T) {
+	ctx := context.Background()
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		Schedules:                       []string{"* * * * *"},
+		ScheduleStartingDeadlineSeconds: map[string]int64{"0 0 * * *": 60},
+	}}
+	err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, `scheduleStartingDeadlineSeconds has an entry for "0 0 * * *", which is not one of the schedules`)
+
+	cwf.Spec.ScheduleStartingDeadlineSeconds = map[string]int64{"* * * * *": -1}
+	err = ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, `scheduleStartingDeadlineSeconds for "* * * * *" must be positive`)
+
+	// an entry for an equivalent schedule matches it
+	cwf.Spec.Schedules = []string{"00 00 * * *"}
+	cwf.Spec.ScheduleStartingDeadlineSeconds = map[string]int64{"@daily": -1}
+	err = ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, `scheduleStartingDeadlineSeconds for "@daily" must be positive`)
+}
+
+func TestCronWorkflowReplaceGracePeriod(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		Schedules:          []string{"* * * * *"},
+		ReplaceGracePeriod: &metav1.Duration{Duration: -time.Minute},
+	}}
+	err := ValidateCronWorkflow(context.Background(), wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "replaceGracePeriod must not be negative")
+}
+
+func TestValidateCronWorkflowList(t *testing.T) {
+	spec := func(schedule string) wfv1.CronWorkflowSpec {
+		return wfv1.CronWorkflowSpec{
+			Schedules: []string{schedule},
+			WorkflowSpec: wfv1.WorkflowSpec{
+				Entrypoint: "main",
+				Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+			},
+		}
+	}
+	list := &wfv1.CronWorkflowList{Items: []wfv1.CronWorkflow{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "valid"}, Spec: spec("* * * * *")},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "bad-schedule"}, Spec: spec("* * * *")},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "valid"}, Spec: spec("@daily")},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "no-entrypoint"}, Spec: wfv1.CronWorkflowSpec{Schedules: []string{"@daily"}}},
+	}}
+
+	errs := ValidateCronWorkflowList(context.Background(), wftmplGetter, cwftmplGetter, list, nil)
+	assert.Len(t, errs, 2)
+	require.ErrorContains(t, errs["argo/bad-schedule"], "cron schedule * * * * is malformed")
+	require.ErrorContains(t, errs["other/no-entrypoint"], "spec.entrypoint is required")
+
+	assert.Empty(t, ValidateCronWorkflowList(context.Background(), wftmplGetter, cwftmplGetter, &wfv1.CronWorkflowList{}, nil))
+}
+
+func TestCronWorkflowStartingDeadlineFraction(t *testing.T) {
+	ctx := context.Background()
+	seconds := int64(60)
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		Schedules:                []string{"* * * * *"},
+		StartingDeadlineFraction: &wfv1.Amount{Value: "0.5"},
+		WorkflowSpec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+		},
+	}}
+	require.NoError(t, ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil))
+
+	cwf.Spec.StartingDeadlineSeconds = &seconds
+	err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "startingDeadlineFraction may not be used with startingDeadlineSeconds")
+
+	cwf.Spec.StartingDeadlineSeconds = nil
+	for _, fraction := range []string{"0", "-0.5", "1.5", "half"} {
+		cwf.Spec.StartingDeadlineFraction = &wfv1.Amount{Value: json.Number(fraction)}
+		err = ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+		require.EqualError(t, err, "startingDeadlineFraction must be greater than 0 and at most 1", fraction)
+	}
+}
+
+func TestCronWorkflowReservedWorkflowMetadataLabels(t *testing.T) {
+	ctx := context.Background()
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		Schedules: []string{"* * * * *"},
+		WorkflowSpec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+		},
+		WorkflowMetadata: &metav1.ObjectMeta{Labels: map[string]string{common.LabelKeyCronWorkflow: "other", "team": "data"}},
+	}}
+	err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, `workflowMetadata may not set the label "workflows.argoproj.io/cron-workflow", which is reserved for the controller`)
+
+	delete(cwf.Spec.WorkflowMetadata.Labels, common.LabelKeyCronWorkflow)
+	err = ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.NoError(t, err)
+}
+
+func TestCronWorkflowReservedWorkflowMetadataAnnotations(t *testing.T) {
+	ctx := context.Background()
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		Schedules: []string{"* * * * *"},
+		WorkflowSpec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+		},
+	}}
+	for _, key := range common.ReservedCronWorkflowAnnotations {
+		cwf.Spec.WorkflowMetadata = &metav1.ObjectMeta{Annotations: map[string]string{key: "other", "team": "data"}}
+		err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+		require.EqualError(t, err, fmt.Sprintf("workflowMetadata may not set the annotation %q, which is reserved for the controller", key))
+	}
+
+	cwf.Spec.WorkflowMetadata = &metav1.ObjectMeta{Annotations: map[string]string{"team": "data"}}
+	err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.NoError(t, err)
+}
+
 var invalidContainerSetDependencyNotFound = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3354,3 +3483,104 @@ func TestShouldCheckValidationToSpacedParameters(t *testing.T) {
 	// Do not allow leading or trailing spaces in parameters
 	require.ErrorContains(t, err, "failed to resolve {{  workflow.thisdoesnotexist  }}")
 }
+
+func TestCronWorkflowScheduleWindow(t *testing.T) {
+	ctx := context.Background()
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		ScheduleWindow: &wfv1.ScheduleWindow{Start: "01:00", End: "03:00"},
+		WorkflowSpec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+		},
+	}}
+	require.NoError(t, ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil))
+
+	cwf.Spec.ScheduleWindow.End = "00:30"
+	err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "scheduleWindow is malformed: end 00:30 must be after start 01:00")
+
+	cwf.Spec.ScheduleWindow.End = "03:00"
+	cwf.Spec.Schedules = []string{"* * * * *"}
+	err = ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "scheduleWindow may not be used with schedule or schedules")
+}
+
+func TestCronWorkflowSuspendPolicy(t *testing.T) {
+	ctx := context.Background()
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		Schedules:     []string{"* * * * *"},
+		SuspendPolicy: wfv1.SuspendPolicyImmediate,
+		WorkflowSpec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+		},
+	}}
+	require.NoError(t, ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil))
+
+	cwf.Spec.SuspendPolicy = "Later"
+	err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "'Later' is not a valid suspendPolicy")
+}
+
+func TestCronWorkflowScheduleMode(t *testing.T) {
+	ctx := context.Background()
+	for name, tt := range map[string]struct {
+		spec wfv1.CronWorkflowSpec
+		err  string
+	}{
+		"Cron":     {wfv1.CronWorkflowSpec{Schedules: []string{"0 * * * *", "@daily"}}, ""},
+		"Interval": {wfv1.CronWorkflowSpec{Schedules: []string{"@every 90m", "CRON_TZ=UTC @every 2h"}}, ""},
+		"Window":   {wfv1.CronWorkflowSpec{ScheduleWindow: &wfv1.ScheduleWindow{Start: "01:00", End: "03:00"}}, ""},
+		"IntervalAndCron": {
+			wfv1.CronWorkflowSpec{Schedules: []string{"0 * * * *", "@every 90m"}},
+			"@every schedules may not be combined with cron schedules, as they fire at an interval rather than at fixed times",
+		},
+		"IntervalAndWindow": {
+			wfv1.CronWorkflowSpec{Schedule: "@every 90m", ScheduleWindow: &wfv1.ScheduleWindow{Start: "01:00", End: "03:00"}},
+			"scheduleWindow may not be used with schedule or schedules",
+		},
+		"CronAndWindow": {
+			wfv1.CronWorkflowSpec{Schedule: "0 * * * *", ScheduleWindow: &wfv1.ScheduleWindow{Start: "01:00", End: "03:00"}},
+			"scheduleWindow may not be used with schedule or schedules",
+		},
+		"ScheduleAndSchedules": {
+			wfv1.CronWorkflowSpec{Schedule: "@every 90m", Schedules: []string{"0 * * * *"}},
+			"cron workflow cant be configured with both Spec.Schedule and Spec.Schedules",
+		},
+	} {
+		cwf := &wfv1.CronWorkflow{Spec: tt.spec}
+		cwf.Spec.WorkflowSpec = wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+		}
+		err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+		if tt.err == "" {
+			require.NoError(t, err, name)
+		} else {
+			require.EqualError(t, err, tt.err, name)
+		}
+	}
+}
+
+func TestCronWorkflowTimezones(t *testing.T) {
+	ctx := context.Background()
+	cwf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{
+		Schedules: []string{"CRON_TZ=Europe/Paris 0 9 * * *", "0 17 * * *"},
+		Timezone:  "Asia/Tokyo",
+		WorkflowSpec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+		},
+	}}
+	require.NoError(t, ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil))
+
+	cwf.Spec.Schedules = []string{"CRON_TZ=Mars/Olympus 0 9 * * *", "CRON_TZ=Europe/Paris 0 17 * * *", "TZ=Nowhere 0 18 * * *"}
+	err := ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "unknown timezones: Mars/Olympus, Nowhere")
+
+	// the spec's timezone is validated even if every schedule has its own
+	cwf.Spec.Schedules = []string{"CRON_TZ=Europe/Paris 0 9 * * *"}
+	cwf.Spec.Timezone = "Asia/Nowhere"
+	err = ValidateCronWorkflow(ctx, wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "unknown timezones: Asia/Nowhere")
+}